@@ -0,0 +1,101 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balancer
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func init() {
+	Cmd.AddCommand(statusCmd)
+	Cmd.AddCommand(pauseCmd)
+	Cmd.AddCommand(resumeCmd)
+}
+
+var Cmd = &cobra.Command{
+	Use:   "balancer",
+	Short: "Manage the load balancer",
+	Long:  `Inspect, pause and resume the coordinator load balancer`,
+}
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the load balancer status",
+	Args:  cobra.NoArgs,
+	RunE:  execStatus,
+}
+
+var pauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause the load balancer",
+	Long:  `Pause the load balancer, so that no shard gets moved automatically. Useful during maintenance`,
+	Args:  cobra.NoArgs,
+	RunE:  execPause,
+}
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume the load balancer",
+	Args:  cobra.NoArgs,
+	RunE:  execResume,
+}
+
+func execStatus(cmd *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.GetBalancerStatus(ctx, &proto.GetBalancerStatusRequest{})
+	if err != nil {
+		return err
+	}
+	return common.WriteOutput(cmd.OutOrStdout(), common.OutputBalancer{
+		Paused:   res.Paused,
+		Balanced: res.Balanced,
+	})
+}
+
+func execPause(_ *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	_, err = client.PauseBalancer(ctx, &proto.PauseBalancerRequest{})
+	return err
+}
+
+func execResume(_ *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	_, err = client.ResumeBalancer(ctx, &proto.ResumeBalancerRequest{})
+	return err
+}
@@ -0,0 +1,65 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balancer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func runCmd(cmd *cobra.Command, args string, stdin string) (string, error) {
+	actual := new(bytes.Buffer)
+	cmd.SetIn(bytes.NewBufferString(stdin))
+	cmd.SetOut(actual)
+	cmd.SetErr(actual)
+	cmd.SetArgs(strings.Split(args, " "))
+	err := cmd.Execute()
+	return strings.TrimSpace(actual.String()), err
+}
+
+func TestBalancer_status(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("GetBalancerStatus", &proto.GetBalancerStatusRequest{}).
+		Return(&proto.GetBalancerStatusResponse{Paused: true, Balanced: false}, nil)
+
+	out, err := runCmd(Cmd, "status", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"paused":true,"balanced":false}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestBalancer_pauseResume(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("PauseBalancer", &proto.PauseBalancerRequest{}).Return(nil)
+	common.MockedClient.On("ResumeBalancer", &proto.ResumeBalancerRequest{}).Return(nil)
+
+	out, err := runCmd(Cmd, "pause", "")
+	assert.NoError(t, err)
+	assert.Empty(t, out)
+
+	out, err = runCmd(Cmd, "resume", "")
+	assert.NoError(t, err)
+	assert.Empty(t, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
@@ -0,0 +1,53 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/oxia-db/oxia/cmd/admin/balancer"
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/cmd/admin/namespaces"
+	"github.com/oxia-db/oxia/cmd/admin/nodes"
+	"github.com/oxia-db/oxia/cmd/admin/sessions"
+	"github.com/oxia-db/oxia/cmd/admin/shards"
+	"github.com/oxia-db/oxia/common/constant"
+)
+
+const defaultRequestTimeout = 1 * time.Minute
+
+var (
+	Cmd = &cobra.Command{
+		Use:   "admin",
+		Short: "Administer the cluster",
+		Long:  `Inspect the cluster status and run administrative operations through the coordinator`,
+	}
+)
+
+func init() {
+	defaultAdminAddress := fmt.Sprintf("localhost:%d", constant.DefaultInternalPort)
+	Cmd.PersistentFlags().StringVarP(&common.Config.AdminAddr, "admin-address", "a", defaultAdminAddress, "Coordinator admin address")
+	Cmd.PersistentFlags().DurationVar(&common.Config.RequestTimeout, "request-timeout", defaultRequestTimeout, "Requests timeout")
+	Cmd.PersistentFlags().StringVar(&common.Config.AuthToken, "auth-token", "", "Token to authenticate with the admin service")
+
+	Cmd.AddCommand(namespaces.Cmd)
+	Cmd.AddCommand(shards.Cmd)
+	Cmd.AddCommand(nodes.Cmd)
+	Cmd.AddCommand(balancer.Cmd)
+	Cmd.AddCommand(sessions.Cmd)
+}
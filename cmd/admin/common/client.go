@@ -0,0 +1,62 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//revive:disable-next-line:var-naming
+package common
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/oxia-db/oxia/common/rpc"
+	"github.com/oxia-db/oxia/oxia/auth"
+	"github.com/oxia-db/oxia/proto"
+)
+
+var (
+	Config       = AdminConfig{}
+	MockedClient *MockAdminClient
+)
+
+type AdminConfig struct {
+	AdminAddr      string
+	RequestTimeout time.Duration
+	AuthToken      string
+}
+
+// NewAdminClient connects to the admin service of the coordinator. The
+// returned closer releases the connection.
+func (AdminConfig) NewAdminClient() (proto.OxiaAdminClient, io.Closer, error) {
+	if MockedClient != nil {
+		return MockedClient, MockedClient, nil
+	}
+
+	var authentication auth.Authentication
+	if Config.AuthToken != "" {
+		authentication = auth.NewTokenAuthenticationWithToken(Config.AuthToken, false)
+	}
+
+	clientPool := rpc.NewClientPool(nil, authentication)
+	client, err := clientPool.GetAdminRpc(Config.AdminAddr)
+	if err != nil {
+		_ = clientPool.Close()
+		return nil, nil, err
+	}
+	return client, clientPool, nil
+}
+
+func (AdminConfig) NewContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), Config.RequestTimeout)
+}
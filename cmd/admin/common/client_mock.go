@@ -0,0 +1,142 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//revive:disable-next-line:var-naming
+package common
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/oxia-db/oxia/proto"
+)
+
+var _ proto.OxiaAdminClient = &MockAdminClient{}
+
+type MockAdminClient struct {
+	mock.Mock
+}
+
+func NewMockAdminClient() *MockAdminClient {
+	return &MockAdminClient{}
+}
+
+func (*MockAdminClient) Close() error {
+	return nil
+}
+
+func (m *MockAdminClient) CreateNamespace(_ context.Context, in *proto.CreateNamespaceRequest, _ ...grpc.CallOption) (*proto.CreateNamespaceResponse, error) {
+	args := m.MethodCalled("CreateNamespace", in)
+	return &proto.CreateNamespaceResponse{}, args.Error(0)
+}
+
+func (m *MockAdminClient) DeleteNamespace(_ context.Context, in *proto.DeleteNamespaceRequest, _ ...grpc.CallOption) (*proto.DeleteNamespaceResponse, error) {
+	args := m.MethodCalled("DeleteNamespace", in)
+	return &proto.DeleteNamespaceResponse{}, args.Error(0)
+}
+
+func (m *MockAdminClient) ListNamespaces(_ context.Context, in *proto.ListNamespacesRequest, _ ...grpc.CallOption) (*proto.ListNamespacesResponse, error) {
+	args := m.MethodCalled("ListNamespaces", in)
+	return castResponse[*proto.ListNamespacesResponse](args)
+}
+
+func (m *MockAdminClient) DescribeNamespace(_ context.Context, in *proto.DescribeNamespaceRequest, _ ...grpc.CallOption) (*proto.DescribeNamespaceResponse, error) {
+	args := m.MethodCalled("DescribeNamespace", in)
+	return castResponse[*proto.DescribeNamespaceResponse](args)
+}
+
+func (m *MockAdminClient) ListNodes(_ context.Context, in *proto.ListNodesRequest, _ ...grpc.CallOption) (*proto.ListNodesResponse, error) {
+	args := m.MethodCalled("ListNodes", in)
+	return castResponse[*proto.ListNodesResponse](args)
+}
+
+func (m *MockAdminClient) ElectLeader(_ context.Context, in *proto.ElectLeaderRequest, _ ...grpc.CallOption) (*proto.ElectLeaderResponse, error) {
+	args := m.MethodCalled("ElectLeader", in)
+	return castResponse[*proto.ElectLeaderResponse](args)
+}
+
+func (m *MockAdminClient) TransferLeader(_ context.Context, in *proto.TransferLeaderRequest, _ ...grpc.CallOption) (*proto.TransferLeaderResponse, error) {
+	args := m.MethodCalled("TransferLeader", in)
+	return castResponse[*proto.TransferLeaderResponse](args)
+}
+
+func (m *MockAdminClient) Split(_ context.Context, in *proto.SplitRequest, _ ...grpc.CallOption) (*proto.SplitResponse, error) {
+	args := m.MethodCalled("Split", in)
+	return castResponse[*proto.SplitResponse](args)
+}
+
+func (m *MockAdminClient) Merge(_ context.Context, in *proto.MergeRequest, _ ...grpc.CallOption) (*proto.MergeResponse, error) {
+	args := m.MethodCalled("Merge", in)
+	return castResponse[*proto.MergeResponse](args)
+}
+
+func (m *MockAdminClient) SwapNode(_ context.Context, in *proto.SwapNodeRequest, _ ...grpc.CallOption) (*proto.SwapNodeResponse, error) {
+	args := m.MethodCalled("SwapNode", in)
+	return &proto.SwapNodeResponse{}, args.Error(0)
+}
+
+func (m *MockAdminClient) DrainNode(_ context.Context, in *proto.DrainNodeRequest, _ ...grpc.CallOption) (*proto.DrainNodeResponse, error) {
+	args := m.MethodCalled("DrainNode", in)
+	return castResponse[*proto.DrainNodeResponse](args)
+}
+
+func (m *MockAdminClient) GetDrainStatus(_ context.Context, in *proto.GetDrainStatusRequest, _ ...grpc.CallOption) (*proto.GetDrainStatusResponse, error) {
+	args := m.MethodCalled("GetDrainStatus", in)
+	return castResponse[*proto.GetDrainStatusResponse](args)
+}
+
+func (m *MockAdminClient) CancelDrain(_ context.Context, in *proto.CancelDrainRequest, _ ...grpc.CallOption) (*proto.CancelDrainResponse, error) {
+	args := m.MethodCalled("CancelDrain", in)
+	return &proto.CancelDrainResponse{}, args.Error(0)
+}
+
+func (m *MockAdminClient) GetBalancerStatus(_ context.Context, in *proto.GetBalancerStatusRequest, _ ...grpc.CallOption) (*proto.GetBalancerStatusResponse, error) {
+	args := m.MethodCalled("GetBalancerStatus", in)
+	return castResponse[*proto.GetBalancerStatusResponse](args)
+}
+
+func (m *MockAdminClient) PauseBalancer(_ context.Context, in *proto.PauseBalancerRequest, _ ...grpc.CallOption) (*proto.PauseBalancerResponse, error) {
+	args := m.MethodCalled("PauseBalancer", in)
+	return &proto.PauseBalancerResponse{}, args.Error(0)
+}
+
+func (m *MockAdminClient) ResumeBalancer(_ context.Context, in *proto.ResumeBalancerRequest, _ ...grpc.CallOption) (*proto.ResumeBalancerResponse, error) {
+	args := m.MethodCalled("ResumeBalancer", in)
+	return &proto.ResumeBalancerResponse{}, args.Error(0)
+}
+
+func (m *MockAdminClient) ListSessions(_ context.Context, in *proto.ListSessionsRequest, _ ...grpc.CallOption) (*proto.ListSessionsResponse, error) {
+	args := m.MethodCalled("ListSessions", in)
+	return castResponse[*proto.ListSessionsResponse](args)
+}
+
+func (m *MockAdminClient) DescribeSession(_ context.Context, in *proto.DescribeSessionRequest, _ ...grpc.CallOption) (*proto.DescribeSessionResponse, error) {
+	args := m.MethodCalled("DescribeSession", in)
+	return castResponse[*proto.DescribeSessionResponse](args)
+}
+
+func (m *MockAdminClient) RevokeSession(_ context.Context, in *proto.RevokeSessionRequest, _ ...grpc.CallOption) (*proto.RevokeSessionResponse, error) {
+	args := m.MethodCalled("RevokeSession", in)
+	return &proto.RevokeSessionResponse{}, args.Error(0)
+}
+
+func castResponse[T any](args mock.Arguments) (T, error) {
+	res, ok := args.Get(0).(T)
+	if !ok {
+		panic("cast failed")
+	}
+	return res, args.Error(1)
+}
@@ -0,0 +1,92 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//revive:disable-next-line:var-naming
+package common
+
+import "github.com/oxia-db/oxia/proto"
+
+func ToOutputNamespace(info *proto.NamespaceInfo) OutputNamespace {
+	config := info.Config
+	ns := OutputNamespace{
+		Name:                              config.Name,
+		InitialShardCount:                 config.InitialShardCount,
+		ReplicationFactor:                 config.ReplicationFactor,
+		NotificationsEnabled:              config.GetNotificationsEnabled(),
+		NotificationsIncludeValue:         config.GetNotificationsIncludeValue(),
+		NotificationsIncludePreviousValue: config.GetNotificationsIncludePreviousValue(),
+		Dynamic:                           info.Dynamic,
+	}
+	for _, antiAffinity := range config.AntiAffinities {
+		ns.AntiAffinities = append(ns.AntiAffinities, OutputAntiAffinity{
+			Labels: antiAffinity.Labels,
+			Mode:   antiAffinity.Mode,
+		})
+	}
+	for _, leaderAffinity := range config.LeaderAffinities {
+		ns.LeaderAffinities = append(ns.LeaderAffinities, OutputLeaderAffinity{
+			Labels: leaderAffinity.Labels,
+		})
+	}
+	for _, nodeAffinity := range config.NodeAffinities {
+		ns.NodeAffinities = append(ns.NodeAffinities, OutputNodeAffinity{
+			Labels: nodeAffinity.Labels,
+			Mode:   nodeAffinity.Mode,
+		})
+	}
+	for _, toleration := range config.Tolerations {
+		ns.Tolerations = append(ns.Tolerations, OutputToleration{
+			Key:    toleration.Key,
+			Value:  toleration.Value,
+			Effect: toleration.Effect,
+		})
+	}
+	return ns
+}
+
+func ToOutputShard(namespace string, info *proto.ShardInfo) OutputShard {
+	return OutputShard{
+		Namespace:        namespace,
+		Shard:            info.Shard,
+		Status:           info.Status,
+		Term:             info.Term,
+		Leader:           info.GetLeader(),
+		Ensemble:         info.Ensemble,
+		MinHashInclusive: info.Int32HashRange.GetMinHashInclusive(),
+		MaxHashInclusive: info.Int32HashRange.GetMaxHashInclusive(),
+	}
+}
+
+func ToOutputDrainStatus(status *proto.DrainStatus) OutputDrainStatus {
+	return OutputDrainStatus{
+		Node:           status.Node,
+		Phase:          status.Phase,
+		LeaderShards:   status.LeaderShards,
+		ReplicaShards:  status.ReplicaShards,
+		TotalShards:    status.TotalShards,
+		Error:          status.GetError(),
+		SafeToShutdown: status.SafeToShutdown,
+	}
+}
+
+func ToOutputSession(namespace string, info *proto.SessionInfo) OutputSession {
+	return OutputSession{
+		Namespace:      namespace,
+		Shard:          info.Shard,
+		SessionId:      info.SessionId,
+		ClientIdentity: info.ClientIdentity,
+		TimeoutMs:      info.TimeoutMs,
+		Leader:         info.Leader,
+	}
+}
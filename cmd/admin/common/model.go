@@ -0,0 +1,115 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//revive:disable-next-line:var-naming
+package common
+
+type OutputNamespace struct {
+	Name                              string                 `json:"name"`
+	InitialShardCount                 uint32                 `json:"initial_shard_count"`
+	ReplicationFactor                 uint32                 `json:"replication_factor"`
+	NotificationsEnabled              bool                   `json:"notifications_enabled"`
+	NotificationsIncludeValue         bool                   `json:"notifications_include_value,omitempty"`
+	NotificationsIncludePreviousValue bool                   `json:"notifications_include_previous_value,omitempty"`
+	AntiAffinities                    []OutputAntiAffinity   `json:"anti_affinities,omitempty"`
+	LeaderAffinities                  []OutputLeaderAffinity `json:"leader_affinities,omitempty"`
+	NodeAffinities                    []OutputNodeAffinity   `json:"node_affinities,omitempty"`
+	Tolerations                       []OutputToleration     `json:"tolerations,omitempty"`
+	Dynamic                           bool                   `json:"dynamic"`
+	Shards                            []OutputShard          `json:"shards,omitempty"`
+}
+
+type OutputAntiAffinity struct {
+	Labels []string `json:"labels"`
+	Mode   string   `json:"mode"`
+}
+
+type OutputLeaderAffinity struct {
+	Labels map[string]string `json:"labels"`
+}
+
+type OutputNodeAffinity struct {
+	Labels map[string]string `json:"labels"`
+	Mode   string            `json:"mode"`
+}
+
+type OutputToleration struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect,omitempty"`
+}
+
+type OutputShard struct {
+	Namespace        string   `json:"namespace"`
+	Shard            int64    `json:"shard"`
+	Status           string   `json:"status"`
+	Term             int64    `json:"term"`
+	Leader           string   `json:"leader,omitempty"`
+	Ensemble         []string `json:"ensemble"`
+	MinHashInclusive uint32   `json:"min_hash_inclusive"`
+	MaxHashInclusive uint32   `json:"max_hash_inclusive"`
+}
+
+type OutputNode struct {
+	Id              string `json:"id"`
+	PublicAddress   string `json:"public_address"`
+	InternalAddress string `json:"internal_address"`
+	Status          string `json:"status"`
+	LeaderShards    uint32 `json:"leader_shards"`
+	ReplicaShards   uint32 `json:"replica_shards"`
+	Draining        bool   `json:"draining"`
+}
+
+type OutputLeader struct {
+	Namespace string `json:"namespace"`
+	Shard     int64  `json:"shard"`
+	Leader    string `json:"leader"`
+}
+
+type OutputSplit struct {
+	Namespace string  `json:"namespace"`
+	Shard     int64   `json:"shard"`
+	Children  []int64 `json:"children"`
+}
+
+type OutputMerge struct {
+	Namespace string  `json:"namespace"`
+	Shards    []int64 `json:"shards"`
+	Merged    int64   `json:"merged"`
+}
+
+type OutputDrainStatus struct {
+	Node           string `json:"node"`
+	Phase          string `json:"phase"`
+	LeaderShards   uint32 `json:"leader_shards"`
+	ReplicaShards  uint32 `json:"replica_shards"`
+	TotalShards    uint32 `json:"total_shards"`
+	Error          string `json:"error,omitempty"`
+	SafeToShutdown bool   `json:"safe_to_shutdown"`
+}
+
+type OutputBalancer struct {
+	Paused   bool `json:"paused"`
+	Balanced bool `json:"balanced"`
+}
+
+type OutputSession struct {
+	Namespace      string   `json:"namespace"`
+	Shard          int64    `json:"shard"`
+	SessionId      int64    `json:"session_id"`
+	ClientIdentity string   `json:"client_identity"`
+	TimeoutMs      uint32   `json:"timeout_ms"`
+	Leader         string   `json:"leader"`
+	EphemeralKeys  []string `json:"ephemeral_keys,omitempty"`
+}
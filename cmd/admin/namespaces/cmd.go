@@ -0,0 +1,288 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/proto"
+)
+
+var (
+	Config = flags{}
+)
+
+type flags struct {
+	name                              string
+	initialShardCount                 uint32
+	replicationFactor                 uint32
+	notificationsEnabled              bool
+	notificationsIncludeValue         bool
+	notificationsIncludePreviousValue bool
+	antiAffinities                    []string
+	leaderAffinities                  []string
+	nodeAffinities                    []string
+	tolerations                       []string
+}
+
+func (flags *flags) Reset() {
+	flags.name = ""
+	flags.initialShardCount = 1
+	flags.replicationFactor = 1
+	flags.notificationsEnabled = true
+	flags.notificationsIncludeValue = false
+	flags.notificationsIncludePreviousValue = false
+	flags.antiAffinities = nil
+	flags.leaderAffinities = nil
+	flags.nodeAffinities = nil
+	flags.tolerations = nil
+}
+
+func init() {
+	createCmd.Flags().StringVar(&Config.name, "name", "", "The name of the namespace")
+	createCmd.Flags().Uint32Var(&Config.initialShardCount, "shards", 1, "The number of shards of the namespace")
+	createCmd.Flags().Uint32Var(&Config.replicationFactor, "replication-factor", 1, "The number of replicas of each shard")
+	createCmd.Flags().BoolVar(&Config.notificationsEnabled, "notifications", true, "Whether notifications are enabled")
+	createCmd.Flags().BoolVar(&Config.notificationsIncludeValue, "notifications-include-value", false,
+		"Whether the notifications carry the value written by each change")
+	createCmd.Flags().BoolVar(&Config.notificationsIncludePreviousValue, "notifications-include-previous-value", false,
+		"Whether the notifications carry the value replaced by each change")
+	createCmd.Flags().StringArrayVar(&Config.antiAffinities, "anti-affinity", nil,
+		"Anti-affinity rule in the format 'label1,label2:mode', with mode 'Strict' or 'Relaxed'. Can be repeated")
+	createCmd.Flags().StringArrayVar(&Config.leaderAffinities, "leader-affinity", nil,
+		"Leader affinity rule in the format 'label1=value1,label2=value2'. Can be repeated, in order of preference")
+	createCmd.Flags().StringArrayVar(&Config.nodeAffinities, "node-affinity", nil,
+		"Node affinity rule in the format 'label1=value1,label2=value2:mode', with mode 'Required' or 'Preferred'. Can be repeated")
+	createCmd.Flags().StringArrayVar(&Config.tolerations, "toleration", nil,
+		"Toleration of the server taints in the format 'key[=value][:effect]', with effect 'NoSchedule' or 'PreferNoSchedule'. Can be repeated")
+	_ = createCmd.MarkFlagRequired("name")
+
+	Cmd.AddCommand(listCmd)
+	Cmd.AddCommand(describeCmd)
+	Cmd.AddCommand(createCmd)
+	Cmd.AddCommand(deleteCmd)
+}
+
+var Cmd = &cobra.Command{
+	Use:   "namespaces",
+	Short: "Manage namespaces",
+	Long:  `List, describe, create and delete the namespaces of the cluster`,
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the namespaces",
+	Args:  cobra.NoArgs,
+	RunE:  execList,
+}
+
+var describeCmd = &cobra.Command{
+	Use:   "describe NAMESPACE",
+	Short: "Describe a namespace and its shards",
+	Args:  cobra.ExactArgs(1),
+	RunE:  execDescribe,
+}
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a namespace",
+	Long:  `Create a namespace. The namespace is stored in the cluster status, alongside the ones defined in the cluster config`,
+	Args:  cobra.NoArgs,
+	RunE:  execCreate,
+}
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete NAMESPACE",
+	Short: "Delete a namespace",
+	Long:  `Delete a namespace that was created through the admin API, along with all its data`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  execDelete,
+}
+
+func execList(cmd *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.ListNamespaces(ctx, &proto.ListNamespacesRequest{})
+	if err != nil {
+		return err
+	}
+
+	for _, ns := range res.Namespaces {
+		if err := common.WriteOutput(cmd.OutOrStdout(), common.ToOutputNamespace(ns)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func execDescribe(cmd *cobra.Command, args []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: args[0]})
+	if err != nil {
+		return err
+	}
+
+	output := common.ToOutputNamespace(res.Namespace)
+	for _, shard := range res.Shards {
+		output.Shards = append(output.Shards, common.ToOutputShard(args[0], shard))
+	}
+	return common.WriteOutput(cmd.OutOrStdout(), output)
+}
+
+func execCreate(_ *cobra.Command, _ []string) error {
+	antiAffinities, err := parseAntiAffinities(Config.antiAffinities)
+	if err != nil {
+		return err
+	}
+	leaderAffinities, err := parseLeaderAffinities(Config.leaderAffinities)
+	if err != nil {
+		return err
+	}
+	nodeAffinities, err := parseNodeAffinities(Config.nodeAffinities)
+	if err != nil {
+		return err
+	}
+	tolerations, err := parseTolerations(Config.tolerations)
+	if err != nil {
+		return err
+	}
+
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	_, err = client.CreateNamespace(ctx, &proto.CreateNamespaceRequest{
+		Config: &proto.NamespaceConfig{
+			Name:                              Config.name,
+			InitialShardCount:                 Config.initialShardCount,
+			ReplicationFactor:                 Config.replicationFactor,
+			NotificationsEnabled:              &Config.notificationsEnabled,
+			NotificationsIncludeValue:         &Config.notificationsIncludeValue,
+			NotificationsIncludePreviousValue: &Config.notificationsIncludePreviousValue,
+			AntiAffinities:                    antiAffinities,
+			LeaderAffinities:                  leaderAffinities,
+			NodeAffinities:                    nodeAffinities,
+			Tolerations:                       tolerations,
+		},
+	})
+	return err
+}
+
+func execDelete(_ *cobra.Command, args []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	_, err = client.DeleteNamespace(ctx, &proto.DeleteNamespaceRequest{Namespace: args[0]})
+	return err
+}
+
+func parseAntiAffinities(rules []string) ([]*proto.AntiAffinity, error) {
+	var antiAffinities []*proto.AntiAffinity
+	for _, rule := range rules {
+		labels, mode, found := strings.Cut(rule, ":")
+		if !found || labels == "" || mode == "" {
+			return nil, errors.Errorf("invalid anti-affinity rule %q, expected 'label1,label2:mode'", rule)
+		}
+		antiAffinities = append(antiAffinities, &proto.AntiAffinity{
+			Labels: strings.Split(labels, ","),
+			Mode:   mode,
+		})
+	}
+	return antiAffinities, nil
+}
+
+func parseLeaderAffinities(rules []string) ([]*proto.LeaderAffinity, error) {
+	var leaderAffinities []*proto.LeaderAffinity
+	for _, rule := range rules {
+		labels, ok := parseLabels(rule)
+		if !ok {
+			return nil, errors.Errorf("invalid leader affinity rule %q, expected 'label1=value1,label2=value2'", rule)
+		}
+		leaderAffinities = append(leaderAffinities, &proto.LeaderAffinity{Labels: labels})
+	}
+	return leaderAffinities, nil
+}
+
+func parseNodeAffinities(rules []string) ([]*proto.NodeAffinity, error) {
+	var nodeAffinities []*proto.NodeAffinity
+	for _, rule := range rules {
+		selector, mode, found := strings.Cut(rule, ":")
+		labels, ok := parseLabels(selector)
+		if !found || !ok || mode == "" {
+			return nil, errors.Errorf("invalid node affinity rule %q, expected 'label1=value1,label2=value2:mode'", rule)
+		}
+		nodeAffinities = append(nodeAffinities, &proto.NodeAffinity{
+			Labels: labels,
+			Mode:   mode,
+		})
+	}
+	return nodeAffinities, nil
+}
+
+func parseTolerations(rules []string) ([]*proto.Toleration, error) {
+	var tolerations []*proto.Toleration
+	for _, rule := range rules {
+		taint, effect, _ := strings.Cut(rule, ":")
+		key, value, _ := strings.Cut(taint, "=")
+		if key == "" {
+			return nil, errors.Errorf("invalid toleration %q, expected 'key[=value][:effect]'", rule)
+		}
+		tolerations = append(tolerations, &proto.Toleration{
+			Key:    key,
+			Value:  value,
+			Effect: effect,
+		})
+	}
+	return tolerations, nil
+}
+
+// parseLabels parses label values in the format 'label1=value1,label2=value2'.
+func parseLabels(selector string) (map[string]string, bool) {
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(selector, ",") {
+		label, value, found := strings.Cut(pair, "=")
+		if !found || label == "" || value == "" {
+			return nil, false
+		}
+		labels[label] = value
+	}
+	return labels, true
+}
@@ -0,0 +1,192 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func runCmd(cmd *cobra.Command, args string, stdin string) (string, error) {
+	actual := new(bytes.Buffer)
+	cmd.SetIn(bytes.NewBufferString(stdin))
+	cmd.SetOut(actual)
+	cmd.SetErr(actual)
+	cmd.SetArgs(strings.Split(args, " "))
+	err := cmd.Execute()
+	Config.Reset()
+	return strings.TrimSpace(actual.String()), err
+}
+
+func TestNamespaces_list(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	notificationsEnabled := true
+	common.MockedClient.On("ListNamespaces", &proto.ListNamespacesRequest{}).Return(&proto.ListNamespacesResponse{
+		Namespaces: []*proto.NamespaceInfo{{
+			Config: &proto.NamespaceConfig{
+				Name:                 "default",
+				InitialShardCount:    2,
+				ReplicationFactor:    3,
+				NotificationsEnabled: &notificationsEnabled,
+			},
+		}, {
+			Config: &proto.NamespaceConfig{
+				Name:              "ns-1",
+				InitialShardCount: 1,
+				ReplicationFactor: 1,
+				AntiAffinities:    []*proto.AntiAffinity{{Labels: []string{"zone"}, Mode: "Strict"}},
+				LeaderAffinities:  []*proto.LeaderAffinity{{Labels: map[string]string{"zone": "us-east-1a"}}},
+				NodeAffinities:    []*proto.NodeAffinity{{Labels: map[string]string{"disk": "ssd"}, Mode: "Required"}},
+				Tolerations:       []*proto.Toleration{{Key: "dedicated", Effect: "NoSchedule"}},
+			},
+			Dynamic: true,
+		}},
+	}, nil)
+
+	out, err := runCmd(Cmd, "list", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"default","initial_shard_count":2,"replication_factor":3,"notifications_enabled":true,"dynamic":false}
+{"name":"ns-1","initial_shard_count":1,"replication_factor":1,"notifications_enabled":false,"anti_affinities":[{"labels":["zone"],"mode":"Strict"}],`+
+		`"leader_affinities":[{"labels":{"zone":"us-east-1a"}}],"node_affinities":[{"labels":{"disk":"ssd"},"mode":"Required"}],`+
+		`"tolerations":[{"key":"dedicated","effect":"NoSchedule"}],"dynamic":true}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestNamespaces_describe(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	leader := "s1:6648"
+	common.MockedClient.On("DescribeNamespace", &proto.DescribeNamespaceRequest{Namespace: "ns-1"}).Return(&proto.DescribeNamespaceResponse{
+		Namespace: &proto.NamespaceInfo{
+			Config: &proto.NamespaceConfig{Name: "ns-1", InitialShardCount: 1, ReplicationFactor: 2},
+		},
+		Shards: []*proto.ShardInfo{{
+			Shard:          0,
+			Status:         "SteadyState",
+			Term:           3,
+			Leader:         &leader,
+			Ensemble:       []string{"s1:6648", "s2:6648"},
+			Int32HashRange: &proto.Int32HashRange{MinHashInclusive: 0, MaxHashInclusive: 4294967295},
+		}},
+	}, nil)
+
+	out, err := runCmd(Cmd, "describe ns-1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"ns-1","initial_shard_count":1,"replication_factor":2,"notifications_enabled":false,"dynamic":false,`+
+		`"shards":[{"namespace":"ns-1","shard":0,"status":"SteadyState","term":3,"leader":"s1:6648","ensemble":["s1:6648","s2:6648"],`+
+		`"min_hash_inclusive":0,"max_hash_inclusive":4294967295}]}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestNamespaces_create(t *testing.T) {
+	enabled := true
+	disabled := false
+
+	for _, test := range []struct {
+		name     string
+		args     string
+		expected *proto.NamespaceConfig
+	}{
+		{"defaults", "create --name ns-1", &proto.NamespaceConfig{
+			Name: "ns-1", InitialShardCount: 1, ReplicationFactor: 1, NotificationsEnabled: &enabled,
+			NotificationsIncludeValue: &disabled, NotificationsIncludePreviousValue: &disabled,
+		}},
+		{"all", "create --name ns-2 --shards 4 --replication-factor 3 --notifications=false " +
+			"--notifications-include-value --notifications-include-previous-value " +
+			"--anti-affinity zone,rack:Strict --anti-affinity host:Relaxed " +
+			"--leader-affinity zone=us-east-1a,rack=r1 --leader-affinity zone=us-east-1b " +
+			"--node-affinity disk=ssd:Required --node-affinity zone=us-east-1a,rack=r1:Preferred " +
+			"--toleration dedicated --toleration dedicated=critical:NoSchedule", &proto.NamespaceConfig{
+			Name: "ns-2", InitialShardCount: 4, ReplicationFactor: 3, NotificationsEnabled: &disabled,
+			NotificationsIncludeValue: &enabled, NotificationsIncludePreviousValue: &enabled,
+			AntiAffinities: []*proto.AntiAffinity{
+				{Labels: []string{"zone", "rack"}, Mode: "Strict"},
+				{Labels: []string{"host"}, Mode: "Relaxed"},
+			},
+			LeaderAffinities: []*proto.LeaderAffinity{
+				{Labels: map[string]string{"zone": "us-east-1a", "rack": "r1"}},
+				{Labels: map[string]string{"zone": "us-east-1b"}},
+			},
+			NodeAffinities: []*proto.NodeAffinity{
+				{Labels: map[string]string{"disk": "ssd"}, Mode: "Required"},
+				{Labels: map[string]string{"zone": "us-east-1a", "rack": "r1"}, Mode: "Preferred"},
+			},
+			Tolerations: []*proto.Toleration{
+				{Key: "dedicated"},
+				{Key: "dedicated", Value: "critical", Effect: "NoSchedule"},
+			},
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			common.MockedClient = common.NewMockAdminClient()
+			common.MockedClient.On("CreateNamespace", &proto.CreateNamespaceRequest{Config: test.expected}).Return(nil)
+
+			out, err := runCmd(Cmd, test.args, "")
+			assert.NoError(t, err)
+			assert.Empty(t, out)
+
+			common.MockedClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNamespaces_createInvalidAntiAffinity(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+
+	_, err := runCmd(Cmd, "create --name ns-1 --anti-affinity zone", "")
+	assert.ErrorContains(t, err, "invalid anti-affinity rule")
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestNamespaces_createInvalidLeaderAffinity(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+
+	_, err := runCmd(Cmd, "create --name ns-1 --leader-affinity zone", "")
+	assert.ErrorContains(t, err, "invalid leader affinity rule")
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestNamespaces_createInvalidNodeAffinity(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+
+	_, err := runCmd(Cmd, "create --name ns-1 --node-affinity disk=ssd", "")
+	assert.ErrorContains(t, err, "invalid node affinity rule")
+
+	_, err = runCmd(Cmd, "create --name ns-1 --toleration =ssd", "")
+	assert.ErrorContains(t, err, "invalid toleration")
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestNamespaces_delete(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("DeleteNamespace", &proto.DeleteNamespaceRequest{Namespace: "ns-1"}).Return(nil)
+
+	out, err := runCmd(Cmd, "delete ns-1", "")
+	assert.NoError(t, err)
+	assert.Empty(t, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
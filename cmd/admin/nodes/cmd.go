@@ -0,0 +1,210 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodes
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/proto"
+)
+
+const (
+	drainPhaseDrained = "Drained"
+	drainPhaseFailed  = "Failed"
+)
+
+var (
+	Config = flags{}
+)
+
+type flags struct {
+	wait         bool
+	pollInterval time.Duration
+}
+
+func (flags *flags) Reset() {
+	flags.wait = false
+	flags.pollInterval = time.Second
+}
+
+func init() {
+	drainCmd.Flags().BoolVarP(&Config.wait, "wait", "w", false, "Wait for the drain to complete, printing its progress")
+	drainCmd.Flags().DurationVar(&Config.pollInterval, "poll-interval", time.Second, "How often to check the progress of the drain, when waiting")
+
+	Cmd.AddCommand(listCmd)
+	Cmd.AddCommand(drainCmd)
+	Cmd.AddCommand(drainStatusCmd)
+	Cmd.AddCommand(cancelDrainCmd)
+}
+
+var Cmd = &cobra.Command{
+	Use:   "nodes",
+	Short: "Manage nodes",
+	Long:  `Inspect the nodes of the cluster and move the shard replicas away from them`,
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the nodes",
+	Long:  `List the nodes with their status and the number of shards they lead and replicate`,
+	Args:  cobra.NoArgs,
+	RunE:  execList,
+}
+
+var drainCmd = &cobra.Command{
+	Use:   "drain NODE",
+	Short: "Drain a node",
+	Long: `Move the leaderships and then the shard replicas of a node to the other nodes. The node is not
+selected for new leaders or replicas until it is removed from the cluster config, or the drain is canceled.
+The node can be safely shut down once the drain is completed`,
+	Args: cobra.ExactArgs(1),
+	RunE: execDrain,
+}
+
+var drainStatusCmd = &cobra.Command{
+	Use:   "drain-status NODE",
+	Short: "Show the progress of the drain of a node",
+	Args:  cobra.ExactArgs(1),
+	RunE:  execDrainStatus,
+}
+
+var cancelDrainCmd = &cobra.Command{
+	Use:   "cancel-drain NODE",
+	Short: "Cancel the drain of a node",
+	Long:  `Stop draining a node. The shards that were already moved out of the node are not moved back`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  execCancelDrain,
+}
+
+func execList(cmd *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.ListNodes(ctx, &proto.ListNodesRequest{})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range res.Nodes {
+		if err := common.WriteOutput(cmd.OutOrStdout(), common.OutputNode{
+			Id:              node.Id,
+			PublicAddress:   node.PublicAddress,
+			InternalAddress: node.InternalAddress,
+			Status:          node.Status,
+			LeaderShards:    node.LeaderShards,
+			ReplicaShards:   node.ReplicaShards,
+			Draining:        node.Draining,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func execDrain(cmd *cobra.Command, args []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.DrainNode(ctx, &proto.DrainNodeRequest{Node: args[0]})
+	if err != nil {
+		return err
+	}
+
+	status := res.Status
+	if err := common.WriteOutput(cmd.OutOrStdout(), common.ToOutputDrainStatus(status)); err != nil {
+		return err
+	}
+	if !Config.wait {
+		return nil
+	}
+
+	// Print the progress every time it changes, until the drain is over
+	for status.Phase != drainPhaseDrained && status.Phase != drainPhaseFailed {
+		select {
+		case <-time.After(Config.pollInterval):
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		}
+
+		newStatus, err := getDrainStatus(client, args[0])
+		if err != nil {
+			return err
+		}
+		if pb.Equal(status, newStatus) {
+			continue
+		}
+		status = newStatus
+		if err := common.WriteOutput(cmd.OutOrStdout(), common.ToOutputDrainStatus(status)); err != nil {
+			return err
+		}
+	}
+
+	if status.Phase == drainPhaseFailed {
+		return errors.Errorf("failed to drain node %s: %s", args[0], status.GetError())
+	}
+	return nil
+}
+
+func execDrainStatus(cmd *cobra.Command, args []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	status, err := getDrainStatus(client, args[0])
+	if err != nil {
+		return err
+	}
+	return common.WriteOutput(cmd.OutOrStdout(), common.ToOutputDrainStatus(status))
+}
+
+func execCancelDrain(_ *cobra.Command, args []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	_, err = client.CancelDrain(ctx, &proto.CancelDrainRequest{Node: args[0]})
+	return err
+}
+
+func getDrainStatus(client proto.OxiaAdminClient, node string) (*proto.DrainStatus, error) {
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.GetDrainStatus(ctx, &proto.GetDrainStatusRequest{Node: node})
+	if err != nil {
+		return nil, err
+	}
+	return res.Status, nil
+}
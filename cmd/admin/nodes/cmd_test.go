@@ -0,0 +1,133 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodes
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func runCmd(cmd *cobra.Command, args string, stdin string) (string, error) {
+	actual := new(bytes.Buffer)
+	cmd.SetIn(bytes.NewBufferString(stdin))
+	cmd.SetOut(actual)
+	cmd.SetErr(actual)
+	cmd.SetArgs(strings.Split(args, " "))
+	err := cmd.Execute()
+	Config.Reset()
+	return strings.TrimSpace(actual.String()), err
+}
+
+func TestNodes_list(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("ListNodes", &proto.ListNodesRequest{}).Return(&proto.ListNodesResponse{
+		Nodes: []*proto.NodeInfo{
+			{Id: "s1:6648", PublicAddress: "s1:6648", InternalAddress: "s1:6649", Status: "Running", LeaderShards: 1, ReplicaShards: 3},
+			{Id: "s2:6648", PublicAddress: "s2:6648", InternalAddress: "s2:6649", Status: "Running", Draining: true},
+		},
+	}, nil)
+
+	out, err := runCmd(Cmd, "list", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"s1:6648","public_address":"s1:6648","internal_address":"s1:6649","status":"Running","leader_shards":1,"replica_shards":3,"draining":false}
+{"id":"s2:6648","public_address":"s2:6648","internal_address":"s2:6649","status":"Running","leader_shards":0,"replica_shards":0,"draining":true}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestNodes_drain(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("DrainNode", &proto.DrainNodeRequest{Node: "s1:6649"}).Return(&proto.DrainNodeResponse{
+		Status: &proto.DrainStatus{Node: "s1:6649", Phase: "MovingLeaderships", LeaderShards: 1, ReplicaShards: 2, TotalShards: 2},
+	}, nil)
+
+	out, err := runCmd(Cmd, "drain s1:6649", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"node":"s1:6649","phase":"MovingLeaderships","leader_shards":1,"replica_shards":2,"total_shards":2,"safe_to_shutdown":false}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestNodes_drainWait(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		final    *proto.DrainStatus
+		expected string
+		err      string
+	}{
+		{"drained", &proto.DrainStatus{Node: "s1:6649", Phase: "Drained", TotalShards: 2, SafeToShutdown: true},
+			`{"node":"s1:6649","phase":"Drained","leader_shards":0,"replica_shards":0,"total_shards":2,"safe_to_shutdown":true}`, ""},
+		{"failed", &proto.DrainStatus{Node: "s1:6649", Phase: "Failed", ReplicaShards: 1, TotalShards: 2, Error: pb.String("no available node")},
+			`{"node":"s1:6649","phase":"Failed","leader_shards":0,"replica_shards":1,"total_shards":2,"error":"no available node","safe_to_shutdown":false}`,
+			"failed to drain node s1:6649: no available node"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			common.MockedClient = common.NewMockAdminClient()
+			common.MockedClient.On("DrainNode", &proto.DrainNodeRequest{Node: "s1:6649"}).Return(&proto.DrainNodeResponse{
+				Status: &proto.DrainStatus{Node: "s1:6649", Phase: "MovingReplicas", ReplicaShards: 2, TotalShards: 2},
+			}, nil)
+			// Unchanged progress is not printed again
+			common.MockedClient.On("GetDrainStatus", &proto.GetDrainStatusRequest{Node: "s1:6649"}).Return(&proto.GetDrainStatusResponse{
+				Status: &proto.DrainStatus{Node: "s1:6649", Phase: "MovingReplicas", ReplicaShards: 2, TotalShards: 2},
+			}, nil).Once()
+			common.MockedClient.On("GetDrainStatus", &proto.GetDrainStatusRequest{Node: "s1:6649"}).Return(&proto.GetDrainStatusResponse{
+				Status: test.final,
+			}, nil).Once()
+
+			out, err := runCmd(Cmd, "drain s1:6649 --wait --poll-interval 1ms", "")
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Contains(t, out, `{"node":"s1:6649","phase":"MovingReplicas","leader_shards":0,"replica_shards":2,"total_shards":2,"safe_to_shutdown":false}
+`+test.expected)
+
+			common.MockedClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNodes_drainStatus(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("GetDrainStatus", &proto.GetDrainStatusRequest{Node: "s1:6649"}).Return(&proto.GetDrainStatusResponse{
+		Status: &proto.DrainStatus{Node: "s1:6649", Phase: "Drained", TotalShards: 3, SafeToShutdown: true},
+	}, nil)
+
+	out, err := runCmd(Cmd, "drain-status s1:6649", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"node":"s1:6649","phase":"Drained","leader_shards":0,"replica_shards":0,"total_shards":3,"safe_to_shutdown":true}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestNodes_cancelDrain(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("CancelDrain", &proto.CancelDrainRequest{Node: "s1:6649"}).Return(nil)
+
+	out, err := runCmd(Cmd, "cancel-drain s1:6649", "")
+	assert.NoError(t, err)
+	assert.Empty(t, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
@@ -0,0 +1,183 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessions
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/proto"
+)
+
+var (
+	Config = flags{}
+)
+
+type flags struct {
+	namespace string
+	shard     int64
+}
+
+func (flags *flags) Reset() {
+	flags.namespace = ""
+	flags.shard = -1
+}
+
+func init() {
+	listCmd.Flags().StringVarP(&Config.namespace, "namespace", "n", "", "The namespace of the sessions")
+	listCmd.Flags().Int64Var(&Config.shard, "shard", -1, "Only list the sessions of the given shard")
+	_ = listCmd.MarkFlagRequired("namespace")
+
+	describeCmd.Flags().StringVarP(&Config.namespace, "namespace", "n", "", "The namespace of the session")
+	describeCmd.Flags().Int64Var(&Config.shard, "shard", -1, "The shard of the session")
+	_ = describeCmd.MarkFlagRequired("namespace")
+	_ = describeCmd.MarkFlagRequired("shard")
+
+	revokeCmd.Flags().StringVarP(&Config.namespace, "namespace", "n", "", "The namespace of the session")
+	revokeCmd.Flags().Int64Var(&Config.shard, "shard", -1, "The shard of the session")
+	_ = revokeCmd.MarkFlagRequired("namespace")
+	_ = revokeCmd.MarkFlagRequired("shard")
+
+	Cmd.AddCommand(listCmd)
+	Cmd.AddCommand(describeCmd)
+	Cmd.AddCommand(revokeCmd)
+}
+
+var Cmd = &cobra.Command{
+	Use:   "sessions",
+	Short: "Manage client sessions",
+	Long:  `Inspect the client sessions, the ephemeral records they own, and forcibly expire them`,
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the sessions",
+	Long:  `List the sessions of a namespace with their client identity, timeout and shard leader`,
+	Args:  cobra.NoArgs,
+	RunE:  execList,
+}
+
+var describeCmd = &cobra.Command{
+	Use:   "describe SESSION_ID",
+	Short: "Show a session",
+	Long:  `Show a session along with the keys of the ephemeral records it owns`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  execDescribe,
+}
+
+var revokeCmd = &cobra.Command{
+	Use:   "revoke SESSION_ID",
+	Short: "Revoke a session",
+	Long: `Forcibly expire a session, deleting all the ephemeral records it owns. The client gets a session
+not found error on its next heartbeat`,
+	Args: cobra.ExactArgs(1),
+	RunE: execRevoke,
+}
+
+func execList(cmd *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	req := &proto.ListSessionsRequest{Namespace: Config.namespace}
+	if Config.shard >= 0 {
+		req.Shard = &Config.shard
+	}
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.ListSessions(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range res.Sessions {
+		if err := common.WriteOutput(cmd.OutOrStdout(), common.ToOutputSession(Config.namespace, session)); err != nil {
+			return err
+		}
+	}
+
+	// The sessions of the other shards are still listed
+	for _, failure := range res.FailedShards {
+		cmd.PrintErrf("failed to list the sessions of shard %d: %s\n", failure.Shard, failure.Error)
+	}
+	if len(res.FailedShards) > 0 {
+		return errors.Errorf("failed to list the sessions of %d shards", len(res.FailedShards))
+	}
+	return nil
+}
+
+func execDescribe(cmd *cobra.Command, args []string) error {
+	sessionId, err := parseSessionId(args[0])
+	if err != nil {
+		return err
+	}
+
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.DescribeSession(ctx, &proto.DescribeSessionRequest{
+		Namespace: Config.namespace,
+		Shard:     Config.shard,
+		SessionId: sessionId,
+	})
+	if err != nil {
+		return err
+	}
+
+	session := common.ToOutputSession(Config.namespace, res.Session)
+	session.EphemeralKeys = res.EphemeralKeys
+	return common.WriteOutput(cmd.OutOrStdout(), session)
+}
+
+func execRevoke(_ *cobra.Command, args []string) error {
+	sessionId, err := parseSessionId(args[0])
+	if err != nil {
+		return err
+	}
+
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	_, err = client.RevokeSession(ctx, &proto.RevokeSessionRequest{
+		Namespace: Config.namespace,
+		Shard:     Config.shard,
+		SessionId: sessionId,
+	})
+	return err
+}
+
+func parseSessionId(arg string) (int64, error) {
+	sessionId, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid session id: %s", arg)
+	}
+	return sessionId, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessions
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func runCmd(cmd *cobra.Command, args string, stdin string) (string, error) {
+	actual := new(bytes.Buffer)
+	cmd.SetIn(bytes.NewBufferString(stdin))
+	cmd.SetOut(actual)
+	cmd.SetErr(actual)
+	cmd.SetArgs(strings.Split(args, " "))
+	err := cmd.Execute()
+	Config.Reset()
+	return strings.TrimSpace(actual.String()), err
+}
+
+func TestSessions_list(t *testing.T) {
+	for _, test := range []struct {
+		args    string
+		request *proto.ListSessionsRequest
+	}{
+		{"list -n my-ns", &proto.ListSessionsRequest{Namespace: "my-ns"}},
+		{"list -n my-ns --shard 1", &proto.ListSessionsRequest{Namespace: "my-ns", Shard: pb.Int64(1)}},
+	} {
+		t.Run(test.args, func(t *testing.T) {
+			common.MockedClient = common.NewMockAdminClient()
+			common.MockedClient.On("ListSessions", test.request).Return(&proto.ListSessionsResponse{
+				Sessions: []*proto.SessionInfo{
+					{Shard: 1, SessionId: 5, ClientIdentity: "client-1", TimeoutMs: 15000, Leader: "s1:6649"},
+					{Shard: 1, SessionId: 8, ClientIdentity: "client-2", TimeoutMs: 30000, Leader: "s1:6649"},
+				},
+			}, nil)
+
+			out, err := runCmd(Cmd, test.args, "")
+			assert.NoError(t, err)
+			assert.Equal(t, `{"namespace":"my-ns","shard":1,"session_id":5,"client_identity":"client-1","timeout_ms":15000,"leader":"s1:6649"}
+{"namespace":"my-ns","shard":1,"session_id":8,"client_identity":"client-2","timeout_ms":30000,"leader":"s1:6649"}`, out)
+
+			common.MockedClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSessions_listFailedShards(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("ListSessions", &proto.ListSessionsRequest{Namespace: "my-ns"}).Return(&proto.ListSessionsResponse{
+		Sessions: []*proto.SessionInfo{
+			{Shard: 1, SessionId: 5, ClientIdentity: "client-1", TimeoutMs: 15000, Leader: "s1:6649"},
+		},
+		FailedShards: []*proto.ShardFailure{{Shard: 2, Error: "shard leader not available"}},
+	}, nil)
+
+	out, err := runCmd(Cmd, "list -n my-ns", "")
+	assert.EqualError(t, err, "failed to list the sessions of 1 shards")
+	assert.Contains(t, out, `{"namespace":"my-ns","shard":1,"session_id":5,"client_identity":"client-1","timeout_ms":15000,"leader":"s1:6649"}
+failed to list the sessions of shard 2: shard leader not available`)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestSessions_describe(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("DescribeSession", &proto.DescribeSessionRequest{Namespace: "my-ns", Shard: 2, SessionId: 5}).Return(&proto.DescribeSessionResponse{
+		Session:       &proto.SessionInfo{Shard: 2, SessionId: 5, ClientIdentity: "client-1", TimeoutMs: 15000, Leader: "s2:6649"},
+		EphemeralKeys: []string{"/a", "/b/c"},
+	}, nil)
+
+	out, err := runCmd(Cmd, "describe 5 -n my-ns --shard 2", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"namespace":"my-ns","shard":2,"session_id":5,"client_identity":"client-1","timeout_ms":15000,"leader":"s2:6649","ephemeral_keys":["/a","/b/c"]}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestSessions_revoke(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("RevokeSession", &proto.RevokeSessionRequest{Namespace: "my-ns", Shard: 2, SessionId: 5}).Return(nil)
+
+	_, err := runCmd(Cmd, "revoke 5 -n my-ns --shard 2", "")
+	assert.NoError(t, err)
+
+	_, err = runCmd(Cmd, "revoke abc -n my-ns --shard 2", "")
+	assert.EqualError(t, err, "invalid session id: abc")
+
+	common.MockedClient.AssertExpectations(t)
+}
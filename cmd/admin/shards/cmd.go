@@ -0,0 +1,293 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shards
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/proto"
+)
+
+var (
+	Config = flags{}
+)
+
+type flags struct {
+	namespace  string
+	shard      int64
+	otherShard int64
+	from       string
+	to         string
+}
+
+func (flags *flags) Reset() {
+	flags.namespace = ""
+	flags.shard = -1
+	flags.otherShard = -1
+	flags.from = ""
+	flags.to = ""
+}
+
+func init() {
+	listCmd.Flags().StringVarP(&Config.namespace, "namespace", "n", "", "Only list the shards of the given namespace")
+
+	electCmd.Flags().StringVarP(&Config.namespace, "namespace", "n", "", "The namespace of the shard")
+	electCmd.Flags().Int64Var(&Config.shard, "shard", -1, "The shard id")
+	_ = electCmd.MarkFlagRequired("namespace")
+	_ = electCmd.MarkFlagRequired("shard")
+
+	transferLeaderCmd.Flags().StringVarP(&Config.namespace, "namespace", "n", "", "The namespace of the shard")
+	transferLeaderCmd.Flags().Int64Var(&Config.shard, "shard", -1, "The shard id")
+	transferLeaderCmd.Flags().StringVar(&Config.to, "to", "", "The node that becomes the leader")
+	_ = transferLeaderCmd.MarkFlagRequired("namespace")
+	_ = transferLeaderCmd.MarkFlagRequired("shard")
+	_ = transferLeaderCmd.MarkFlagRequired("to")
+
+	swapCmd.Flags().StringVarP(&Config.namespace, "namespace", "n", "", "The namespace of the shard")
+	swapCmd.Flags().Int64Var(&Config.shard, "shard", -1, "The shard id")
+	swapCmd.Flags().StringVar(&Config.from, "from", "", "The node to remove from the ensemble")
+	swapCmd.Flags().StringVar(&Config.to, "to", "", "The node to add to the ensemble")
+	_ = swapCmd.MarkFlagRequired("namespace")
+	_ = swapCmd.MarkFlagRequired("shard")
+	_ = swapCmd.MarkFlagRequired("from")
+	_ = swapCmd.MarkFlagRequired("to")
+
+	splitCmd.Flags().StringVarP(&Config.namespace, "namespace", "n", "", "The namespace of the shard")
+	splitCmd.Flags().Int64Var(&Config.shard, "shard", -1, "The shard id")
+	_ = splitCmd.MarkFlagRequired("namespace")
+	_ = splitCmd.MarkFlagRequired("shard")
+
+	mergeCmd.Flags().StringVarP(&Config.namespace, "namespace", "n", "", "The namespace of the shards")
+	mergeCmd.Flags().Int64Var(&Config.shard, "shard", -1, "The shard id")
+	mergeCmd.Flags().Int64Var(&Config.otherShard, "with", -1, "The id of the shard to merge with, which has an adjacent hash range")
+	_ = mergeCmd.MarkFlagRequired("namespace")
+	_ = mergeCmd.MarkFlagRequired("shard")
+	_ = mergeCmd.MarkFlagRequired("with")
+
+	Cmd.AddCommand(listCmd)
+	Cmd.AddCommand(electCmd)
+	Cmd.AddCommand(transferLeaderCmd)
+	Cmd.AddCommand(swapCmd)
+	Cmd.AddCommand(splitCmd)
+	Cmd.AddCommand(mergeCmd)
+}
+
+var Cmd = &cobra.Command{
+	Use:   "shards",
+	Short: "Manage shards",
+	Long:  `Inspect the shards of the cluster, trigger leader elections and move shard replicas between nodes`,
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the shards",
+	Long:  `List the shards with their status, term, leader and ensemble`,
+	Args:  cobra.NoArgs,
+	RunE:  execList,
+}
+
+var electCmd = &cobra.Command{
+	Use:   "elect",
+	Short: "Trigger a leader election",
+	Long:  `Trigger a new leader election for a shard, bumping its term`,
+	Args:  cobra.NoArgs,
+	RunE:  execElect,
+}
+
+var transferLeaderCmd = &cobra.Command{
+	Use:   "transfer-leader",
+	Short: "Move the leadership of a shard",
+	Long:  `Move the leadership of a shard to another node of its ensemble, without failing the writes in progress`,
+	Args:  cobra.NoArgs,
+	RunE:  execTransferLeader,
+}
+
+var swapCmd = &cobra.Command{
+	Use:   "swap",
+	Short: "Move a shard replica",
+	Long:  `Move the replica of a shard from one node to another`,
+	Args:  cobra.NoArgs,
+	RunE:  execSwap,
+}
+
+var splitCmd = &cobra.Command{
+	Use:   "split",
+	Short: "Split a shard",
+	Long:  `Split the hash range of a shard into two new shards, which replace it once its data is copied`,
+	Args:  cobra.NoArgs,
+	RunE:  execSplit,
+}
+
+var mergeCmd = &cobra.Command{
+	Use:   "merge",
+	Short: "Merge two shards",
+	Long:  `Merge two shards with adjacent hash ranges into a new shard, which replaces them once their data is copied`,
+	Args:  cobra.NoArgs,
+	RunE:  execMerge,
+}
+
+func execList(cmd *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+
+	var namespaces []string
+	if Config.namespace != "" {
+		namespaces = append(namespaces, Config.namespace)
+	} else {
+		res, err := client.ListNamespaces(ctx, &proto.ListNamespacesRequest{})
+		if err != nil {
+			return err
+		}
+		for _, ns := range res.Namespaces {
+			namespaces = append(namespaces, ns.Config.Name)
+		}
+	}
+
+	for _, namespace := range namespaces {
+		res, err := client.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: namespace})
+		if err != nil {
+			return err
+		}
+		for _, shard := range res.Shards {
+			if err := common.WriteOutput(cmd.OutOrStdout(), common.ToOutputShard(namespace, shard)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func execElect(cmd *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.ElectLeader(ctx, &proto.ElectLeaderRequest{
+		Namespace: Config.namespace,
+		Shard:     Config.shard,
+	})
+	if err != nil {
+		return err
+	}
+
+	return common.WriteOutput(cmd.OutOrStdout(), common.OutputLeader{
+		Namespace: Config.namespace,
+		Shard:     Config.shard,
+		Leader:    res.Leader,
+	})
+}
+
+func execTransferLeader(cmd *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.TransferLeader(ctx, &proto.TransferLeaderRequest{
+		Namespace: Config.namespace,
+		Shard:     Config.shard,
+		To:        Config.to,
+	})
+	if err != nil {
+		return err
+	}
+
+	return common.WriteOutput(cmd.OutOrStdout(), common.OutputLeader{
+		Namespace: Config.namespace,
+		Shard:     Config.shard,
+		Leader:    res.Leader,
+	})
+}
+
+func execSwap(_ *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	_, err = client.SwapNode(ctx, &proto.SwapNodeRequest{
+		Namespace: Config.namespace,
+		Shard:     Config.shard,
+		From:      Config.from,
+		To:        Config.to,
+	})
+	return err
+}
+
+func execSplit(cmd *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.Split(ctx, &proto.SplitRequest{
+		Namespace: Config.namespace,
+		Shard:     Config.shard,
+	})
+	if err != nil {
+		return err
+	}
+
+	return common.WriteOutput(cmd.OutOrStdout(), common.OutputSplit{
+		Namespace: Config.namespace,
+		Shard:     Config.shard,
+		Children:  res.Children,
+	})
+}
+
+func execMerge(cmd *cobra.Command, _ []string) error {
+	client, closer, err := common.Config.NewAdminClient()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	ctx, cancel := common.Config.NewContext()
+	defer cancel()
+	res, err := client.Merge(ctx, &proto.MergeRequest{
+		Namespace:  Config.namespace,
+		Shard:      Config.shard,
+		OtherShard: Config.otherShard,
+	})
+	if err != nil {
+		return err
+	}
+
+	return common.WriteOutput(cmd.OutOrStdout(), common.OutputMerge{
+		Namespace: Config.namespace,
+		Shards:    []int64{Config.shard, Config.otherShard},
+		Merged:    res.Shard,
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shards
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/cmd/admin/common"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func runCmd(cmd *cobra.Command, args string, stdin string) (string, error) {
+	actual := new(bytes.Buffer)
+	cmd.SetIn(bytes.NewBufferString(stdin))
+	cmd.SetOut(actual)
+	cmd.SetErr(actual)
+	cmd.SetArgs(strings.Split(args, " "))
+	err := cmd.Execute()
+	Config.Reset()
+	return strings.TrimSpace(actual.String()), err
+}
+
+func TestShards_list(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	leader := "s1:6648"
+	common.MockedClient.On("ListNamespaces", &proto.ListNamespacesRequest{}).Return(&proto.ListNamespacesResponse{
+		Namespaces: []*proto.NamespaceInfo{
+			{Config: &proto.NamespaceConfig{Name: "ns-1"}},
+			{Config: &proto.NamespaceConfig{Name: "ns-2"}},
+		},
+	}, nil)
+	common.MockedClient.On("DescribeNamespace", &proto.DescribeNamespaceRequest{Namespace: "ns-1"}).Return(&proto.DescribeNamespaceResponse{
+		Namespace: &proto.NamespaceInfo{Config: &proto.NamespaceConfig{Name: "ns-1"}},
+		Shards: []*proto.ShardInfo{{
+			Shard: 0, Status: "SteadyState", Term: 1, Leader: &leader, Ensemble: []string{"s1:6648"},
+			Int32HashRange: &proto.Int32HashRange{MinHashInclusive: 0, MaxHashInclusive: 10},
+		}},
+	}, nil)
+	common.MockedClient.On("DescribeNamespace", &proto.DescribeNamespaceRequest{Namespace: "ns-2"}).Return(&proto.DescribeNamespaceResponse{
+		Namespace: &proto.NamespaceInfo{Config: &proto.NamespaceConfig{Name: "ns-2"}},
+		Shards: []*proto.ShardInfo{{
+			Shard: 1, Status: "Election", Term: 2, Ensemble: []string{"s2:6648"},
+			Int32HashRange: &proto.Int32HashRange{MinHashInclusive: 0, MaxHashInclusive: 10},
+		}},
+	}, nil)
+
+	out, err := runCmd(Cmd, "list", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"namespace":"ns-1","shard":0,"status":"SteadyState","term":1,"leader":"s1:6648","ensemble":["s1:6648"],"min_hash_inclusive":0,"max_hash_inclusive":10}
+{"namespace":"ns-2","shard":1,"status":"Election","term":2,"ensemble":["s2:6648"],"min_hash_inclusive":0,"max_hash_inclusive":10}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestShards_listNamespace(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("DescribeNamespace", &proto.DescribeNamespaceRequest{Namespace: "ns-2"}).Return(&proto.DescribeNamespaceResponse{
+		Namespace: &proto.NamespaceInfo{Config: &proto.NamespaceConfig{Name: "ns-2"}},
+		Shards: []*proto.ShardInfo{{
+			Shard: 1, Status: "SteadyState", Term: 2, Ensemble: []string{"s2:6648"},
+			Int32HashRange: &proto.Int32HashRange{MinHashInclusive: 0, MaxHashInclusive: 10},
+		}},
+	}, nil)
+
+	out, err := runCmd(Cmd, "list -n ns-2", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"namespace":"ns-2","shard":1,"status":"SteadyState","term":2,"ensemble":["s2:6648"],"min_hash_inclusive":0,"max_hash_inclusive":10}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestShards_elect(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("ElectLeader", &proto.ElectLeaderRequest{Namespace: "ns-1", Shard: 2}).
+		Return(&proto.ElectLeaderResponse{Leader: "s3:6649"}, nil)
+
+	out, err := runCmd(Cmd, "elect -n ns-1 --shard 2", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"namespace":"ns-1","shard":2,"leader":"s3:6649"}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestShards_transferLeader(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("TransferLeader", &proto.TransferLeaderRequest{Namespace: "ns-1", Shard: 2, To: "s2:6649"}).
+		Return(&proto.TransferLeaderResponse{Leader: "s2:6649"}, nil)
+
+	out, err := runCmd(Cmd, "transfer-leader -n ns-1 --shard 2 --to s2:6649", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"namespace":"ns-1","shard":2,"leader":"s2:6649"}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestShards_swap(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("SwapNode", &proto.SwapNodeRequest{Namespace: "ns-1", Shard: 2, From: "s1", To: "s4"}).Return(nil)
+
+	out, err := runCmd(Cmd, "swap -n ns-1 --shard 2 --from s1 --to s4", "")
+	assert.NoError(t, err)
+	assert.Empty(t, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestShards_split(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("Split", &proto.SplitRequest{Namespace: "ns-1", Shard: 2}).
+		Return(&proto.SplitResponse{Children: []int64{5, 6}}, nil)
+
+	out, err := runCmd(Cmd, "split -n ns-1 --shard 2", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"namespace":"ns-1","shard":2,"children":[5,6]}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
+
+func TestShards_merge(t *testing.T) {
+	common.MockedClient = common.NewMockAdminClient()
+	common.MockedClient.On("Merge", &proto.MergeRequest{Namespace: "ns-1", Shard: 2, OtherShard: 3}).
+		Return(&proto.MergeResponse{Shard: 7}, nil)
+
+	out, err := runCmd(Cmd, "merge -n ns-1 --shard 2 --with 3", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"namespace":"ns-1","shards":[2,3],"merged":7}`, out)
+
+	common.MockedClient.AssertExpectations(t)
+}
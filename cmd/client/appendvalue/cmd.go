@@ -0,0 +1,99 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appendvalue
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/oxia-db/oxia/cmd/client/common"
+	"github.com/oxia-db/oxia/oxia"
+)
+
+var (
+	Config = flags{}
+)
+
+type flags struct {
+	readValueFromStdIn bool
+	partitionKey       string
+}
+
+func (flags *flags) Reset() {
+	flags.readValueFromStdIn = false
+	flags.partitionKey = ""
+}
+
+func init() {
+	Cmd.Flags().BoolVarP(&Config.readValueFromStdIn, "std-in", "c", false, "Read value from stdin")
+	Cmd.Flags().StringVarP(&Config.partitionKey, "partition-key", "p", "", "Partition Key to be used in override the shard routing")
+}
+
+var Cmd = &cobra.Command{
+	Use:          "append [flags] KEY [VALUE]",
+	Short:        "Append to a value",
+	Long:         `Atomically append bytes to the value of the record with the given key, creating the record if it does not exist. The record keeps its secondary indexes, its session and its expiration`,
+	Args:         cobra.RangeArgs(1, 2),
+	RunE:         exec,
+	SilenceUsage: true,
+}
+
+func exec(cmd *cobra.Command, args []string) error {
+	client, err := common.Config.NewClient()
+	if err != nil {
+		return err
+	}
+
+	key := args[0]
+	var value []byte
+	if len(args) == 2 { //nolint:gocritic
+		// We have a value specified as argument
+		if Config.readValueFromStdIn {
+			return errors.New("the value can either be provided as argument or read from std-in")
+		}
+		value = []byte(args[1])
+	} else if len(args) == 1 && !Config.readValueFromStdIn {
+		return errors.New("no value provided")
+	} else {
+		if value, err = io.ReadAll(cmd.InOrStdin()); err != nil {
+			return err
+		}
+	}
+
+	var options []oxia.AppendOption
+	if Config.partitionKey != "" {
+		options = append(options, oxia.PartitionKey(Config.partitionKey))
+	}
+
+	version, err := client.Append(context.Background(), key, value, options...)
+	if err != nil {
+		return err
+	}
+
+	common.WriteOutput(cmd.OutOrStdout(), common.OutputVersion{
+		Key:                key,
+		VersionId:          version.VersionId,
+		CreatedTimestamp:   time.UnixMilli(int64(version.CreatedTimestamp)),
+		ModifiedTimestamp:  time.UnixMilli(int64(version.ModifiedTimestamp)),
+		ModificationsCount: version.ModificationsCount,
+		Ephemeral:          version.Ephemeral,
+		ClientIdentity:     version.ClientIdentity,
+	})
+	return nil
+}
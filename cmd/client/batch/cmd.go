@@ -0,0 +1,143 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/oxia-db/oxia/cmd/client/common"
+	"github.com/oxia-db/oxia/oxia"
+)
+
+var (
+	Config = flags{}
+)
+
+type flags struct {
+	partitionKey string
+}
+
+func (flags *flags) Reset() {
+	flags.partitionKey = ""
+}
+
+func init() {
+	Cmd.Flags().StringVarP(&Config.partitionKey, "partition-key", "p", "", "Partition Key to be used in override the shard routing of all the operations")
+}
+
+var Cmd = &cobra.Command{
+	Use:   "batch [flags]",
+	Short: "Apply an atomic batch of operations",
+	Long: `Apply atomically a batch of put and delete operations, read from std-in as one JSON object per line. ` +
+		`Either all the operations are applied, or none of them is. All the records must be stored in the same shard. ` +
+		`Example: {"put": {"key": "a", "value": "1", "expected_version": 0}} {"delete": {"key": "b", "expected_value": "2"}}`,
+	Args:         cobra.NoArgs,
+	RunE:         exec,
+	SilenceUsage: true,
+}
+
+type operation struct {
+	Put    *putOperation    `json:"put,omitempty"`
+	Delete *deleteOperation `json:"delete,omitempty"`
+}
+
+type putOperation struct {
+	Key                  string  `json:"key"`
+	Value                string  `json:"value"`
+	ExpectedVersion      *int64  `json:"expected_version,omitempty"`
+	ExpectedValue        *string `json:"expected_value,omitempty"`
+	ExpectedRecordExists bool    `json:"expected_record_exists,omitempty"`
+	PartitionKey         *string `json:"partition_key,omitempty"`
+}
+
+type deleteOperation struct {
+	Key             string  `json:"key"`
+	ExpectedVersion *int64  `json:"expected_version,omitempty"`
+	ExpectedValue   *string `json:"expected_value,omitempty"`
+	PartitionKey    *string `json:"partition_key,omitempty"`
+}
+
+func exec(cmd *cobra.Command, _ []string) error {
+	client, err := common.Config.NewClient()
+	if err != nil {
+		return err
+	}
+
+	batch := client.NewAtomicBatch()
+	decoder := json.NewDecoder(bufio.NewReader(cmd.InOrStdin()))
+	for decoder.More() {
+		op := operation{}
+		if err = decoder.Decode(&op); err != nil {
+			return errors.Wrap(err, "failed to parse operation")
+		}
+
+		switch {
+		case op.Put != nil && op.Delete == nil:
+			err = batch.Put(op.Put.Key, []byte(op.Put.Value), putOptions(op.Put)...)
+		case op.Delete != nil && op.Put == nil:
+			err = batch.Delete(op.Delete.Key, deleteOptions(op.Delete)...)
+		default:
+			return errors.New("each operation must be either a put or a delete")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return batch.Commit(context.Background())
+}
+
+func putOptions(op *putOperation) []oxia.PutOption {
+	var options []oxia.PutOption
+	if op.ExpectedVersion != nil {
+		options = append(options, oxia.ExpectedVersionId(*op.ExpectedVersion))
+	}
+	if op.ExpectedValue != nil {
+		options = append(options, oxia.ExpectedValue([]byte(*op.ExpectedValue)))
+	}
+	if op.ExpectedRecordExists {
+		options = append(options, oxia.ExpectedRecordExists())
+	}
+	if partitionKey := getPartitionKey(op.PartitionKey); partitionKey != "" {
+		options = append(options, oxia.PartitionKey(partitionKey))
+	}
+	return options
+}
+
+func deleteOptions(op *deleteOperation) []oxia.DeleteOption {
+	var options []oxia.DeleteOption
+	if op.ExpectedVersion != nil {
+		options = append(options, oxia.ExpectedVersionId(*op.ExpectedVersion))
+	}
+	if op.ExpectedValue != nil {
+		options = append(options, oxia.ExpectedValue([]byte(*op.ExpectedValue)))
+	}
+	if partitionKey := getPartitionKey(op.PartitionKey); partitionKey != "" {
+		options = append(options, oxia.PartitionKey(partitionKey))
+	}
+	return options
+}
+
+func getPartitionKey(partitionKey *string) string {
+	if partitionKey != nil {
+		return *partitionKey
+	}
+	return Config.partitionKey
+}
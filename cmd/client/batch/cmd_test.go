@@ -0,0 +1,70 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/cmd/client/common"
+	"github.com/oxia-db/oxia/oxia"
+)
+
+func runCmd(cmd *cobra.Command, args []string, stdin string) (string, error) {
+	actual := new(bytes.Buffer)
+	cmd.SetIn(bytes.NewBufferString(stdin))
+	cmd.SetOut(actual)
+	cmd.SetErr(actual)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	Config.Reset()
+	return actual.String(), err
+}
+
+func TestBatch_exec(t *testing.T) {
+	var emptyPutOptions []oxia.PutOption
+	common.MockedClient = common.NewMockClient()
+	batch := common.NewMockAtomicBatch()
+	common.MockedClient.On("NewAtomicBatch").Return(batch)
+
+	batch.On("Put", "a", []byte("1"), emptyPutOptions).Return(nil).Once()
+	batch.On("Put", "b", []byte("2"), []oxia.PutOption{oxia.ExpectedVersionId(5), oxia.ExpectedRecordExists()}).Return(nil).Once()
+	batch.On("Delete", "c", []oxia.DeleteOption{oxia.ExpectedVersionId(3)}).Return(nil).Once()
+	batch.On("Commit").Return(nil).Once()
+	_, err := runCmd(Cmd, nil, `{"put": {"key": "a", "value": "1"}}
+{"put": {"key": "b", "value": "2", "expected_version": 5, "expected_record_exists": true}}
+{"delete": {"key": "c", "expected_version": 3}}`)
+	assert.NoError(t, err)
+
+	batch.On("Put", "a", []byte("1"), []oxia.PutOption{oxia.PartitionKey("x")}).Return(nil).Once()
+	batch.On("Delete", "c", []oxia.DeleteOption{oxia.PartitionKey("y")}).Return(nil).Once()
+	batch.On("Commit").Return(errors.New("unexpected version id")).Once()
+	_, err = runCmd(Cmd, []string{"-p", "x"}, `{"put": {"key": "a", "value": "1"}}
+{"delete": {"key": "c", "partition_key": "y"}}`)
+	assert.EqualError(t, err, "unexpected version id")
+
+	_, err = runCmd(Cmd, nil, `{"put": {"key": "a", "value": "1"}, "delete": {"key": "a"}}`)
+	assert.Error(t, err)
+
+	_, err = runCmd(Cmd, nil, `{"put": `)
+	assert.Error(t, err)
+
+	common.MockedClient.AssertExpectations(t)
+	batch.AssertExpectations(t)
+}
@@ -22,11 +22,16 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/oxia-db/oxia/cmd/client/appendvalue"
+	"github.com/oxia-db/oxia/cmd/client/batch"
 	"github.com/oxia-db/oxia/cmd/client/common"
+	"github.com/oxia-db/oxia/cmd/client/count"
 	"github.com/oxia-db/oxia/cmd/client/del"
 	"github.com/oxia-db/oxia/cmd/client/deleterange"
 	"github.com/oxia-db/oxia/cmd/client/get"
+	"github.com/oxia-db/oxia/cmd/client/increment"
 	"github.com/oxia-db/oxia/cmd/client/list"
+	"github.com/oxia-db/oxia/cmd/client/mergepatch"
 	"github.com/oxia-db/oxia/cmd/client/notifications"
 	"github.com/oxia-db/oxia/cmd/client/put"
 	"github.com/oxia-db/oxia/cmd/client/rangescan"
@@ -52,7 +57,12 @@ func init() {
 	Cmd.AddCommand(get.Cmd)
 	Cmd.AddCommand(list.Cmd)
 	Cmd.AddCommand(rangescan.Cmd)
+	Cmd.AddCommand(count.Cmd)
 	Cmd.AddCommand(deleterange.Cmd)
+	Cmd.AddCommand(increment.Cmd)
+	Cmd.AddCommand(appendvalue.Cmd)
+	Cmd.AddCommand(mergepatch.Cmd)
 	Cmd.AddCommand(notifications.Cmd)
 	Cmd.AddCommand(sequenceupdates.Cmd)
+	Cmd.AddCommand(batch.Cmd)
 }
@@ -62,6 +62,14 @@ func TestClientCmd(t *testing.T) {
 		{"delete-not-exist", "delete does-not-exist", "", "", "Error: key not found", true},
 		{"delete-unexpected-version", "delete k-put -e 9", "", "", "Error: unexpected version id", true},
 		{"delete-expected-version", "delete k-put -e 1", "", "", "", false},
+		{"batch", "batch", `{"put": {"key": "k-batch-a", "value": "a"}}
+			{"put": {"key": "k-batch-b", "value": "b", "expected_version": -1}}`, "", "", false},
+		{"batch-unexpected-value", "batch", `{"delete": {"key": "k-batch-a", "expected_value": "a"}}
+			{"put": {"key": "k-batch-b", "value": "c", "expected_value": "x"}}`, "", "Error: unexpected value", true},
+		{"batch-get", "get k-batch-a", "", "a\n", "", false},
+		{"batch-expected-value", "batch", `{"delete": {"key": "k-batch-a", "expected_value": "a"}}
+			{"put": {"key": "k-batch-b", "value": "c", "expected_value": "b"}}`, "", "", false},
+		{"batch-get-updated", "get k-batch-b", "", "c\n", "", false},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			put.Config.Reset()
@@ -52,6 +52,25 @@ func (m *MockClient) DeleteRange(_ context.Context, minKeyInclusive string, maxK
 	return args.Error(0)
 }
 
+func (m *MockClient) Increment(_ context.Context, key string, delta int64, options ...oxia.IncrementOption) (value int64, version oxia.Version, err error) {
+	args := m.MethodCalled("Increment", key, delta, options)
+	arg0, ok := args.Get(0).(int64)
+	if !ok {
+		panic("cast failed")
+	}
+	return arg0, oxia.Version{}, args.Error(1)
+}
+
+func (m *MockClient) Append(_ context.Context, key string, value []byte, options ...oxia.AppendOption) (oxia.Version, error) {
+	args := m.MethodCalled("Append", key, value, options)
+	return oxia.Version{}, args.Error(0)
+}
+
+func (m *MockClient) MergePatch(_ context.Context, key string, patch []byte, options ...oxia.MergePatchOption) (oxia.Version, error) {
+	args := m.MethodCalled("MergePatch", key, patch, options)
+	return oxia.Version{}, args.Error(0)
+}
+
 func (m *MockClient) Get(_ context.Context, key string, options ...oxia.GetOption) (storedKey string, value []byte, version oxia.Version, err error) {
 	args := m.MethodCalled("Get", key, options)
 	arg1, ok := args.Get(1).([]byte)
@@ -79,10 +98,55 @@ func (m *MockClient) RangeScan(_ context.Context, minKeyInclusive string, maxKey
 	return arg0
 }
 
-func (*MockClient) GetNotifications() (oxia.Notifications, error) {
+func (m *MockClient) RangeStats(_ context.Context, minKeyInclusive string, maxKeyExclusive string, options ...oxia.ListOption) (oxia.RangeStats, error) {
+	args := m.MethodCalled("RangeStats", minKeyInclusive, maxKeyExclusive, options)
+	arg0, ok := args.Get(0).(oxia.RangeStats)
+	if !ok {
+		panic("cast failed")
+	}
+	return arg0, args.Error(1)
+}
+
+func (*MockClient) GetNotifications(...oxia.NotificationsOption) (oxia.Notifications, error) {
 	return nil, errors.New("not implemented in mock")
 }
 
 func (*MockClient) GetSequenceUpdates(context.Context, string, ...oxia.GetSequenceUpdatesOption) (<-chan string, error) {
 	return nil, errors.New("not implemented in mock")
 }
+
+func (*MockClient) Begin(...oxia.TransactionOption) oxia.Transaction {
+	panic("not implemented in mock")
+}
+
+func (m *MockClient) NewAtomicBatch() oxia.AtomicBatch {
+	args := m.MethodCalled("NewAtomicBatch")
+	return args.Get(0).(oxia.AtomicBatch)
+}
+
+func (*MockClient) Namespace(string) (oxia.SyncClient, error) {
+	panic("not implemented in mock")
+}
+
+type MockAtomicBatch struct {
+	mock.Mock
+}
+
+func NewMockAtomicBatch() *MockAtomicBatch {
+	return &MockAtomicBatch{}
+}
+
+func (m *MockAtomicBatch) Put(key string, value []byte, options ...oxia.PutOption) error {
+	args := m.MethodCalled("Put", key, value, options)
+	return args.Error(0)
+}
+
+func (m *MockAtomicBatch) Delete(key string, options ...oxia.DeleteOption) error {
+	args := m.MethodCalled("Delete", key, options)
+	return args.Error(0)
+}
+
+func (m *MockAtomicBatch) Commit(context.Context) error {
+	args := m.MethodCalled("Commit")
+	return args.Error(0)
+}
@@ -31,3 +31,16 @@ type OutputVersion struct {
 type OutputError struct {
 	Err string `json:"error,omitempty"`
 }
+
+type OutputRangeStats struct {
+	Count          uint64 `json:"count"`
+	TotalValueSize uint64 `json:"total_value_size"`
+	MinKey         string `json:"min_key,omitempty"`
+	MaxKey         string `json:"max_key,omitempty"`
+}
+
+type OutputCounter struct {
+	Key       string `json:"key"`
+	Value     int64  `json:"value"`
+	VersionId int64  `json:"version_id"`
+}
@@ -0,0 +1,89 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package increment
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/oxia-db/oxia/cmd/client/common"
+	"github.com/oxia-db/oxia/oxia"
+)
+
+var (
+	Config = flags{}
+)
+
+type flags struct {
+	delta        int64
+	minValue     int64
+	maxValue     int64
+	partitionKey string
+}
+
+func (flags *flags) Reset() {
+	flags.delta = 1
+	flags.minValue = 0
+	flags.maxValue = 0
+	flags.partitionKey = ""
+}
+
+func init() {
+	Cmd.Flags().Int64VarP(&Config.delta, "delta", "d", 1, "Value to add to the counter, negative to decrement it")
+	Cmd.Flags().Int64Var(&Config.minValue, "min", 0, "Lowest value that the counter is allowed to reach")
+	Cmd.Flags().Int64Var(&Config.maxValue, "max", 0, "Highest value that the counter is allowed to reach")
+	Cmd.Flags().StringVarP(&Config.partitionKey, "partition-key", "p", "", "Partition Key to be used in override the shard routing")
+}
+
+var Cmd = &cobra.Command{
+	Use:          "increment [flags] KEY",
+	Short:        "Increment a counter",
+	Long:         `Atomically add a delta to the counter stored with the given key and print its new value. A counter that does not exist starts from 0. If bounds are provided, the increment will only take place if the new value is within them`,
+	Args:         cobra.ExactArgs(1),
+	RunE:         exec,
+	SilenceUsage: true,
+}
+
+func exec(cmd *cobra.Command, args []string) error {
+	client, err := common.Config.NewClient()
+	if err != nil {
+		return err
+	}
+
+	var options []oxia.IncrementOption
+	if cmd.Flags().Changed("min") {
+		options = append(options, oxia.MinValue(Config.minValue))
+	}
+	if cmd.Flags().Changed("max") {
+		options = append(options, oxia.MaxValue(Config.maxValue))
+	}
+	if Config.partitionKey != "" {
+		options = append(options, oxia.PartitionKey(Config.partitionKey))
+	}
+
+	key := args[0]
+	value, version, err := client.Increment(context.Background(), key, Config.delta, options...)
+	if err != nil {
+		return err
+	}
+
+	common.WriteOutput(cmd.OutOrStdout(), common.OutputCounter{
+		Key:       key,
+		Value:     value,
+		VersionId: version.VersionId,
+	})
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package increment
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/cmd/client/common"
+	"github.com/oxia-db/oxia/oxia"
+)
+
+func runCmd(cmd *cobra.Command, args string) (string, error) {
+	actual := new(bytes.Buffer)
+	cmd.SetOut(actual)
+	cmd.SetErr(actual)
+	cmd.SetArgs(strings.Split(args, " "))
+	err := cmd.Execute()
+	Config.Reset()
+	for _, name := range []string{"min", "max"} {
+		cmd.Flags().Lookup(name).Changed = false
+	}
+	return strings.TrimSpace(actual.String()), err
+}
+
+func TestIncrement_exec(t *testing.T) {
+	var emptyOptions []oxia.IncrementOption
+
+	for _, test := range []struct {
+		name               string
+		args               string
+		expectedParameters []any
+	}{
+		{"default-delta", "my-key", []any{"my-key", int64(1), emptyOptions}},
+		{"delta", "my-key --delta 5", []any{"my-key", int64(5), emptyOptions}},
+		{"negative-delta", "my-key -d -2", []any{"my-key", int64(-2), emptyOptions}},
+		{"bounds", "my-key -d -2 --min 0 --max 10", []any{"my-key", int64(-2),
+			[]oxia.IncrementOption{oxia.MinValue(0), oxia.MaxValue(10)}}},
+		{"partition-key", "my-key -p xyz", []any{"my-key", int64(1),
+			[]oxia.IncrementOption{oxia.PartitionKey("xyz")}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			common.MockedClient = common.NewMockClient()
+
+			common.MockedClient.On("Increment", test.expectedParameters...).Return(int64(3), nil)
+			out, err := runCmd(Cmd, test.args)
+			assert.NoError(t, err)
+			assert.Equal(t, `{"key":"my-key","value":3,"version_id":0}`, out)
+
+			common.MockedClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestIncrement_error(t *testing.T) {
+	common.MockedClient = common.NewMockClient()
+
+	var emptyOptions []oxia.IncrementOption
+	common.MockedClient.On("Increment", "my-key", int64(1), emptyOptions).Return(int64(0), oxia.ErrValueOutOfBounds)
+	out, err := runCmd(Cmd, "my-key")
+	assert.ErrorIs(t, err, oxia.ErrValueOutOfBounds)
+	assert.Equal(t, "Error: value out of bounds", out)
+
+	common.MockedClient.AssertExpectations(t)
+}
@@ -0,0 +1,72 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergepatch
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/cmd/client/common"
+	"github.com/oxia-db/oxia/oxia"
+)
+
+func runCmd(cmd *cobra.Command, args string, stdin string) (string, error) {
+	actual := new(bytes.Buffer)
+	cmd.SetIn(bytes.NewBufferString(stdin))
+	cmd.SetOut(actual)
+	cmd.SetErr(actual)
+	cmd.SetArgs(strings.Split(args, " "))
+	err := cmd.Execute()
+	Config.Reset()
+	return strings.TrimSpace(actual.String()), err
+}
+
+func TestMergePatch_exec(t *testing.T) {
+	var emptyOptions []oxia.MergePatchOption
+	for _, test := range []struct {
+		name               string
+		args               string
+		stdin              string
+		expectedParameters []any
+	}{
+		{"entry", `x {"a":1}`, "", []any{"x", []byte(`{"a":1}`), emptyOptions}},
+		{"stdin", "x -c", `{"a":1}`, []any{"x", []byte(`{"a":1}`), emptyOptions}},
+		{"partition-key", `x {"a":1} -p abc`, "", []any{"x", []byte(`{"a":1}`), []oxia.MergePatchOption{oxia.PartitionKey("abc")}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			common.MockedClient = common.NewMockClient()
+
+			common.MockedClient.On("MergePatch", test.expectedParameters...).Return(nil)
+			_, err := runCmd(Cmd, test.args, test.stdin)
+			assert.NoError(t, err)
+
+			common.MockedClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMergePatch_noPatch(t *testing.T) {
+	common.MockedClient = common.NewMockClient()
+
+	_, err := runCmd(Cmd, "x", "")
+	assert.Error(t, err)
+
+	_, err = runCmd(Cmd, `x {"a":1} -c`, "")
+	assert.Error(t, err)
+}
@@ -15,14 +15,50 @@
 package notifications
 
 import (
+	"context"
 	"log/slog"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/oxia-db/oxia/cmd/client/common"
 	"github.com/oxia-db/oxia/oxia"
 )
 
+var (
+	Config = flags{}
+)
+
+type flags struct {
+	keyPrefix string
+	keyMin    string
+	keyMax    string
+	types     []string
+	values    bool
+	group     string
+}
+
+func (flags *flags) Reset() {
+	flags.keyPrefix = ""
+	flags.keyMin = ""
+	flags.keyMax = ""
+	flags.types = nil
+	flags.values = false
+	flags.group = ""
+}
+
+func init() {
+	Cmd.Flags().StringVar(&Config.keyPrefix, "key-prefix", "", "Only follow the keys with the given prefix")
+	Cmd.Flags().StringVarP(&Config.keyMin, "key-min", "s", "", "Only follow the keys in the range, minimum (inclusive)")
+	Cmd.Flags().StringVarP(&Config.keyMax, "key-max", "e", "", "Only follow the keys in the range, maximum (exclusive)")
+	Cmd.MarkFlagsRequiredTogether("key-min", "key-max")
+	Cmd.Flags().StringSliceVar(&Config.types, "type", nil,
+		"Only follow the notifications of the given types: KeyCreated, KeyModified, KeyDeleted or KeyRangeRangeDeleted")
+	Cmd.Flags().BoolVar(&Config.values, "values", false, "Print the values captured with the notifications")
+	Cmd.Flags().StringVar(&Config.group, "consumer-group", "",
+		"Share the notifications with the other members of the consumer group, and commit the progress")
+}
+
 var Cmd = &cobra.Command{
 	Use:   "notifications",
 	Short: "Get notifications stream",
@@ -32,6 +68,11 @@ var Cmd = &cobra.Command{
 }
 
 func exec(_ *cobra.Command, _ []string) error {
+	options, err := notificationsOptions()
+	if err != nil {
+		return err
+	}
+
 	client, err := common.Config.NewClient()
 	if err != nil {
 		return err
@@ -39,7 +80,7 @@ func exec(_ *cobra.Command, _ []string) error {
 
 	defer client.Close()
 
-	notifications, err := client.GetNotifications()
+	notifications, err := client.GetNotifications(options...)
 	if err != nil {
 		return err
 	}
@@ -50,15 +91,66 @@ func exec(_ *cobra.Command, _ []string) error {
 		args := []any{
 			slog.String("type", notification.Type.String()),
 			slog.String("key", notification.Key),
+			slog.Int64("shard", notification.Shard),
+			slog.Int64("offset", notification.Offset),
 		}
 		if notification.Type == oxia.KeyCreated || notification.Type == oxia.KeyModified {
 			args = append(args, slog.Int64("version-id", notification.VersionId))
 		}
+		if notification.Value != nil {
+			args = append(args, slog.String("value", string(notification.Value)))
+		}
+		if notification.PreviousValue != nil {
+			args = append(args, slog.String("previous-value", string(notification.PreviousValue)))
+		}
 		if notification.Type == oxia.KeyRangeRangeDeleted {
 			args = append(args, slog.String("key-range-end", notification.KeyRangeEnd))
 		}
 		slog.Info("", args...)
+
+		if Config.group != "" {
+			if err := notifications.Commit(context.Background(), notification); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
+
+func notificationsOptions() ([]oxia.NotificationsOption, error) {
+	var options []oxia.NotificationsOption
+	if Config.keyPrefix != "" {
+		options = append(options, oxia.KeyPrefix(Config.keyPrefix))
+	}
+	if Config.keyMin != "" || Config.keyMax != "" {
+		options = append(options, oxia.KeyRange(Config.keyMin, Config.keyMax))
+	}
+	if len(Config.types) > 0 {
+		var types []oxia.NotificationType
+		for _, name := range Config.types {
+			t, err := parseNotificationType(name)
+			if err != nil {
+				return nil, err
+			}
+			types = append(types, t)
+		}
+		options = append(options, oxia.NotificationTypes(types...))
+	}
+	if Config.values {
+		options = append(options, oxia.IncludeNotificationValues())
+	}
+	if Config.group != "" {
+		options = append(options, oxia.ConsumerGroup(Config.group))
+	}
+	return options, nil
+}
+
+func parseNotificationType(name string) (oxia.NotificationType, error) {
+	for _, t := range []oxia.NotificationType{oxia.KeyCreated, oxia.KeyModified, oxia.KeyDeleted, oxia.KeyRangeRangeDeleted} {
+		if t.String() == name {
+			return t, nil
+		}
+	}
+	return 0, errors.Errorf("unknown notification type %q", name)
+}
@@ -35,6 +35,7 @@ type flags struct {
 	readValueFromStdIn bool
 	partitionKey       string
 	sequenceKeysDeltas []int64
+	ttl                time.Duration
 }
 
 func (flags *flags) Reset() {
@@ -42,6 +43,7 @@ func (flags *flags) Reset() {
 	flags.readValueFromStdIn = false
 	flags.partitionKey = ""
 	flags.sequenceKeysDeltas = nil
+	flags.ttl = 0
 }
 
 func init() {
@@ -49,6 +51,7 @@ func init() {
 	Cmd.Flags().BoolVarP(&Config.readValueFromStdIn, "std-in", "c", false, "Read value from stdin")
 	Cmd.Flags().StringVarP(&Config.partitionKey, "partition-key", "p", "", "Partition Key to be used in override the shard routing")
 	Cmd.Flags().Int64SliceVarP(&Config.sequenceKeysDeltas, "sequence-keys-deltas", "d", nil, "Specify one or more sequence keys deltas to be added to the inserted key")
+	Cmd.Flags().DurationVar(&Config.ttl, "ttl", 0, "Time-to-live of the record, after which it is automatically deleted")
 }
 
 var Cmd = &cobra.Command{
@@ -114,6 +117,9 @@ func getOptions() []oxia.PutOption {
 		}
 		options = append(options, oxia.SequenceKeysDeltas(deltas...))
 	}
+	if Config.ttl != 0 {
+		options = append(options, oxia.TTL(Config.ttl))
+	}
 
 	return options
 }
@@ -67,6 +67,11 @@ func init() {
 
 	Cmd.Flags().StringVarP(&configFile, "conf", "f", "", "Cluster config file")
 
+	// authentication section
+	Cmd.Flags().StringVar(&conf.AdminServiceAddr, "admin-addr", "", "Admin service bind address, required when the authentication is enabled")
+	Cmd.Flags().StringVar(&conf.AuthOptions.ProviderName, "auth-provider-name", "", "Authentication provider name of the admin service. supported: oidc")
+	Cmd.Flags().StringVar(&conf.AuthOptions.ProviderParams, "auth-provider-params", "", "Authentication provider params. \n oidc: "+"{\"allowedIssueURLs\":\"required1,required2\",\"allowedAudiences\":\"required1,required2\",\"userNameClaim\":\"optional(default:sub)\"}")
+
 	// server TLS section
 	Cmd.Flags().StringVar(&serverTLS.CertFile, "tls-cert-file", "", "Tls certificate file")
 	Cmd.Flags().StringVar(&serverTLS.KeyFile, "tls-key-file", "", "Tls key file")
@@ -103,6 +108,10 @@ func validate(*cobra.Command, []string) error {
 		// no-op
 	}
 
+	if conf.AuthOptions.IsEnabled() && conf.AdminServiceAddr == "" {
+		return errors.New("admin-addr must be set with auth-provider-name")
+	}
+
 	switch conf.MetadataProviderName {
 	case "memory", "configmap", "raft", "file":
 		return nil
@@ -25,6 +25,7 @@ import (
 	"github.com/oxia-db/oxia/common/logging"
 	"github.com/oxia-db/oxia/common/process"
 
+	"github.com/oxia-db/oxia/cmd/admin"
 	"github.com/oxia-db/oxia/cmd/client"
 	"github.com/oxia-db/oxia/cmd/coordinator"
 	"github.com/oxia-db/oxia/cmd/health"
@@ -60,6 +61,7 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&process.PprofEnable, "profile", false, "Enable pprof profiler")
 	rootCmd.PersistentFlags().StringVar(&process.PprofBindAddress, "profile-bind-address", "127.0.0.1:6060", "Bind address for pprof")
 
+	rootCmd.AddCommand(admin.Cmd)
 	rootCmd.AddCommand(client.Cmd)
 	rootCmd.AddCommand(coordinator.Cmd)
 	rootCmd.AddCommand(health.Cmd)
@@ -48,6 +48,10 @@ func init() {
 	Cmd.Flags().BoolVar(&conf.WalSyncData, "wal-sync-data", true, "Whether to sync data in write-ahead-log")
 
 	Cmd.Flags().BoolVar(&conf.NotificationsEnabled, "notifications-enabled", true, "Whether notifications are enabled")
+	Cmd.Flags().BoolVar(&conf.NotificationsIncludeValue, "notifications-include-value", false,
+		"Whether the notifications carry the value written by each change")
+	Cmd.Flags().BoolVar(&conf.NotificationsIncludePreviousValue, "notifications-include-previous-value", false,
+		"Whether the notifications carry the value replaced by each change")
 	Cmd.Flags().DurationVar(&conf.NotificationsRetentionTime, "notifications-retention-time", 1*time.Hour, "Retention time for the db notifications to clients")
 	Cmd.Flags().Int64Var(&conf.DbBlockCacheMB, "db-cache-size-mb", kv.DefaultFactoryOptions.CacheSizeMB,
 		"Max size of the shared DB cache")
@@ -120,6 +120,9 @@ func exec(*cobra.Command, []string) error {
 				printPuts(le, writes.Puts)
 				printDeletes(le, writes.Deletes)
 				printDeleteRanges(le, writes.DeleteRanges)
+				printIncrements(le, writes.Increments)
+				printAppends(le, writes.Appends)
+				printMergePatches(le, writes.MergePatches)
 			}
 		}
 	}
@@ -179,3 +182,51 @@ func printDeleteRanges(le *proto.LogEntry, deleteRanges []*proto.DeleteRangeRequ
 		)
 	}
 }
+
+func printIncrements(le *proto.LogEntry, increments []*proto.IncrementRequest) {
+	for _, i := range increments {
+		args := []any{
+			slog.String("op", "increment"),
+			slog.Time("ts", time.UnixMilli(int64(le.Timestamp))),
+			slog.String("key", i.Key),
+			slog.Int64("delta", i.Delta),
+			slog.Int64("offset", le.Offset),
+			slog.Int64("term", le.Term),
+		}
+		if i.MinValue != nil {
+			args = append(args, slog.Int64("min-value", i.GetMinValue()))
+		}
+		if i.MaxValue != nil {
+			args = append(args, slog.Int64("max-value", i.GetMaxValue()))
+		}
+		slog.Info("", args...)
+	}
+}
+
+func printAppends(le *proto.LogEntry, appends []*proto.AppendRequest) {
+	for _, a := range appends {
+		slog.Info(
+			"",
+			slog.String("op", "append"),
+			slog.Time("ts", time.UnixMilli(int64(le.Timestamp))),
+			slog.String("key", a.Key),
+			slog.Int("size", len(a.Value)),
+			slog.Int64("offset", le.Offset),
+			slog.Int64("term", le.Term),
+		)
+	}
+}
+
+func printMergePatches(le *proto.LogEntry, mergePatches []*proto.MergePatchRequest) {
+	for _, m := range mergePatches {
+		slog.Info(
+			"",
+			slog.String("op", "merge-patch"),
+			slog.Time("ts", time.UnixMilli(int64(le.Timestamp))),
+			slog.String("key", m.Key),
+			slog.Int("size", len(m.Patch)),
+			slog.Int64("offset", le.Offset),
+			slog.Int64("term", le.Term),
+		)
+	}
+}
@@ -32,6 +32,11 @@ const (
 	CodeInvalidSessionTimeout   codes.Code = 109
 	CodeNamespaceNotFound       codes.Code = 110
 	CodeNotificationsNotEnabled codes.Code = 111
+	CodeShardSplitting          codes.Code = 112
+	CodeShardMerging            codes.Code = 113
+	CodeInvalidTransaction      codes.Code = 114
+	CodeFollowerTooStale        codes.Code = 115
+	CodeLeaderTransferring      codes.Code = 116
 )
 
 var (
@@ -47,4 +52,9 @@ var (
 	ErrInvalidSessionTimeout   = status.Error(CodeInvalidSessionTimeout, "oxia: invalid session timeout")
 	ErrNamespaceNotFound       = status.Error(CodeNamespaceNotFound, "oxia: namespace not found")
 	ErrNotificationsNotEnabled = status.Error(CodeNotificationsNotEnabled, "oxia: notifications not enabled on namespace")
+	ErrShardSplitting          = status.Error(CodeShardSplitting, "oxia: shard is being split")
+	ErrShardMerging            = status.Error(CodeShardMerging, "oxia: shard is being merged")
+	ErrInvalidTransaction      = status.Error(CodeInvalidTransaction, "oxia: invalid transaction")
+	ErrFollowerTooStale        = status.Error(CodeFollowerTooStale, "oxia: follower data is too stale")
+	ErrLeaderTransferring      = status.Error(CodeLeaderTransferring, "oxia: leadership is being transferred")
 )
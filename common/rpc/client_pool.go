@@ -50,6 +50,7 @@ type ClientPool interface {
 	GetHealthRpc(target string) (grpc_health_v1.HealthClient, io.Closer, error)
 	GetCoordinationRpc(target string) (proto.OxiaCoordinationClient, error)
 	GetReplicationRpc(target string) (proto.OxiaLogReplicationClient, error)
+	GetAdminRpc(target string) (proto.OxiaAdminClient, error)
 
 	// Clear all the pooled client instances for the given target
 	Clear(target string)
@@ -129,6 +130,15 @@ func (cp *clientPool) GetReplicationRpc(target string) (proto.OxiaLogReplication
 	return proto.NewOxiaLogReplicationClient(cnx), nil
 }
 
+func (cp *clientPool) GetAdminRpc(target string) (proto.OxiaAdminClient, error) {
+	cnx, err := cp.getConnectionFromPool(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.NewOxiaAdminClient(cnx), nil
+}
+
 func (cp *clientPool) Clear(target string) {
 	cp.Lock()
 	defer cp.Unlock()
@@ -89,6 +89,15 @@ func (l *loggingClientRpc) RangeScan(ctx context.Context, in *proto.RangeScanReq
 	return res, err
 }
 
+func (l *loggingClientRpc) RangeStats(ctx context.Context, in *proto.RangeStatsRequest, opts ...grpc.CallOption) (
+	res *proto.RangeStatsResponse, err error) {
+	if res, err = l.client.RangeStats(ctx, in, opts...); err != nil {
+		return nil, l.decorateErr(err)
+	}
+
+	return res, err
+}
+
 func (l *loggingClientRpc) GetNotifications(ctx context.Context, in *proto.NotificationsRequest, opts ...grpc.CallOption) (
 	res proto.OxiaClient_GetNotificationsClient, err error) {
 	if res, err = l.client.GetNotifications(ctx, in, opts...); err != nil {
@@ -132,3 +141,30 @@ func (l *loggingClientRpc) GetSequenceUpdates(ctx context.Context, in *proto.Get
 
 	return res, err
 }
+
+func (l *loggingClientRpc) PrepareTransaction(ctx context.Context, in *proto.PrepareTransactionRequest, opts ...grpc.CallOption) (
+	res *proto.TransactionResponse, err error) {
+	if res, err = l.client.PrepareTransaction(ctx, in, opts...); err != nil {
+		return nil, l.decorateErr(err)
+	}
+
+	return res, err
+}
+
+func (l *loggingClientRpc) CommitTransaction(ctx context.Context, in *proto.CommitTransactionRequest, opts ...grpc.CallOption) (
+	res *proto.TransactionResponse, err error) {
+	if res, err = l.client.CommitTransaction(ctx, in, opts...); err != nil {
+		return nil, l.decorateErr(err)
+	}
+
+	return res, err
+}
+
+func (l *loggingClientRpc) AbortTransaction(ctx context.Context, in *proto.AbortTransactionRequest, opts ...grpc.CallOption) (
+	res *proto.TransactionResponse, err error) {
+	if res, err = l.client.AbortTransaction(ctx, in, opts...); err != nil {
+		return nil, l.decorateErr(err)
+	}
+
+	return res, err
+}
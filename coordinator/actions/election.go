@@ -19,6 +19,10 @@ import "sync"
 type ElectionAction struct {
 	Shard int64
 
+	// When set, the leadership is transferred to this node, if it's part of
+	// the ensemble. Otherwise, the new leader is chosen by the leader selector.
+	PreferredLeader string
+
 	NewLeader string
 	Waiter    *sync.WaitGroup
 }
@@ -34,7 +38,8 @@ func (*ElectionAction) Type() Type {
 
 func (e *ElectionAction) Clone() *ElectionAction {
 	return &ElectionAction{
-		Shard:  e.Shard,
-		Waiter: &sync.WaitGroup{},
+		Shard:           e.Shard,
+		PreferredLeader: e.PreferredLeader,
+		Waiter:          &sync.WaitGroup{},
 	}
 }
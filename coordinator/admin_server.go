@@ -0,0 +1,470 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/entity"
+	"github.com/oxia-db/oxia/common/rpc"
+	"github.com/oxia-db/oxia/coordinator/controllers"
+	"github.com/oxia-db/oxia/coordinator/model"
+	"github.com/oxia-db/oxia/coordinator/policies"
+	"github.com/oxia-db/oxia/proto"
+)
+
+type adminServer struct {
+	proto.UnimplementedOxiaAdminServer
+
+	coordinator Coordinator
+	log         *slog.Logger
+}
+
+func newAdminServer(coordinator Coordinator) *adminServer {
+	return &adminServer{
+		coordinator: coordinator,
+		log: slog.With(
+			slog.String("component", "admin-server"),
+		),
+	}
+}
+
+func (s *adminServer) CreateNamespace(ctx context.Context, req *proto.CreateNamespaceRequest) (*proto.CreateNamespaceResponse, error) {
+	s.log.Info(
+		"Create namespace",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+
+	if req.Config == nil {
+		return nil, status.Error(codes.InvalidArgument, "oxia: missing namespace config")
+	}
+	if err := s.coordinator.CreateNamespace(toNamespaceConfig(req.Config)); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.CreateNamespaceResponse{}, nil
+}
+
+func (s *adminServer) DeleteNamespace(ctx context.Context, req *proto.DeleteNamespaceRequest) (*proto.DeleteNamespaceResponse, error) {
+	s.log.Info(
+		"Delete namespace",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+
+	if err := s.coordinator.DeleteNamespace(req.Namespace); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.DeleteNamespaceResponse{}, nil
+}
+
+func (s *adminServer) ListNamespaces(context.Context, *proto.ListNamespacesRequest) (*proto.ListNamespacesResponse, error) {
+	clusterStatus := s.coordinator.StatusResource().Load()
+
+	res := &proto.ListNamespacesResponse{}
+	for _, nc := range s.coordinator.ConfigResource().Load().Namespaces {
+		res.Namespaces = append(res.Namespaces, toNamespaceInfo(nc, clusterStatus))
+	}
+	return res, nil
+}
+
+func (s *adminServer) DescribeNamespace(_ context.Context, req *proto.DescribeNamespaceRequest) (*proto.DescribeNamespaceResponse, error) {
+	nc, exist := s.coordinator.ConfigResource().NamespaceConfig(req.Namespace)
+	if !exist {
+		return nil, constant.ErrNamespaceNotFound
+	}
+
+	clusterStatus := s.coordinator.StatusResource().Load()
+	res := &proto.DescribeNamespaceResponse{
+		Namespace: toNamespaceInfo(*nc, clusterStatus),
+		Shards:    []*proto.ShardInfo{},
+	}
+
+	ns := clusterStatus.Namespaces[req.Namespace]
+	for _, shard := range slices.Sorted(maps.Keys(ns.Shards)) {
+		res.Shards = append(res.Shards, toShardInfo(shard, ns.Shards[shard]))
+	}
+	return res, nil
+}
+
+func (s *adminServer) ListNodes(context.Context, *proto.ListNodesRequest) (*proto.ListNodesResponse, error) {
+	nodeControllers := s.coordinator.NodeControllers()
+	clusterStatus := s.coordinator.StatusResource().Load()
+
+	res := &proto.ListNodesResponse{}
+	for _, server := range s.coordinator.ConfigResource().Load().Servers {
+		id := server.GetIdentifier()
+		info := &proto.NodeInfo{
+			Id:              id,
+			PublicAddress:   server.Public,
+			InternalAddress: server.Internal,
+			Status:          controllers.NotRunning.String(),
+			Draining:        clusterStatus.IsDraining(id),
+		}
+		if nc, exist := nodeControllers[id]; exist {
+			info.Status = nc.Status().String()
+		}
+
+		for _, ns := range clusterStatus.Namespaces {
+			for _, shard := range ns.Shards {
+				if shard.Status == model.ShardStatusDeleting || findEnsembleNode(shard.Ensemble, id) == nil {
+					continue
+				}
+				info.ReplicaShards++
+				if shard.Leader != nil && shard.Leader.GetIdentifier() == id {
+					info.LeaderShards++
+				}
+			}
+		}
+		res.Nodes = append(res.Nodes, info)
+	}
+	return res, nil
+}
+
+func (s *adminServer) ElectLeader(ctx context.Context, req *proto.ElectLeaderRequest) (*proto.ElectLeaderResponse, error) {
+	s.log.Info(
+		"Elect leader",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+
+	leader, err := s.coordinator.ElectLeader(req.Namespace, req.Shard)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.ElectLeaderResponse{Leader: leader}, nil
+}
+
+func (s *adminServer) TransferLeader(ctx context.Context, req *proto.TransferLeaderRequest) (*proto.TransferLeaderResponse, error) {
+	s.log.Info(
+		"Transfer leader",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+
+	leader, err := s.coordinator.TransferLeadership(req.Namespace, req.Shard, req.To)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.TransferLeaderResponse{Leader: leader}, nil
+}
+
+func (s *adminServer) SwapNode(ctx context.Context, req *proto.SwapNodeRequest) (*proto.SwapNodeResponse, error) {
+	s.log.Info(
+		"Swap node",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+
+	if err := s.coordinator.SwapNode(req.Namespace, req.Shard, req.From, req.To); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.SwapNodeResponse{}, nil
+}
+
+func (s *adminServer) Split(ctx context.Context, req *proto.SplitRequest) (*proto.SplitResponse, error) {
+	s.log.Info(
+		"Split shard",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+
+	children, err := s.coordinator.SplitShard(req.Namespace, req.Shard)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.SplitResponse{Children: children}, nil
+}
+
+func (s *adminServer) Merge(ctx context.Context, req *proto.MergeRequest) (*proto.MergeResponse, error) {
+	s.log.Info(
+		"Merge shards",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+
+	shard, err := s.coordinator.MergeShards(req.Namespace, req.Shard, req.OtherShard)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.MergeResponse{Shard: shard}, nil
+}
+
+func (s *adminServer) DrainNode(ctx context.Context, req *proto.DrainNodeRequest) (*proto.DrainNodeResponse, error) {
+	s.log.Info(
+		"Drain node",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+
+	drainStatus, err := s.coordinator.DrainNode(req.Node)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.DrainNodeResponse{Status: toDrainStatus(drainStatus)}, nil
+}
+
+func (s *adminServer) GetDrainStatus(_ context.Context, req *proto.GetDrainStatusRequest) (*proto.GetDrainStatusResponse, error) {
+	drainStatus, err := s.coordinator.DrainStatus(req.Node)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.GetDrainStatusResponse{Status: toDrainStatus(drainStatus)}, nil
+}
+
+func (s *adminServer) CancelDrain(ctx context.Context, req *proto.CancelDrainRequest) (*proto.CancelDrainResponse, error) {
+	s.log.Info(
+		"Cancel drain",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+
+	if err := s.coordinator.CancelDrain(req.Node); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.CancelDrainResponse{}, nil
+}
+
+func (s *adminServer) GetBalancerStatus(context.Context, *proto.GetBalancerStatusRequest) (*proto.GetBalancerStatusResponse, error) {
+	loadBalancer := s.coordinator.LoadBalancer()
+	return &proto.GetBalancerStatusResponse{
+		Paused:   loadBalancer.IsPaused(),
+		Balanced: loadBalancer.IsBalanced(),
+	}, nil
+}
+
+func (s *adminServer) PauseBalancer(ctx context.Context, _ *proto.PauseBalancerRequest) (*proto.PauseBalancerResponse, error) {
+	s.log.Info(
+		"Pause balancer",
+		slog.String("peer", rpc.GetPeer(ctx)),
+	)
+
+	s.coordinator.LoadBalancer().Pause()
+	return &proto.PauseBalancerResponse{}, nil
+}
+
+func (s *adminServer) ResumeBalancer(ctx context.Context, _ *proto.ResumeBalancerRequest) (*proto.ResumeBalancerResponse, error) {
+	s.log.Info(
+		"Resume balancer",
+		slog.String("peer", rpc.GetPeer(ctx)),
+	)
+
+	s.coordinator.LoadBalancer().Resume()
+	return &proto.ResumeBalancerResponse{}, nil
+}
+
+func (s *adminServer) ListSessions(_ context.Context, req *proto.ListSessionsRequest) (*proto.ListSessionsResponse, error) {
+	sessions, failedShards, err := s.coordinator.ListSessions(req.Namespace, req.Shard)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	res := &proto.ListSessionsResponse{}
+	for idx := range sessions {
+		res.Sessions = append(res.Sessions, toSessionInfo(&sessions[idx]))
+	}
+	for _, shard := range slices.Sorted(maps.Keys(failedShards)) {
+		res.FailedShards = append(res.FailedShards, &proto.ShardFailure{
+			Shard: shard,
+			Error: failedShards[shard].Error(),
+		})
+	}
+	return res, nil
+}
+
+func (s *adminServer) DescribeSession(_ context.Context, req *proto.DescribeSessionRequest) (*proto.DescribeSessionResponse, error) {
+	session, err := s.coordinator.DescribeSession(req.Namespace, req.Shard, req.SessionId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.DescribeSessionResponse{
+		Session:       toSessionInfo(session),
+		EphemeralKeys: session.EphemeralKeys,
+	}, nil
+}
+
+func (s *adminServer) RevokeSession(ctx context.Context, req *proto.RevokeSessionRequest) (*proto.RevokeSessionResponse, error) {
+	s.log.Info(
+		"Revoke session",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+
+	if err := s.coordinator.RevokeSession(req.Namespace, req.Shard, req.SessionId); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &proto.RevokeSessionResponse{}, nil
+}
+
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidNamespaceConfig), errors.Is(err, ErrInvalidMerge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrNamespaceAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ErrNamespaceNotDynamic), errors.Is(err, ErrInvalidSwap),
+		errors.Is(err, ErrInvalidLeaderTransfer), errors.Is(err, ErrNodeNotDraining):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrShardNotFound), errors.Is(err, ErrNodeNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrLeaderNotAvailable):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, constant.ErrNamespaceNotFound):
+		return constant.ErrNamespaceNotFound
+	default:
+		return err
+	}
+}
+
+func toDrainStatus(drainStatus *DrainStatus) *proto.DrainStatus {
+	res := &proto.DrainStatus{
+		Node:           drainStatus.Node,
+		Phase:          drainStatus.Phase.String(),
+		LeaderShards:   uint32(drainStatus.LeaderShards),
+		ReplicaShards:  uint32(drainStatus.ReplicaShards),
+		TotalShards:    uint32(drainStatus.TotalShards),
+		SafeToShutdown: drainStatus.SafeToShutdown(),
+	}
+	if drainStatus.Err != nil {
+		errMsg := drainStatus.Err.Error()
+		res.Error = &errMsg
+	}
+	return res
+}
+
+func toNamespaceConfig(config *proto.NamespaceConfig) model.NamespaceConfig {
+	nc := model.NamespaceConfig{
+		Name:                              config.Name,
+		InitialShardCount:                 config.InitialShardCount,
+		ReplicationFactor:                 config.ReplicationFactor,
+		NotificationsIncludeValue:         config.GetNotificationsIncludeValue(),
+		NotificationsIncludePreviousValue: config.GetNotificationsIncludePreviousValue(),
+	}
+	if config.NotificationsEnabled != nil {
+		nc.NotificationsEnabled = entity.Bool(*config.NotificationsEnabled)
+	}
+	if len(config.AntiAffinities) > 0 || len(config.LeaderAffinities) > 0 ||
+		len(config.NodeAffinities) > 0 || len(config.Tolerations) > 0 {
+		nc.Policies = &policies.Policies{}
+		for _, antiAffinity := range config.AntiAffinities {
+			nc.Policies.AntiAffinities = append(nc.Policies.AntiAffinities, policies.AntiAffinity{
+				Labels: antiAffinity.Labels,
+				Mode:   policies.AntiAffinityMode(antiAffinity.Mode),
+			})
+		}
+		for _, leaderAffinity := range config.LeaderAffinities {
+			nc.Policies.LeaderAffinities = append(nc.Policies.LeaderAffinities, policies.LeaderAffinity{
+				Labels: leaderAffinity.Labels,
+			})
+		}
+		for _, nodeAffinity := range config.NodeAffinities {
+			nc.Policies.NodeAffinities = append(nc.Policies.NodeAffinities, policies.NodeAffinity{
+				Labels: nodeAffinity.Labels,
+				Mode:   policies.NodeAffinityMode(nodeAffinity.Mode),
+			})
+		}
+		for _, toleration := range config.Tolerations {
+			nc.Policies.Tolerations = append(nc.Policies.Tolerations, policies.Toleration{
+				Key:    toleration.Key,
+				Value:  toleration.Value,
+				Effect: policies.TaintEffect(toleration.Effect),
+			})
+		}
+	}
+	return nc
+}
+
+func toNamespaceInfo(nc model.NamespaceConfig, clusterStatus *model.ClusterStatus) *proto.NamespaceInfo {
+	notificationsEnabled := nc.NotificationsEnabled.Get()
+	config := &proto.NamespaceConfig{
+		Name:                              nc.Name,
+		InitialShardCount:                 nc.InitialShardCount,
+		ReplicationFactor:                 nc.ReplicationFactor,
+		NotificationsEnabled:              &notificationsEnabled,
+		NotificationsIncludeValue:         &nc.NotificationsIncludeValue,
+		NotificationsIncludePreviousValue: &nc.NotificationsIncludePreviousValue,
+	}
+	if nc.Policies != nil {
+		for _, antiAffinity := range nc.Policies.AntiAffinities {
+			config.AntiAffinities = append(config.AntiAffinities, &proto.AntiAffinity{
+				Labels: antiAffinity.Labels,
+				Mode:   string(antiAffinity.Mode),
+			})
+		}
+		for _, leaderAffinity := range nc.Policies.LeaderAffinities {
+			config.LeaderAffinities = append(config.LeaderAffinities, &proto.LeaderAffinity{
+				Labels: leaderAffinity.Labels,
+			})
+		}
+		for _, nodeAffinity := range nc.Policies.NodeAffinities {
+			config.NodeAffinities = append(config.NodeAffinities, &proto.NodeAffinity{
+				Labels: nodeAffinity.Labels,
+				Mode:   string(nodeAffinity.Mode),
+			})
+		}
+		for _, toleration := range nc.Policies.Tolerations {
+			config.Tolerations = append(config.Tolerations, &proto.Toleration{
+				Key:    toleration.Key,
+				Value:  toleration.Value,
+				Effect: string(toleration.Effect),
+			})
+		}
+	}
+
+	_, dynamic := clusterStatus.DynamicNamespace(nc.Name)
+	return &proto.NamespaceInfo{
+		Config:  config,
+		Dynamic: dynamic,
+	}
+}
+
+func toShardInfo(shard int64, shardMetadata model.ShardMetadata) *proto.ShardInfo {
+	info := &proto.ShardInfo{
+		Shard: shard,
+		// The status is exposed with the same names used in the cluster status
+		Status:   shardMetadata.Status.String(),
+		Term:     shardMetadata.Term,
+		Ensemble: make([]string, 0, len(shardMetadata.Ensemble)),
+		Int32HashRange: &proto.Int32HashRange{
+			MinHashInclusive: shardMetadata.Int32HashRange.Min,
+			MaxHashInclusive: shardMetadata.Int32HashRange.Max,
+		},
+	}
+	if shardMetadata.Leader != nil {
+		leader := shardMetadata.Leader.GetIdentifier()
+		info.Leader = &leader
+	}
+	for _, server := range shardMetadata.Ensemble {
+		info.Ensemble = append(info.Ensemble, server.GetIdentifier())
+	}
+	return info
+}
+
+func toSessionInfo(session *Session) *proto.SessionInfo {
+	return &proto.SessionInfo{
+		Shard:          session.Shard,
+		SessionId:      session.SessionId,
+		ClientIdentity: session.ClientIdentity,
+		TimeoutMs:      uint32(session.Timeout.Milliseconds()),
+		Leader:         session.Leader,
+	}
+}
@@ -46,5 +46,12 @@ type LoadBalancer interface {
 
 	IsBalanced() bool
 
+	// Pause stops the balancer from proposing any action, until it is resumed.
+	Pause()
+
+	Resume()
+
+	IsPaused() bool
+
 	LoadRatioAlgorithm() selectors.LoadRatioAlgorithm
 }
@@ -17,6 +17,8 @@ package balancer
 import (
 	"context"
 	"log/slog"
+	"maps"
+	"slices"
 	"sync"
 	"time"
 
@@ -58,6 +60,7 @@ type nodeBasedBalancer struct {
 	shardQuarantineShardMap *sync.Map
 
 	triggerCh chan any
+	skipping  bool
 
 	actionCh chan actions.Action
 }
@@ -97,6 +100,7 @@ func (r *nodeBasedBalancer) rebalanceEnsemble() bool {
 	swapGroup := &sync.WaitGroup{}
 	currentStatus := r.statusResource.Load()
 	candidates, metadata := r.configResource.NodesWithMetadata()
+	utils.RemoveDrainingNodes(candidates, currentStatus)
 	groupedStatus, historyNodes := utils.GroupingShardsNodeByStatus(candidates, currentStatus)
 	loadRatios := r.loadRatioAlgorithm(&model.RatioParams{NodeShardsInfos: groupedStatus, HistoryNodes: historyNodes})
 
@@ -154,14 +158,28 @@ func (r *nodeBasedBalancer) balanceHighestNode(loadRatios *model.Ratio, candidat
 		}
 		fromNodeID := highestLoadRatioNode.NodeID
 		fromNode := highestLoadRatioNode.Node
-		if _, err := r.swapShard(highestLoadRatioShard, fromNode, swapGroup, loadRatios, candidates, metadata, currentStatus); err != nil {
+		targetNodeID, err := r.selectSwapTarget(highestLoadRatioShard, fromNodeID, loadRatios, candidates, metadata, currentStatus)
+		switch {
+		case err != nil:
 			r.Error("failed to select server when swap the node",
 				slog.String("namespace", highestLoadRatioShard.Namespace),
 				slog.Int64("shard", highestLoadRatioShard.ShardID),
 				slog.String("from-node", fromNodeID),
 				slog.Any("error", err),
 			)
-			continue
+		case targetNodeID == "":
+		case !reducesLoadGap(loadRatios, highestLoadRatioShard, highestLoadRatioNode, targetNodeID):
+			// The placement policies only allow nodes that are as loaded
+			// as the current one
+		default:
+			if err := r.proposeSwap(highestLoadRatioShard, fromNode, targetNodeID, swapGroup, loadRatios); err != nil {
+				r.Error("failed to swap the node",
+					slog.String("namespace", highestLoadRatioShard.Namespace),
+					slog.Int64("shard", highestLoadRatioShard.ShardID),
+					slog.String("from-node", fromNodeID),
+					slog.Any("error", err),
+				)
+			}
 		}
 		if !shardIter.Prev() {
 			break
@@ -187,16 +205,19 @@ func (r *nodeBasedBalancer) cleanDeletedNode(loadRatios *model.Ratio,
 		}
 		deletedNodeID := nodeLoadRatio.NodeID
 		deletedNode := nodeLoadRatio.Node
-		for shardIter := nodeLoadRatio.ShardIterator(); shardIter.Next(); {
-			var shardRatio = shardIter.Value()
-			if swapped, err := r.swapShard(shardRatio, deletedNode, swapGroup, loadRatios, candidates, metadata, currentStatus); err != nil || !swapped {
-				r.Error("failed to select server when move ensemble out of deleted node",
-					slog.String("namespace", shardRatio.Namespace),
-					slog.Int64("shard", shardRatio.ShardID),
-					slog.String("from-node", deletedNodeID),
-					slog.Any("error", err),
-				)
-				continue
+		// The replicas of a draining node are moved by the drain itself
+		if !currentStatus.IsDraining(deletedNodeID) {
+			for shardIter := nodeLoadRatio.ShardIterator(); shardIter.Next(); {
+				var shardRatio = shardIter.Value()
+				if swapped, err := r.swapShard(shardRatio, deletedNode, swapGroup, loadRatios, candidates, metadata, currentStatus); err != nil || !swapped {
+					r.Error("failed to select server when move ensemble out of deleted node",
+						slog.String("namespace", shardRatio.Namespace),
+						slog.Int64("shard", shardRatio.ShardID),
+						slog.String("from-node", deletedNodeID),
+						slog.Any("error", err),
+					)
+					continue
+				}
 			}
 		}
 		if err := loadRatios.RemoveDeletedNode(nodeLoadRatio.NodeID); err != nil {
@@ -213,12 +234,29 @@ func (r *nodeBasedBalancer) swapShard(
 	candidates *linkedhashset.Set[string],
 	metadata map[string]model.ServerMetadata,
 	currentStatus *model.ClusterStatus) (bool, error) {
-	var nsc *model.NamespaceConfig
-	var exist bool
-	var err error
+	targetNodeID, err := r.selectSwapTarget(candidateShard, fromNode.GetIdentifier(), loadRatios, candidates, metadata, currentStatus)
+	if err != nil || targetNodeID == "" {
+		return false, err
+	}
+	if err := r.proposeSwap(candidateShard, fromNode, targetNodeID, swapGroup, loadRatios); err != nil {
+		return false, err
+	}
+	return true, nil
+}
 
-	if nsc, exist = r.configResource.NamespaceConfig(candidateShard.Namespace); !exist {
-		return false, nil
+// selectSwapTarget selects the node that takes the place of the given node in
+// the shard ensemble, following the namespace policies. It returns an empty
+// target if the shard should not be moved.
+func (r *nodeBasedBalancer) selectSwapTarget(
+	candidateShard *model.ShardLoadRatio,
+	fromNodeID string,
+	loadRatios *model.Ratio,
+	candidates *linkedhashset.Set[string],
+	metadata map[string]model.ServerMetadata,
+	currentStatus *model.ClusterStatus) (string, error) {
+	nsc, exist := r.configResource.NamespaceConfig(candidateShard.Namespace)
+	if !exist {
+		return "", nil
 	}
 	policies := nsc.Policies
 	sContext := &single.Context{
@@ -228,7 +266,6 @@ func (r *nodeBasedBalancer) swapShard(
 		Status:             currentStatus,
 		LoadRatioSupplier:  func() *model.Ratio { return loadRatios },
 	}
-	fromNodeID := fromNode.GetIdentifier()
 
 	// filter selected
 	selected := linkedhashset.New[string]()
@@ -241,16 +278,25 @@ func (r *nodeBasedBalancer) swapShard(
 	}
 	sContext.SetSelected(selected)
 
-	var targetNodeID string
-	if targetNodeID, err = r.selector.Select(sContext); err != nil {
-		return false, err
+	targetNodeID, err := r.selector.Select(sContext)
+	if err != nil {
+		return "", err
 	}
 	if targetNodeID == fromNodeID {
-		return false, nil
+		return "", nil
 	}
-	var targetNode *model.Server
-	if targetNode, exist = r.configResource.Node(targetNodeID); !exist {
-		return false, errors.New("target node does not exist")
+	return targetNodeID, nil
+}
+
+func (r *nodeBasedBalancer) proposeSwap(
+	candidateShard *model.ShardLoadRatio,
+	fromNode model.Server,
+	targetNodeID string,
+	swapGroup *sync.WaitGroup,
+	loadRatios *model.Ratio) error {
+	targetNode, exist := r.configResource.Node(targetNodeID)
+	if !exist {
+		return errors.New("target node does not exist")
 	}
 
 	swapGroup.Add(1)
@@ -261,9 +307,19 @@ func (r *nodeBasedBalancer) swapShard(
 		Waiter: swapGroup,
 	}
 	r.Info("propose to swap the shard", slog.Int64("shard", candidateShard.ShardID), slog.Any("from", fromNode), slog.Any("to", targetNodeID))
-	loadRatios.MoveShardToNode(candidateShard, fromNodeID, targetNodeID)
+	loadRatios.MoveShardToNode(candidateShard, fromNode.GetIdentifier(), targetNodeID)
 	loadRatios.ReCalculateRatios()
-	return true, nil
+	return nil
+}
+
+// reducesLoadGap returns whether moving the shard to the target node leaves
+// it less loaded than the source node was.
+func reducesLoadGap(loadRatios *model.Ratio, shard *model.ShardLoadRatio, fromNode *model.NodeLoadRatio, targetNodeID string) bool {
+	targetNode := loadRatios.NodeLoadRatio(targetNodeID)
+	if targetNode == nil {
+		return true
+	}
+	return targetNode.Ratio+shard.Ratio < fromNode.Ratio
 }
 
 func (r *nodeBasedBalancer) checkQuarantineNodes() {
@@ -302,6 +358,7 @@ func (r *nodeBasedBalancer) IsNodeQuarantined(highestLoadRatioNode *model.NodeLo
 func (r *nodeBasedBalancer) IsBalanced() bool {
 	status := r.statusResource.Load()
 	candidates := r.configResource.Nodes()
+	utils.RemoveDrainingNodes(candidates, status)
 	groupedStatus, historyNodes := utils.GroupingShardsNodeByStatus(candidates, status)
 	return r.loadRatioAlgorithm(
 		&model.RatioParams{
@@ -317,6 +374,37 @@ func (r *nodeBasedBalancer) Trigger() {
 	channel.PushNoBlock(r.triggerCh, nil)
 }
 
+func (r *nodeBasedBalancer) Pause() {
+	r.Info("pause the load balancer")
+	r.setPaused(true)
+}
+
+func (r *nodeBasedBalancer) Resume() {
+	r.Info("resume the load balancer")
+	r.setPaused(false)
+	channel.PushNoBlock(r.triggerCh, nil)
+}
+
+func (r *nodeBasedBalancer) IsPaused() bool {
+	return r.statusResource.Load().BalancerPaused
+}
+
+// setPaused persists the pause in the cluster status, so that a new
+// coordinator does not resume the balancing by itself.
+func (r *nodeBasedBalancer) setPaused(paused bool) {
+	for {
+		currentStatus, version := r.statusResource.LoadWithVersion()
+		if currentStatus.BalancerPaused == paused {
+			return
+		}
+		newStatus := currentStatus.Clone()
+		newStatus.BalancerPaused = paused
+		if r.statusResource.Swap(newStatus, version) {
+			return
+		}
+	}
+}
+
 func (r *nodeBasedBalancer) LoadRatioAlgorithm() selectors.LoadRatioAlgorithm {
 	return r.loadRatioAlgorithm
 }
@@ -355,6 +443,15 @@ func (r *nodeBasedBalancer) startBackgroundNotifier() {
 				if !more {
 					return
 				}
+				if r.IsPaused() {
+					// Only log the first skipped balance after the pause
+					if !r.skipping {
+						r.Info("skip the balance, the load balancer is paused")
+					}
+					r.skipping = true
+					continue
+				}
+				r.skipping = false
 				if r.rebalanceEnsemble() { // if shard is balanced
 					r.rebalanceLeader()
 				}
@@ -369,7 +466,8 @@ func (r *nodeBasedBalancer) rebalanceLeader() {
 	r.checkQuarantineShards()
 
 	status := r.statusResource.Load()
-	candidates := r.configResource.Nodes()
+	candidates, metadata := r.configResource.NodesWithMetadata()
+	utils.RemoveDrainingNodes(candidates, status)
 	totalShards, electedShards, nodeLeaders := utils.NodeShardLeaders(candidates, status)
 
 	electedRate := float64(electedShards) / float64(totalShards)
@@ -387,6 +485,11 @@ func (r *nodeBasedBalancer) rebalanceLeader() {
 		r.Info("end leader rebalance")
 	}()
 
+	// Leaders placed against the leader affinity rules are moved first
+	if r.rebalanceLeaderAffinity(status, candidates, metadata, nodeLeaders) {
+		return
+	}
+
 	maxLeadersNodeID, maxLeaders, minLeaders := r.rankLeaders(nodeLeaders)
 
 	nsAndShards, ok := nodeLeaders[maxLeadersNodeID]
@@ -407,40 +510,120 @@ func (r *nodeBasedBalancer) rebalanceLeader() {
 		shard := nsAndShard.ShardID
 		shardStatus := status.Namespaces[namespace].Shards[shard]
 
-		minCandidateLeaders := -1
-		for _, candidate := range shardStatus.Ensemble {
-			candidateID := candidate.GetIdentifier()
-			if !r.nodeAvailableJudger(candidateID) {
-				continue
-			}
-			shards := nodeLeaders[candidateID]
-			leaders := shards.Size()
-			if minCandidateLeaders == -1 || leaders < minCandidateLeaders {
-				minCandidateLeaders = leaders
-			}
-		}
+		minCandidateID, minCandidateLeaders := leastLeadersCandidate(
+			r.leaderCandidates(namespace, shardStatus, candidates, metadata), nodeLeaders)
 		if minCandidateLeaders == maxLeaders || minCandidateLeaders+1 == maxLeaders {
 			r.Info("quarantine the shard due to no valid candidates", slog.Int64("shard", shard), slog.Any("leader", maxLeadersNodeID))
 			r.shardQuarantineShardMap.Store(shard, time.Now())
 			break
 		}
 
-		latch := &sync.WaitGroup{}
-		latch.Add(1)
-		ac := &actions.ElectionAction{
-			Shard:  shard,
-			Waiter: latch,
+		r.transferLeader(shard, maxLeadersNodeID, minCandidateID)
+		break
+	}
+}
+
+// rebalanceLeaderAffinity moves the leadership of one shard whose leader
+// doesn't satisfy the leader affinity rules of its namespace, while a member
+// of its ensemble does. It returns whether a shard was moved.
+func (r *nodeBasedBalancer) rebalanceLeaderAffinity(status *model.ClusterStatus, candidates *linkedhashset.Set[string],
+	metadata map[string]model.ServerMetadata, nodeLeaders map[string]*arraylist.List[utils.NamespaceAndShard]) bool {
+	for _, namespace := range slices.Sorted(maps.Keys(status.Namespaces)) {
+		nsConfig, exist := r.configResource.NamespaceConfig(namespace)
+		if !exist || nsConfig.Policies == nil || len(nsConfig.Policies.LeaderAffinities) == 0 {
+			continue
 		}
-		r.actionCh <- ac
-		latch.Wait()
-		leader := ac.NewLeader
-		r.Info("triggered new election", slog.Int64("shard", shard), slog.Any("old-leader", maxLeadersNodeID), slog.Any("new-leader", leader))
-		if leader == maxLeadersNodeID { // no changes
-			r.Info("quarantine the shard due to no leader changed", slog.Int64("shard", shard), slog.Any("old-leader", maxLeadersNodeID), slog.Any("new-leader", leader))
-			r.shardQuarantineShardMap.Store(shard, time.Now())
+		nsStatus := status.Namespaces[namespace]
+		for _, shard := range slices.Sorted(maps.Keys(nsStatus.Shards)) {
+			shardStatus := nsStatus.Shards[shard]
+			if shardStatus.Leader == nil {
+				continue
+			}
+			if _, exist := r.shardQuarantineShardMap.Load(shard); exist {
+				continue
+			}
+			leader := shardStatus.Leader.GetIdentifier()
+			if !candidates.Contains(leader) || !r.nodeAvailableJudger(leader) {
+				// The leader is being replaced anyway
+				continue
+			}
+			preferred := r.leaderCandidates(namespace, shardStatus, candidates, metadata)
+			if preferred.Contains(leader) {
+				continue
+			}
+			newLeader, _ := leastLeadersCandidate(preferred, nodeLeaders)
+			if newLeader == "" {
+				continue
+			}
+			r.Info("move the leader to satisfy the leader affinity",
+				slog.String("namespace", namespace),
+				slog.Int64("shard", shard),
+				slog.String("leader", leader),
+			)
+			r.transferLeader(shard, leader, newLeader)
+			return true
+		}
+	}
+	return false
+}
+
+// leaderCandidates returns the available members of the shard ensemble that
+// are preferred as leader by the namespace policies.
+func (r *nodeBasedBalancer) leaderCandidates(namespace string, shardStatus model.ShardMetadata,
+	candidates *linkedhashset.Set[string], metadata map[string]model.ServerMetadata) *linkedhashset.Set[string] {
+	ensemble := linkedhashset.New[string]()
+	for _, server := range shardStatus.Ensemble {
+		serverID := server.GetIdentifier()
+		if !r.nodeAvailableJudger(serverID) {
+			continue
+		}
+		if !candidates.Contains(serverID) {
+			// The node is draining
+			continue
+		}
+		ensemble.Add(serverID)
+	}
+	nsConfig, exist := r.configResource.NamespaceConfig(namespace)
+	if !exist {
+		return ensemble
+	}
+	return utils.PreferredLeaders(ensemble, nsConfig.Policies, metadata)
+}
+
+// transferLeader triggers an election that moves the shard leadership to the
+// new leader, and quarantines the shard if the leader didn't change.
+func (r *nodeBasedBalancer) transferLeader(shard int64, oldLeader string, newLeader string) {
+	latch := &sync.WaitGroup{}
+	latch.Add(1)
+	ac := &actions.ElectionAction{
+		Shard:           shard,
+		PreferredLeader: newLeader,
+		Waiter:          latch,
+	}
+	r.actionCh <- ac
+	latch.Wait()
+	leader := ac.NewLeader
+	r.Info("triggered new election", slog.Int64("shard", shard), slog.Any("old-leader", oldLeader), slog.Any("new-leader", leader))
+	if leader == oldLeader { // no changes
+		r.Info("quarantine the shard due to no leader changed", slog.Int64("shard", shard), slog.Any("old-leader", oldLeader), slog.Any("new-leader", leader))
+		r.shardQuarantineShardMap.Store(shard, time.Now())
+	}
+}
+
+func leastLeadersCandidate(candidates *linkedhashset.Set[string],
+	nodeLeaders map[string]*arraylist.List[utils.NamespaceAndShard]) (minCandidateID string, minCandidateLeaders int) {
+	minCandidateLeaders = -1
+	for iter := candidates.Iterator(); iter.Next(); {
+		shards, exist := nodeLeaders[iter.Value()]
+		if !exist {
+			continue
+		}
+		if leaders := shards.Size(); minCandidateLeaders == -1 || leaders < minCandidateLeaders {
+			minCandidateLeaders = leaders
+			minCandidateID = iter.Value()
 		}
-		break
 	}
+	return minCandidateID, minCandidateLeaders
 }
 
 func (r *nodeBasedBalancer) rankLeaders(nodeLeaders map[string]*arraylist.List[utils.NamespaceAndShard]) (maxLeadersNodeID string, maxLeaders int, minLeaders int) {
@@ -124,6 +124,24 @@ type mockPerNodeChannels struct {
 		error
 	}
 
+	splitShardRequests  chan *proto.SplitShardRequest
+	splitShardResponses chan struct {
+		*proto.SplitShardResponse
+		error
+	}
+
+	mergeShardRequests  chan *proto.MergeShardRequest
+	mergeShardResponses chan struct {
+		*proto.MergeShardResponse
+		error
+	}
+
+	transferLeadershipRequests  chan *proto.TransferLeadershipRequest
+	transferLeadershipResponses chan struct {
+		*proto.TransferLeadershipResponse
+		error
+	}
+
 	shardAssignmentsStream *mockShardAssignmentClient
 	healthClient           *mockHealthClient
 	err                    error
@@ -201,6 +219,51 @@ func (m *mockPerNodeChannels) expectAddFollowerRequest(t *testing.T, shard int64
 	assert.Equal(t, term, r.Term)
 }
 
+func (m *mockPerNodeChannels) expectSplitShardRequest(t *testing.T, shard int64, term int64) *proto.SplitShardRequest {
+	t.Helper()
+
+	var r *proto.SplitShardRequest
+	select {
+	case r = <-m.splitShardRequests:
+	case <-time.After(defaultTimeout):
+		assert.Fail(t, "did not receive SplitShard request in time")
+		return nil
+	}
+
+	assert.Equal(t, shard, r.Shard)
+	assert.Equal(t, term, r.Term)
+	return r
+}
+
+func (m *mockPerNodeChannels) expectMergeShardRequest(t *testing.T, shard int64, term int64) *proto.MergeShardRequest {
+	t.Helper()
+
+	var r *proto.MergeShardRequest
+	select {
+	case r = <-m.mergeShardRequests:
+	case <-time.After(defaultTimeout):
+		assert.Fail(t, "did not receive MergeShard request in time")
+		return nil
+	}
+
+	assert.Equal(t, shard, r.Shard)
+	assert.Equal(t, term, r.Term)
+	return r
+}
+
+func (m *mockPerNodeChannels) expectTransferLeadershipRequest(t *testing.T, shard int64, term int64, newLeader string) {
+	t.Helper()
+
+	select {
+	case r := <-m.transferLeadershipRequests:
+		assert.Equal(t, shard, r.Shard)
+		assert.Equal(t, term, r.Term)
+		assert.Equal(t, newLeader, r.NewLeader)
+	case <-time.After(defaultTimeout):
+		assert.Fail(t, "did not receive TransferLeadership request in time")
+	}
+}
+
 func (m *mockPerNodeChannels) expectGetStatusRequest(t *testing.T, shard int64) {
 	t.Helper()
 
@@ -261,6 +324,34 @@ func (m *mockPerNodeChannels) AddFollowerResponse(err error) {
 	}{&proto.AddFollowerResponse{}, err}
 }
 
+func (m *mockPerNodeChannels) SplitShardResponse(offset int64, err error) {
+	m.splitShardResponses <- struct {
+		*proto.SplitShardResponse
+		error
+	}{&proto.SplitShardResponse{Offset: offset}, err}
+}
+
+func (m *mockPerNodeChannels) MergeShardResponse(offset int64, err error) {
+	m.mergeShardResponses <- struct {
+		*proto.MergeShardResponse
+		error
+	}{&proto.MergeShardResponse{Offset: offset}, err}
+}
+
+func (m *mockPerNodeChannels) StageMergeResponse(targetOffsets map[string]int64, err error) {
+	m.mergeShardResponses <- struct {
+		*proto.MergeShardResponse
+		error
+	}{&proto.MergeShardResponse{TargetOffsets: targetOffsets}, err}
+}
+
+func (m *mockPerNodeChannels) TransferLeadershipResponse(term int64, offset int64, err error) {
+	m.transferLeadershipResponses <- struct {
+		*proto.TransferLeadershipResponse
+		error
+	}{&proto.TransferLeadershipResponse{HeadEntryId: &proto.EntryId{Term: term, Offset: offset}}, err}
+}
+
 func newMockPerNodeChannels() *mockPerNodeChannels {
 	return &mockPerNodeChannels{
 		newTermRequests: make(chan *proto.NewTermRequest, 100),
@@ -288,6 +379,21 @@ func newMockPerNodeChannels() *mockPerNodeChannels {
 			*proto.DeleteShardResponse
 			error
 		}, 100),
+		splitShardRequests: make(chan *proto.SplitShardRequest, 100),
+		splitShardResponses: make(chan struct {
+			*proto.SplitShardResponse
+			error
+		}, 100),
+		mergeShardRequests: make(chan *proto.MergeShardRequest, 100),
+		mergeShardResponses: make(chan struct {
+			*proto.MergeShardResponse
+			error
+		}, 100),
+		transferLeadershipRequests: make(chan *proto.TransferLeadershipRequest, 100),
+		transferLeadershipResponses: make(chan struct {
+			*proto.TransferLeadershipResponse
+			error
+		}, 100),
 		shardAssignmentsStream: newMockShardAssignmentClient(),
 		healthClient:           newMockHealthClient(),
 	}
@@ -445,6 +551,75 @@ func (r *mockRpcProvider) DeleteShard(ctx context.Context, node model.Server, re
 	}
 }
 
+func (r *mockRpcProvider) SplitShard(ctx context.Context, node model.Server, req *proto.SplitShardRequest) (*proto.SplitShardResponse, error) {
+	r.Lock()
+
+	s := r.getNode(node)
+	s.splitShardRequests <- req
+
+	if s.err != nil {
+		r.Unlock()
+		return nil, s.err
+	}
+
+	r.Unlock()
+
+	select {
+	case response := <-s.splitShardResponses:
+		return response.SplitShardResponse, response.error
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(3 * time.Second):
+		return nil, errors.New("timeout")
+	}
+}
+
+func (r *mockRpcProvider) MergeShard(ctx context.Context, node model.Server, req *proto.MergeShardRequest) (*proto.MergeShardResponse, error) {
+	r.Lock()
+
+	s := r.getNode(node)
+	s.mergeShardRequests <- req
+
+	if s.err != nil {
+		r.Unlock()
+		return nil, s.err
+	}
+
+	r.Unlock()
+
+	select {
+	case response := <-s.mergeShardResponses:
+		return response.MergeShardResponse, response.error
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(3 * time.Second):
+		return nil, errors.New("timeout")
+	}
+}
+
+func (r *mockRpcProvider) TransferLeadership(ctx context.Context, node model.Server, req *proto.TransferLeadershipRequest) (*proto.TransferLeadershipResponse, error) {
+	r.Lock()
+
+	s := r.getNode(node)
+	s.transferLeadershipRequests <- req
+
+	if s.err != nil {
+		r.Unlock()
+		return nil, s.err
+	}
+
+	r.Unlock()
+
+	select {
+	case response := <-s.transferLeadershipResponses:
+		return response.TransferLeadershipResponse, response.error
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(3 * time.Second):
+		return nil, errors.New("timeout")
+	}
+}
+
 func (r *mockRpcProvider) AddFollower(ctx context.Context, node model.Server, req *proto.AddFollowerRequest) (*proto.AddFollowerResponse, error) {
 	r.Lock()
 
@@ -468,6 +643,14 @@ func (r *mockRpcProvider) AddFollower(ctx context.Context, node model.Server, re
 	}
 }
 
+func (*mockRpcProvider) ListShardSessions(context.Context, model.Server, *proto.ListShardSessionsRequest) (*proto.ListShardSessionsResponse, error) {
+	return nil, ErrNotImplement
+}
+
+func (*mockRpcProvider) RevokeShardSession(context.Context, model.Server, *proto.RevokeShardSessionRequest) (*proto.RevokeShardSessionResponse, error) {
+	return nil, ErrNotImplement
+}
+
 func (r *mockRpcProvider) GetHealthClient(node model.Server) (grpc_health_v1.HealthClient, io.Closer, error) {
 	c := r.GetNode(node).healthClient
 	return c, c, nil
@@ -46,6 +46,19 @@ const (
 	Draining //
 )
 
+func (s NodeStatus) String() string {
+	switch s {
+	case Running:
+		return "Running"
+	case NotRunning:
+		return "NotRunning"
+	case Draining:
+		return "Draining"
+	default:
+		return "Unknown"
+	}
+}
+
 const (
 	healthCheckProbeInterval   = 2 * time.Second
 	healthCheckProbeTimeout    = 2 * time.Second
@@ -20,6 +20,7 @@ import (
 	"io"
 	"log/slog"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -54,6 +55,15 @@ const (
 	// Timeout when waiting for followers to catchup with leader.
 	catchupTimeout = 5 * time.Minute
 
+	// Timeout when waiting for the new leader to catchup with the current
+	// one, during a leadership transfer
+	transferLeadershipTimeout = 5 * time.Second
+
+	// Time given to hand off the leadership, once the new leader is caught up
+	// with the current one. The current leader rejects the writes meanwhile,
+	// and resumes them if no new term is started in time
+	leadershipHandOffTimeout = 10 * time.Second
+
 	chanBufferSize = 100
 
 	DefaultPeriodicTasksInterval = 1 * time.Minute
@@ -65,6 +75,14 @@ type swapNodeRequest struct {
 	res  chan error
 }
 
+type copyShardRequest struct {
+	// The status of the shard while the data is being copied
+	status   model.ShardStatus
+	copyData func(shardMeta model.ShardMetadata) error
+	onCopied func() error
+	res      chan error
+}
+
 var _ ShardController = &shardController{}
 
 // The ShardController is responsible to handle all the state transition for a given a shard
@@ -80,6 +98,23 @@ type ShardController interface {
 	SwapNode(from model.Server, to model.Server) error
 	DeleteShard()
 
+	// Split copies the shard data into the children shards. The onCopied callback
+	// is invoked once the data is copied, and the split is aborted if it fails.
+	// When the split completes, the shard is marked for deletion.
+	Split(children map[int64]model.ShardMetadata, onCopied func() error) error
+
+	// StageMerge copies a snapshot of the shard data into the target shard,
+	// while the shard keeps accepting writes. It returns the offsets that the
+	// target shard nodes are caught up to.
+	StageMerge(targetId int64, target model.ShardMetadata) (map[string]int64, error)
+
+	// Merge copies the shard data that follows the snapshot staged in the
+	// target shard, either replacing or merging into the data of the shards
+	// before it. The onCopied callback is invoked once the data is copied, and
+	// the merge is aborted if it fails. When the merge completes, the shard is
+	// marked for deletion.
+	Merge(targetId int64, target model.ShardMetadata, targetOffsets map[string]int64, onCopied func() error) error
+
 	Election(action *actions.ElectionAction) string
 }
 
@@ -100,6 +135,7 @@ type shardController struct {
 	deleteOp      chan any
 	nodeFailureOp chan model.Server
 	swapNodeOp    chan swapNodeRequest
+	copyOp        chan copyShardRequest
 
 	ctx                   context.Context
 	cancel                context.CancelFunc
@@ -151,6 +187,7 @@ func NewShardController(
 		deleteOp:              make(chan any, chanBufferSize),
 		nodeFailureOp:         make(chan model.Server, chanBufferSize),
 		swapNodeOp:            make(chan swapNodeRequest, chanBufferSize),
+		copyOp:                make(chan copyShardRequest, chanBufferSize),
 		periodicTasksInterval: periodTasksInterval,
 		log: slog.With(
 			slog.String("component", "shard-controller"),
@@ -241,6 +278,9 @@ func (s *shardController) run(initShardMeta *model.ShardMetadata) {
 		case sw := <-s.swapNodeOp:
 			s.swapNode(sw.from, sw.to, sw.res)
 
+		case cp := <-s.copyOp:
+			s.copyShard(cp)
+
 		case <-periodicTasksTimer.C:
 			s.handlePeriodicTasks()
 
@@ -320,8 +360,27 @@ func (s *shardController) verifyCurrentEnsemble(initShardMeta *model.ShardMetada
 }
 
 func (s *shardController) electLeaderWithRetries(ea *actions.ElectionAction) {
+	var preferredLeader string
+	if ea != nil && ea.PreferredLeader != "" {
+		preferredLeader = ea.PreferredLeader
+		if headEntryId := s.prepareLeadershipTransfer(preferredLeader); headEntryId != nil {
+			newLeader, err := s.handOffLeadership(preferredLeader, headEntryId)
+			if err == nil {
+				ea.Done(newLeader)
+				return
+			}
+
+			s.leaderElectionsFailed.Inc()
+			s.log.Warn(
+				"Failed to hand off the leadership, electing a new leader",
+				slog.String("new-leader", preferredLeader),
+				slog.Any("error", err),
+			)
+		}
+	}
+
 	newLeader, _ := backoff.RetryNotifyWithData[string](func() (string, error) {
-		return s.electLeader()
+		return s.electLeader(preferredLeader)
 	}, oxiatime.NewBackOff(s.ctx),
 		func(err error, duration time.Duration) {
 			s.leaderElectionsFailed.Inc()
@@ -336,9 +395,164 @@ func (s *shardController) electLeaderWithRetries(ea *actions.ElectionAction) {
 	}
 }
 
-func (s *shardController) electLeader() (string, error) {
+// prepareLeadershipTransfer asks the current leader to hand off the leadership
+// to the given node, and returns the last entry of the leader once the node
+// has acknowledged it.
+//
+// Once the node has caught up, the leader stops accepting writes until the
+// node has all the entries of the shard, so that the node can take over the
+// leadership, and the clients only see retriable errors meanwhile. The leader
+// resumes the writes by itself if the hand-off doesn't happen within the
+// hand-off timeout. If the transfer fails, a new leader is elected anyway,
+// without any guarantee about which node it is.
+func (s *shardController) prepareLeadershipTransfer(newLeader string) *proto.EntryId {
+	shardMeta := s.metadata.Load()
+	if shardMeta.Status != model.ShardStatusSteadyState || shardMeta.Leader == nil ||
+		shardMeta.Leader.GetIdentifier() == newLeader {
+		return nil
+	}
+
+	var target *model.Server
+	for idx := range shardMeta.Ensemble {
+		if shardMeta.Ensemble[idx].GetIdentifier() == newLeader {
+			target = &shardMeta.Ensemble[idx]
+		}
+	}
+	if target == nil {
+		s.log.Warn(
+			"Cannot transfer the leadership to a node outside the ensemble",
+			slog.String("new-leader", newLeader),
+		)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, transferLeadershipTimeout)
+	defer cancel()
+
+	res, err := s.rpc.TransferLeadership(ctx, *shardMeta.Leader, &proto.TransferLeadershipRequest{
+		Namespace:        s.namespace,
+		Shard:            s.shard,
+		Term:             shardMeta.Term,
+		NewLeader:        target.Internal,
+		HandOffTimeoutMs: uint32(leadershipHandOffTimeout.Milliseconds()),
+	})
+	if err != nil {
+		s.log.Warn(
+			"Failed to transfer the leadership, electing a new leader",
+			slog.Any("leader", shardMeta.Leader),
+			slog.Any("new-leader", target),
+			slog.Any("error", err),
+		)
+		return nil
+	}
+
+	s.log.Info(
+		"The new leader is caught up with the current one",
+		slog.Any("leader", shardMeta.Leader),
+		slog.Any("new-leader", target),
+		slog.Any("head-entry-id", res.HeadEntryId),
+	)
+	return res.HeadEntryId
+}
+
+// handOffLeadership makes the given node the leader in a new term, once it has
+// acknowledged the last entry of the current leader, which is rejecting writes.
+//
+// Only the current leader and the new one are fenced, so that the writes
+// resume without waiting for the whole ensemble. Fencing the current leader
+// guarantees that no entry is added in the previous term, while the other
+// followers rejoin the new leader in the background.
+func (s *shardController) handOffLeadership(newLeader string, headEntryId *proto.EntryId) (string, error) {
+	shardMeta := s.metadata.Load()
+	if shardMeta.Status != model.ShardStatusSteadyState || shardMeta.Leader == nil || len(shardMeta.RemovedNodes) > 0 {
+		return "", errors.Errorf("shard is not in steady state: %s", shardMeta.Status)
+	}
+	if shardMeta.Term != headEntryId.Term {
+		return "", errors.Errorf("shard moved to term %d", shardMeta.Term)
+	}
+
 	timer := s.leaderElectionLatency.Timer()
+	currentLeader := shardMeta.Leader.GetIdentifier()
+	mutShardMeta := s.startElection()
+
+	var leader, follower *model.Server
+	for idx := range mutShardMeta.Ensemble {
+		switch mutShardMeta.Ensemble[idx].GetIdentifier() {
+		case newLeader:
+			leader = &mutShardMeta.Ensemble[idx]
+		case currentLeader:
+			follower = &mutShardMeta.Ensemble[idx]
+		}
+	}
+	if leader == nil || follower == nil {
+		return "", errors.New("the current and the new leader must be in the ensemble")
+	}
+
+	fr, err := s.newTermAll(mutShardMeta.Term, *leader, *follower)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to fence the current and the new leader")
+	}
+
+	// Both must stop at the last entry, or an entry added after it by the
+	// current leader could be lost
+	for server, entryId := range fr {
+		if entryId.Term != headEntryId.Term || entryId.Offset != headEntryId.Offset {
+			return "", errors.Errorf("node %s stopped at entry %v instead of %v", server.GetIdentifier(), entryId, headEntryId)
+		}
+	}
+
+	followers := map[model.Server]*proto.EntryId{*follower: fr[*follower]}
+	if err = s.becomeLeader(mutShardMeta.Term, mutShardMeta.Ensemble, *leader, followers); err != nil {
+		return "", errors.Wrapf(err, "failed to become leader for node %s", newLeader)
+	}
+
+	timer.Done()
+	s.leaderElected(mutShardMeta, *leader, followers)
+	return newLeader, nil
+}
+
+// Send NewTerm to the given nodes in parallel and wait for all of them to
+// reply successfully.
+func (s *shardController) newTermAll(term int64, servers ...model.Server) (map[model.Server]*proto.EntryId, error) {
+	ch := make(chan struct {
+		model.Server
+		*proto.EntryId
+		error
+	}, len(servers))
 
+	for _, server := range servers {
+		go process.DoWithLabels(
+			s.ctx,
+			map[string]string{
+				"oxia":  "shard-controller-leadership-hand-off",
+				"shard": fmt.Sprintf("%d", s.shard),
+				"node":  server.GetIdentifier(),
+			}, func() {
+				entryId, err := s.newTerm(s.currentElectionCtx, term, server)
+				ch <- struct {
+					model.Server
+					*proto.EntryId
+					error
+				}{server, entryId, err}
+			},
+		)
+	}
+
+	res := make(map[model.Server]*proto.EntryId)
+	var err error
+	for range servers {
+		r := <-ch
+		if r.error != nil {
+			err = multierr.Append(err, errors.Wrapf(r.error, "failed to newTerm node %s", r.Server.GetIdentifier()))
+			continue
+		}
+		res[r.Server] = r.EntryId
+	}
+	return res, err
+}
+
+// startElection moves the shard to a new term, without any leader.
+func (s *shardController) startElection() model.ShardMetadata {
 	if s.currentElectionCancel != nil {
 		// Cancel any pending activity from the previous election
 		s.currentElectionCancel()
@@ -360,6 +574,12 @@ func (s *shardController) electLeader() (string, error) {
 	)
 
 	s.statusResource.UpdateShardMetadata(s.namespace, s.shard, mutShardMeta)
+	return mutShardMeta
+}
+
+func (s *shardController) electLeader(preferredLeader string) (string, error) {
+	timer := s.leaderElectionLatency.Timer()
+	mutShardMeta := s.startElection()
 
 	// Send NewTerm to all the ensemble members
 	fr, err := s.newTermQuorum(&mutShardMeta)
@@ -367,7 +587,7 @@ func (s *shardController) electLeader() (string, error) {
 		return "", errors.Wrap(err, "Failed to create new term quorum")
 	}
 
-	newLeader, followers := s.selectNewLeader(fr)
+	newLeader, followers := s.selectNewLeader(fr, preferredLeader)
 
 	if s.log.Enabled(context.Background(), slog.LevelInfo) {
 		f := make([]struct {
@@ -392,6 +612,14 @@ func (s *shardController) electLeader() (string, error) {
 		return "", errors.Wrapf(err, "failed to become leader for node %s", newLeader.GetIdentifier())
 	}
 
+	timer.Done()
+	s.leaderElected(mutShardMeta, newLeader, followers)
+	return newLeader.GetIdentifier(), nil
+}
+
+// leaderElected records the new leader of the shard, and keeps fencing the
+// followers that didn't join it yet.
+func (s *shardController) leaderElected(mutShardMeta model.ShardMetadata, newLeader model.Server, followers map[model.Server]*proto.EntryId) {
 	mutShardMeta.Status = model.ShardStatusSteadyState
 	mutShardMeta.PendingDeleteShardNodes = mergeLists(mutShardMeta.PendingDeleteShardNodes, mutShardMeta.RemovedNodes)
 	mutShardMeta.RemovedNodes = nil
@@ -409,7 +637,6 @@ func (s *shardController) electLeader() (string, error) {
 		slog.Int64("term", mutShardMeta.Term),
 		slog.Any("leader", mutShardMeta.Leader),
 	)
-	timer.Done()
 
 	if s.eventListener != nil {
 		s.eventListener.LeaderElected(s.shard, newLeader, maps.Keys(followers))
@@ -424,7 +651,6 @@ func (s *shardController) electLeader() (string, error) {
 			s.keepFencingFailedFollowers(term, ensemble, leader, followers)
 		},
 	)
-	return newLeader.GetIdentifier(), nil
 }
 
 func (s *shardController) getRefreshedEnsemble(shardMeta *model.ShardMetadata) []model.Server {
@@ -637,7 +863,9 @@ func (s *shardController) newTerm(ctx context.Context, term int64, node model.Se
 		Shard:     s.shard,
 		Term:      term,
 		Options: &proto.NewTermOptions{
-			EnableNotifications: s.namespaceConfig.NotificationsEnabled.Get(),
+			EnableNotifications:               s.namespaceConfig.NotificationsEnabled.Get(),
+			NotificationsIncludeValue:         s.namespaceConfig.NotificationsIncludeValue,
+			NotificationsIncludePreviousValue: s.namespaceConfig.NotificationsIncludePreviousValue,
 		},
 	})
 	if err != nil {
@@ -683,15 +911,23 @@ func chooseCandidates(newTermResponses map[model.Server]*proto.EntryId) []model.
 	return candidates
 }
 
-func (s *shardController) selectNewLeader(newTermResponses map[model.Server]*proto.EntryId) (
+func (s *shardController) selectNewLeader(newTermResponses map[model.Server]*proto.EntryId, preferredLeader string) (
 	leader model.Server, followers map[model.Server]*proto.EntryId) {
 	candidates := chooseCandidates(newTermResponses)
 
-	server, _ := s.leaderSelector.Select(&leaderselector.Context{
-		Candidates: candidates,
-		Status:     s.statusResource.Load(),
-	})
-	leader = server
+	if idx := slices.IndexFunc(candidates, func(candidate model.Server) bool {
+		return candidate.GetIdentifier() == preferredLeader
+	}); idx >= 0 {
+		leader = candidates[idx]
+	} else {
+		_, metadata := s.configResource.NodesWithMetadata()
+		leader, _ = s.leaderSelector.Select(&leaderselector.Context{
+			Candidates:         candidates,
+			CandidatesMetadata: metadata,
+			Policies:           s.namespaceConfig.Policies,
+			Status:             s.statusResource.Load(),
+		})
+	}
 	followers = make(map[model.Server]*proto.EntryId)
 	for a, e := range newTermResponses {
 		if a != leader {
@@ -762,7 +998,9 @@ func (s *shardController) deleteShard() error {
 	}
 
 	s.statusResource.DeleteShardMetadata(s.namespace, s.shard)
-	s.eventListener.ShardDeleted(s.shard)
+	if s.eventListener != nil {
+		s.eventListener.ShardDeleted(s.shard)
+	}
 	return s.close()
 }
 
@@ -838,6 +1076,200 @@ func (s *shardController) swapNode(from model.Server, to model.Server, res chan
 	res <- nil
 }
 
+func (s *shardController) Split(children map[int64]model.ShardMetadata, onCopied func() error) error {
+	s.log.Info(
+		"Splitting shard",
+		slog.Any("children", children),
+	)
+
+	return s.submitCopy(copyShardRequest{
+		status: model.ShardStatusSplitting,
+		copyData: func(shardMeta model.ShardMetadata) error {
+			return s.splitShardRpc(shardMeta, children)
+		},
+		onCopied: onCopied,
+	})
+}
+
+func (s *shardController) StageMerge(targetId int64, target model.ShardMetadata) (map[string]int64, error) {
+	shardMeta := s.metadata.Load()
+	if shardMeta.Status != model.ShardStatusSteadyState || shardMeta.Leader == nil {
+		return nil, errors.Errorf("shard is not in steady state: %s", shardMeta.Status)
+	}
+
+	s.log.Info(
+		"Staging the shard data for the merge",
+		slog.Int64("target-shard", targetId),
+		slog.Any("target", target),
+	)
+
+	res, err := s.rpc.MergeShard(s.ctx, *shardMeta.Leader, s.newMergeShardRequest(shardMeta, targetId, target))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to stage the shard data from leader %s", shardMeta.Leader.GetIdentifier())
+	}
+	return res.TargetOffsets, nil
+}
+
+func (s *shardController) Merge(targetId int64, target model.ShardMetadata, targetOffsets map[string]int64, onCopied func() error) error {
+	s.log.Info(
+		"Merging shard",
+		slog.Int64("target-shard", targetId),
+		slog.Any("target", target),
+	)
+
+	return s.submitCopy(copyShardRequest{
+		status: model.ShardStatusMerging,
+		copyData: func(shardMeta model.ShardMetadata) error {
+			return s.mergeShardRpc(shardMeta, targetId, target, targetOffsets)
+		},
+		onCopied: onCopied,
+	})
+}
+
+func (s *shardController) submitCopy(req copyShardRequest) error {
+	req.res = make(chan error, 1)
+	s.copyOp <- req
+
+	select {
+	case err := <-req.res:
+		return err
+	case <-s.ctx.Done():
+		// The controller gets closed right after a successful copy
+		select {
+		case err := <-req.res:
+			return err
+		default:
+			return s.ctx.Err()
+		}
+	}
+}
+
+// copyShard copies the shard data into other shards, which are going to
+// replace this shard.
+func (s *shardController) copyShard(req copyShardRequest) {
+	shardMeta := s.metadata.Load()
+	if shardMeta.Status != model.ShardStatusSteadyState || shardMeta.Leader == nil {
+		req.res <- errors.Errorf("shard is not in steady state: %s", shardMeta.Status)
+		return
+	}
+
+	shardMeta = s.metadata.Compute(func(shardMeta *model.ShardMetadata) {
+		shardMeta.Status = req.status
+	})
+	s.statusResource.UpdateShardMetadata(s.namespace, s.shard, shardMeta)
+
+	err := req.copyData(shardMeta)
+	if err == nil {
+		err = req.onCopied()
+	}
+
+	if err != nil {
+		s.log.Warn(
+			"Failed to copy the shard data, aborting",
+			slog.Any("status", req.status),
+			slog.Any("error", err),
+		)
+
+		// The leader is rejecting writes while the data is being copied.
+		// Starting a new term will make the shard available again.
+		s.electLeaderWithRetries(nil)
+		req.res <- err
+		return
+	}
+
+	// From this point, the new shards are replacing this shard
+	shardMeta = s.metadata.Compute(func(shardMeta *model.ShardMetadata) {
+		shardMeta.Status = model.ShardStatusDeleting
+	})
+	s.statusResource.UpdateShardMetadata(s.namespace, s.shard, shardMeta)
+
+	s.log.Info(
+		"Successfully copied the shard data",
+		slog.Any("status", req.status),
+	)
+	req.res <- nil
+
+	s.deleteShardWithRetries()
+}
+
+func (s *shardController) splitShardRpc(shardMeta model.ShardMetadata, children map[int64]model.ShardMetadata) error {
+	req := &proto.SplitShardRequest{
+		Namespace: s.namespace,
+		Shard:     s.shard,
+		Term:      shardMeta.Term,
+	}
+
+	childrenIds := maps.Keys(children)
+	slices.Sort(childrenIds)
+	for _, childId := range childrenIds {
+		child := children[childId]
+		ensemble := make([]string, 0, len(child.Ensemble))
+		for _, server := range child.Ensemble {
+			ensemble = append(ensemble, server.Internal)
+		}
+
+		req.Children = append(req.Children, &proto.SplitShardChild{
+			Shard: childId,
+			Term:  child.Term,
+			Int32HashRange: &proto.Int32HashRange{
+				MinHashInclusive: child.Int32HashRange.Min,
+				MaxHashInclusive: child.Int32HashRange.Max,
+			},
+			Ensemble: ensemble,
+		})
+	}
+
+	res, err := s.rpc.SplitShard(s.ctx, *shardMeta.Leader, req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to copy the shard data from leader %s", shardMeta.Leader.GetIdentifier())
+	}
+
+	s.log.Info(
+		"Copied the shard data into the children shards",
+		slog.Int64("offset", res.Offset),
+	)
+	return nil
+}
+
+func (s *shardController) mergeShardRpc(shardMeta model.ShardMetadata, targetId int64, target model.ShardMetadata, targetOffsets map[string]int64) error {
+	req := s.newMergeShardRequest(shardMeta, targetId, target)
+	req.CatchUp = true
+	req.TargetOffsets = targetOffsets
+
+	res, err := s.rpc.MergeShard(s.ctx, *shardMeta.Leader, req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to copy the shard data from leader %s", shardMeta.Leader.GetIdentifier())
+	}
+
+	s.log.Info(
+		"Copied the shard data into the target shard",
+		slog.Int64("target-shard", targetId),
+		slog.Int64("offset", res.Offset),
+	)
+	return nil
+}
+
+// newMergeShardRequest creates the request to copy the shard data into the
+// target shard. The data of the first of the merged shards replaces the data
+// of the target shard, while the data of the following ones is merged into it.
+func (s *shardController) newMergeShardRequest(shardMeta model.ShardMetadata, targetId int64, target model.ShardMetadata) *proto.MergeShardRequest {
+	ensemble := make([]string, 0, len(target.Ensemble))
+	for _, server := range target.Ensemble {
+		ensemble = append(ensemble, server.Internal)
+	}
+
+	return &proto.MergeShardRequest{
+		Namespace:      s.namespace,
+		Shard:          s.shard,
+		Term:           shardMeta.Term,
+		TargetShard:    targetId,
+		TargetTerm:     target.Term,
+		TargetEnsemble: ensemble,
+		Merge:          len(target.MergedFrom) > 0 && target.MergedFrom[0] != s.shard,
+		MergeShards:    target.MergedFrom,
+	}
+}
+
 func (s *shardController) isFollowerCatchUp(ctx context.Context, server model.Server, leaderHeadOffset int64) error {
 	fs, err := s.rpc.GetStatus(ctx, server, &proto.GetStatusRequest{Shard: s.shard})
 	if err != nil {
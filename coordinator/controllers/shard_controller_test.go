@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/oxia-db/oxia/common/concurrent"
+	"github.com/oxia-db/oxia/coordinator/actions"
 
 	"github.com/oxia-db/oxia/coordinator/metadata"
 	"github.com/oxia-db/oxia/coordinator/resources"
@@ -603,3 +604,350 @@ func TestShardController_LeaderElectionShouldNotFailIfRemoveFails(t *testing.T)
 
 	assert.NoError(t, sc.Close())
 }
+
+func TestShardController_Split(t *testing.T) {
+	var shard int64 = 5
+	rpc := newMockRpcProvider()
+
+	s1 := model.Server{Public: "s1:9091", Internal: "s1:8191"}
+	s2 := model.Server{Public: "s2:9091", Internal: "s2:8191"}
+	s3 := model.Server{Public: "s3:9091", Internal: "s3:8191"}
+	n1 := rpc.GetNode(s1)
+	n2 := rpc.GetNode(s2)
+	n3 := rpc.GetNode(s3)
+
+	meta := metadata.NewMetadataProviderMemory()
+	defer meta.Close()
+	statusResource := resources.NewStatusResource(meta)
+	configResource := resources.NewClusterConfigResource(t.Context(), func() (model.ClusterConfig, error) {
+		return model.ClusterConfig{}, nil
+	}, nil, nil)
+	defer configResource.Close()
+
+	sc := NewShardController(constant.DefaultNamespace, shard, namespaceConfig, model.ShardMetadata{
+		Status:         model.ShardStatusSteadyState,
+		Term:           1,
+		Leader:         &s1,
+		Ensemble:       []model.Server{s1, s2, s3},
+		Int32HashRange: model.Int32HashRange{Min: 0, Max: 99},
+	}, configResource, statusResource, nil, rpc, DefaultPeriodicTasksInterval)
+
+	n1.GetStatusResponse(1, proto.ServingStatus_LEADER, 0, 0)
+	n2.GetStatusResponse(1, proto.ServingStatus_FOLLOWER, 0, 0)
+	n3.GetStatusResponse(1, proto.ServingStatus_FOLLOWER, 0, 0)
+
+	children := map[int64]model.ShardMetadata{
+		6: {Term: 0, Ensemble: []model.Server{s1, s2, s3}, Int32HashRange: model.Int32HashRange{Min: 0, Max: 49}},
+		7: {Term: 0, Ensemble: []model.Server{s1, s2, s3}, Int32HashRange: model.Int32HashRange{Min: 50, Max: 99}},
+	}
+
+	wg := concurrent.NewWaitGroup(1)
+	wg.Go(func() error {
+		return sc.Split(children, func() error {
+			assert.Equal(t, model.ShardStatusSplitting, sc.Metadata().Status())
+			return nil
+		})
+	})
+
+	n1.SplitShardResponse(10, nil)
+	req := n1.expectSplitShardRequest(t, shard, 1)
+	assert.Len(t, req.Children, 2)
+	assert.EqualValues(t, 6, req.Children[0].Shard)
+	assert.EqualValues(t, 49, req.Children[0].Int32HashRange.MaxHashInclusive)
+	assert.EqualValues(t, 7, req.Children[1].Shard)
+	assert.EqualValues(t, 50, req.Children[1].Int32HashRange.MinHashInclusive)
+	assert.Equal(t, []string{s1.Internal, s2.Internal, s3.Internal}, req.Children[0].Ensemble)
+
+	// After the split, the parent shard gets deleted
+	n1.DeleteShardResponse(nil)
+	n2.DeleteShardResponse(nil)
+	n3.DeleteShardResponse(nil)
+
+	assert.NoError(t, wg.Wait(context.Background()))
+
+	n1.expectDeleteShardRequest(t, shard, 1)
+	n2.expectDeleteShardRequest(t, shard, 1)
+	n3.expectDeleteShardRequest(t, shard, 1)
+
+	assert.NoError(t, sc.Close())
+}
+
+func TestShardController_SplitAborted(t *testing.T) {
+	var shard int64 = 5
+	rpc := newMockRpcProvider()
+
+	s1 := model.Server{Public: "s1:9091", Internal: "s1:8191"}
+	s2 := model.Server{Public: "s2:9091", Internal: "s2:8191"}
+	s3 := model.Server{Public: "s3:9091", Internal: "s3:8191"}
+	n1 := rpc.GetNode(s1)
+	n2 := rpc.GetNode(s2)
+	n3 := rpc.GetNode(s3)
+
+	meta := metadata.NewMetadataProviderMemory()
+	defer meta.Close()
+	statusResource := resources.NewStatusResource(meta)
+	configResource := resources.NewClusterConfigResource(t.Context(), func() (model.ClusterConfig, error) {
+		return model.ClusterConfig{}, nil
+	}, nil, nil)
+	defer configResource.Close()
+
+	sc := NewShardController(constant.DefaultNamespace, shard, namespaceConfig, model.ShardMetadata{
+		Status:         model.ShardStatusSteadyState,
+		Term:           1,
+		Leader:         &s1,
+		Ensemble:       []model.Server{s1, s2, s3},
+		Int32HashRange: model.Int32HashRange{Min: 0, Max: 99},
+	}, configResource, statusResource, nil, rpc, DefaultPeriodicTasksInterval)
+
+	n1.GetStatusResponse(1, proto.ServingStatus_LEADER, 0, 0)
+	n2.GetStatusResponse(1, proto.ServingStatus_FOLLOWER, 0, 0)
+	n3.GetStatusResponse(1, proto.ServingStatus_FOLLOWER, 0, 0)
+
+	children := map[int64]model.ShardMetadata{
+		6: {Term: 0, Ensemble: []model.Server{s1, s2, s3}, Int32HashRange: model.Int32HashRange{Min: 0, Max: 49}},
+		7: {Term: 0, Ensemble: []model.Server{s1, s2, s3}, Int32HashRange: model.Int32HashRange{Min: 50, Max: 99}},
+	}
+
+	n1.SplitShardResponse(10, nil)
+
+	// The shard should be elected again in a new term, to resume accepting writes
+	n1.NewTermResponse(1, 10, nil)
+	n2.NewTermResponse(1, 10, nil)
+	n3.NewTermResponse(1, 10, nil)
+	n1.BecomeLeaderResponse(nil)
+	n2.BecomeLeaderResponse(nil)
+	n3.BecomeLeaderResponse(nil)
+
+	err := sc.Split(children, func() error {
+		return errors.New("children shards failed to start")
+	})
+	assert.Error(t, err)
+
+	n1.expectSplitShardRequest(t, shard, 1)
+	n1.expectNewTermRequest(t, shard, 2, true)
+	n2.expectNewTermRequest(t, shard, 2, true)
+	n3.expectNewTermRequest(t, shard, 2, true)
+
+	assert.Equal(t, model.ShardStatusSteadyState, sc.Metadata().Status())
+	assert.EqualValues(t, 2, sc.Metadata().Term())
+
+	assert.NoError(t, sc.Close())
+}
+
+func TestShardController_Merge(t *testing.T) {
+	var shard int64 = 5
+	rpc := newMockRpcProvider()
+
+	s1 := model.Server{Public: "s1:9091", Internal: "s1:8191"}
+	s2 := model.Server{Public: "s2:9091", Internal: "s2:8191"}
+	s3 := model.Server{Public: "s3:9091", Internal: "s3:8191"}
+	n1 := rpc.GetNode(s1)
+	n2 := rpc.GetNode(s2)
+	n3 := rpc.GetNode(s3)
+
+	meta := metadata.NewMetadataProviderMemory()
+	defer meta.Close()
+	statusResource := resources.NewStatusResource(meta)
+	configResource := resources.NewClusterConfigResource(t.Context(), func() (model.ClusterConfig, error) {
+		return model.ClusterConfig{}, nil
+	}, nil, nil)
+	defer configResource.Close()
+
+	sc := NewShardController(constant.DefaultNamespace, shard, namespaceConfig, model.ShardMetadata{
+		Status:         model.ShardStatusSteadyState,
+		Term:           1,
+		Leader:         &s1,
+		Ensemble:       []model.Server{s1, s2, s3},
+		Int32HashRange: model.Int32HashRange{Min: 50, Max: 99},
+	}, configResource, statusResource, nil, rpc, DefaultPeriodicTasksInterval)
+
+	n1.GetStatusResponse(1, proto.ServingStatus_LEADER, 0, 0)
+	n2.GetStatusResponse(1, proto.ServingStatus_FOLLOWER, 0, 0)
+	n3.GetStatusResponse(1, proto.ServingStatus_FOLLOWER, 0, 0)
+
+	target := model.ShardMetadata{
+		Term:           0,
+		Ensemble:       []model.Server{s1, s2},
+		Int32HashRange: model.Int32HashRange{Min: 0, Max: 99},
+		MergedFrom:     []int64{4, 5},
+	}
+
+	// The snapshot is staged while the shard keeps accepting writes
+	targetOffsets := map[string]int64{s1.Internal: 7, s2.Internal: 7}
+	n1.StageMergeResponse(targetOffsets, nil)
+	offsets, err := sc.StageMerge(6, target)
+	assert.NoError(t, err)
+	assert.Equal(t, targetOffsets, offsets)
+	assert.Equal(t, model.ShardStatusSteadyState, sc.Metadata().Status())
+
+	req := n1.expectMergeShardRequest(t, shard, 1)
+	assert.EqualValues(t, 6, req.TargetShard)
+	assert.EqualValues(t, 0, req.TargetTerm)
+	assert.True(t, req.Merge)
+	assert.False(t, req.CatchUp)
+	assert.Equal(t, []int64{4, 5}, req.MergeShards)
+	assert.Equal(t, []string{s1.Internal, s2.Internal}, req.TargetEnsemble)
+
+	wg := concurrent.NewWaitGroup(1)
+	wg.Go(func() error {
+		return sc.Merge(6, target, offsets, func() error {
+			assert.Equal(t, model.ShardStatusMerging, sc.Metadata().Status())
+			return nil
+		})
+	})
+
+	// Then the log entries that follow the snapshot are copied
+	n1.MergeShardResponse(10, nil)
+	req = n1.expectMergeShardRequest(t, shard, 1)
+	assert.True(t, req.Merge)
+	assert.True(t, req.CatchUp)
+	assert.Equal(t, targetOffsets, req.TargetOffsets)
+
+	// After the merge, the source shard gets deleted
+	n1.DeleteShardResponse(nil)
+	n2.DeleteShardResponse(nil)
+	n3.DeleteShardResponse(nil)
+
+	assert.NoError(t, wg.Wait(context.Background()))
+
+	n1.expectDeleteShardRequest(t, shard, 1)
+	n2.expectDeleteShardRequest(t, shard, 1)
+	n3.expectDeleteShardRequest(t, shard, 1)
+
+	assert.NoError(t, sc.Close())
+}
+
+func TestShardController_TransferLeadership(t *testing.T) {
+	var shard int64 = 5
+	rpc := newMockRpcProvider()
+
+	s1 := model.Server{Public: "s1:9091", Internal: "s1:8191"}
+	s2 := model.Server{Public: "s2:9091", Internal: "s2:8191"}
+	s3 := model.Server{Public: "s3:9091", Internal: "s3:8191"}
+	n1 := rpc.GetNode(s1)
+	n2 := rpc.GetNode(s2)
+	n3 := rpc.GetNode(s3)
+
+	meta := metadata.NewMetadataProviderMemory()
+	defer meta.Close()
+	statusResource := resources.NewStatusResource(meta)
+	configResource := resources.NewClusterConfigResource(t.Context(), func() (model.ClusterConfig, error) {
+		return model.ClusterConfig{}, nil
+	}, nil, nil)
+	defer configResource.Close()
+
+	sc := NewShardController(constant.DefaultNamespace, shard, namespaceConfig, model.ShardMetadata{
+		Status:   model.ShardStatusSteadyState,
+		Term:     1,
+		Leader:   &s1,
+		Ensemble: []model.Server{s1, s2, s3},
+	}, configResource, statusResource, nil, rpc, DefaultPeriodicTasksInterval)
+
+	n1.GetStatusResponse(1, proto.ServingStatus_LEADER, 10, 10)
+	n2.GetStatusResponse(1, proto.ServingStatus_FOLLOWER, 10, 10)
+	n3.GetStatusResponse(1, proto.ServingStatus_FOLLOWER, 10, 10)
+
+	// The leader hands off the leadership once s2 is caught up. Only the
+	// current and the new leader are fenced before s2 becomes leader, while
+	// s3 rejoins it afterward
+	n1.TransferLeadershipResponse(1, 10, nil)
+	n1.NewTermResponse(1, 10, nil)
+	n2.NewTermResponse(1, 10, nil)
+	n2.BecomeLeaderResponse(nil)
+	n3.NewTermResponse(1, 10, nil)
+	n2.AddFollowerResponse(nil)
+
+	leader := sc.Election(&actions.ElectionAction{Shard: shard, PreferredLeader: s2.GetIdentifier()})
+	assert.Equal(t, s2.GetIdentifier(), leader)
+
+	n1.expectTransferLeadershipRequest(t, shard, 1, s2.Internal)
+	n1.expectNewTermRequest(t, shard, 2, true)
+	n2.expectNewTermRequest(t, shard, 2, true)
+	n2.expectBecomeLeaderRequest(t, shard, 2, 3)
+
+	assert.EqualValues(t, 2, sc.Metadata().Term())
+	assert.Equal(t, s2, *sc.Metadata().Leader())
+
+	n3.expectNewTermRequest(t, shard, 2, true)
+	n2.expectAddFollowerRequest(t, shard, 2)
+
+	// If s2 is not caught up, the transfer fails and the leader is elected
+	// among the nodes with all the entries
+	n2.TransferLeadershipResponse(0, 0, errors.New("timeout"))
+	n1.NewTermResponse(2, 12, nil)
+	n2.NewTermResponse(2, 12, nil)
+	n3.NewTermResponse(2, 10, nil)
+	n1.BecomeLeaderResponse(nil)
+	n2.BecomeLeaderResponse(nil)
+
+	leader = sc.Election(&actions.ElectionAction{Shard: shard, PreferredLeader: s3.GetIdentifier()})
+	assert.NotEqual(t, s3.GetIdentifier(), leader)
+
+	n2.expectTransferLeadershipRequest(t, shard, 2, s3.Internal)
+	n1.expectNewTermRequest(t, shard, 3, true)
+	n2.expectNewTermRequest(t, shard, 3, true)
+	n3.expectNewTermRequest(t, shard, 3, true)
+
+	assert.EqualValues(t, 3, sc.Metadata().Term())
+	assert.NotEqual(t, s3, *sc.Metadata().Leader())
+
+	assert.NoError(t, sc.Close())
+}
+
+func TestShardController_TransferLeadershipPastLastEntry(t *testing.T) {
+	var shard int64 = 5
+	rpc := newMockRpcProvider()
+
+	s1 := model.Server{Public: "s1:9091", Internal: "s1:8191"}
+	s2 := model.Server{Public: "s2:9091", Internal: "s2:8191"}
+	s3 := model.Server{Public: "s3:9091", Internal: "s3:8191"}
+	n1 := rpc.GetNode(s1)
+	n2 := rpc.GetNode(s2)
+	n3 := rpc.GetNode(s3)
+
+	meta := metadata.NewMetadataProviderMemory()
+	defer meta.Close()
+	statusResource := resources.NewStatusResource(meta)
+	configResource := resources.NewClusterConfigResource(t.Context(), func() (model.ClusterConfig, error) {
+		return model.ClusterConfig{}, nil
+	}, nil, nil)
+	defer configResource.Close()
+
+	sc := NewShardController(constant.DefaultNamespace, shard, namespaceConfig, model.ShardMetadata{
+		Status:   model.ShardStatusSteadyState,
+		Term:     1,
+		Leader:   &s1,
+		Ensemble: []model.Server{s1, s2, s3},
+	}, configResource, statusResource, nil, rpc, DefaultPeriodicTasksInterval)
+
+	n1.GetStatusResponse(1, proto.ServingStatus_LEADER, 10, 10)
+	n2.GetStatusResponse(1, proto.ServingStatus_FOLLOWER, 10, 10)
+	n3.GetStatusResponse(1, proto.ServingStatus_FOLLOWER, 10, 10)
+
+	// The current leader added an entry after the one acknowledged by s2, so
+	// s2 cannot take over directly, and the leader is elected among the nodes
+	// with all the entries
+	n1.TransferLeadershipResponse(1, 10, nil)
+	n1.NewTermResponse(1, 11, nil)
+	n2.NewTermResponse(1, 10, nil)
+	n1.NewTermResponse(1, 11, nil)
+	n2.NewTermResponse(1, 10, nil)
+	n3.NewTermResponse(1, 11, nil)
+	n1.BecomeLeaderResponse(nil)
+	n3.BecomeLeaderResponse(nil)
+
+	leader := sc.Election(&actions.ElectionAction{Shard: shard, PreferredLeader: s2.GetIdentifier()})
+	assert.NotEqual(t, s2.GetIdentifier(), leader)
+
+	n1.expectTransferLeadershipRequest(t, shard, 1, s2.Internal)
+	n1.expectNewTermRequest(t, shard, 2, true)
+	n2.expectNewTermRequest(t, shard, 2, true)
+	n1.expectNewTermRequest(t, shard, 3, true)
+	n2.expectNewTermRequest(t, shard, 3, true)
+	n3.expectNewTermRequest(t, shard, 3, true)
+
+	assert.EqualValues(t, 3, sc.Metadata().Term())
+	assert.NotEqual(t, s2, *sc.Metadata().Leader())
+
+	assert.NoError(t, sc.Close())
+}
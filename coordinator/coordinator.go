@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
+	"slices"
 	"sync"
 	"time"
 
@@ -32,6 +34,7 @@ import (
 	"github.com/oxia-db/oxia/coordinator/resources"
 
 	"github.com/oxia-db/oxia/common/concurrent"
+	"github.com/oxia-db/oxia/common/constant"
 	"github.com/oxia-db/oxia/common/process"
 	"github.com/oxia-db/oxia/coordinator/balancer"
 	"github.com/oxia-db/oxia/coordinator/metadata"
@@ -43,6 +46,9 @@ import (
 	"github.com/oxia-db/oxia/proto"
 )
 
+// Maximum time to wait for the new shards to be ready, when splitting or merging shards.
+const newShardsReadyTimeout = 1 * time.Minute
+
 type Coordinator interface {
 	io.Closer
 	controllers.ShardEventListener
@@ -56,6 +62,64 @@ type Coordinator interface {
 
 	StatusResource() resources.StatusResource
 	ConfigResource() resources.ClusterConfigResource
+
+	// SplitShard splits the hash range of a shard into two new shards, which
+	// replace the existing shard once its data is copied. It returns the ids
+	// of the new shards.
+	SplitShard(namespace string, shard int64) ([]int64, error)
+
+	// MergeShards merges two shards with adjacent hash ranges into a new shard,
+	// which replaces them once their data is copied. It returns the id of the
+	// new shard.
+	MergeShards(namespace string, shard1 int64, shard2 int64) (int64, error)
+
+	// CreateNamespace adds a namespace to the cluster. Its config is persisted
+	// in the cluster status, alongside the namespaces of the cluster config.
+	CreateNamespace(namespaceConfig model.NamespaceConfig) error
+
+	// DeleteNamespace removes a namespace that was created with
+	// CreateNamespace, deleting all its shards.
+	DeleteNamespace(namespace string) error
+
+	// ElectLeader triggers a new leader election for the shard. It returns the
+	// identifier of the new leader.
+	ElectLeader(namespace string, shard int64) (string, error)
+
+	// TransferLeadership moves the leadership of the shard to another node of
+	// its ensemble. The current leader rejects the writes with a retriable
+	// error until the node is caught up, so that no write fails or gets lost.
+	TransferLeadership(namespace string, shard int64, to string) (string, error)
+
+	// SwapNode replaces a node in the ensemble of the shard with another one,
+	// and waits until the new node is caught up.
+	SwapNode(namespace string, shard int64, from string, to string) error
+
+	// DrainNode starts moving the leaderships and then the replicas out of the
+	// node, in background. The node is not selected for new leaders or
+	// replicas, until it is removed from the cluster config or the drain is
+	// canceled. It returns the current progress of the drain.
+	DrainNode(node string) (*DrainStatus, error)
+
+	// DrainStatus returns the progress of the drain of the node.
+	DrainStatus(node string) (*DrainStatus, error)
+
+	// CancelDrain stops draining the node. The shards already moved out of
+	// the node are not moved back.
+	CancelDrain(node string) error
+
+	// ListSessions returns the sessions of the namespace, as tracked by the
+	// shard leaders. If the shard is set, only its sessions are returned.
+	// Otherwise, the shards whose sessions cannot be listed are returned
+	// with their error, along with the sessions of the other shards.
+	ListSessions(namespace string, shard *int64) ([]Session, map[int64]error, error)
+
+	// DescribeSession returns a session of the shard, along with the keys of
+	// the ephemeral records it owns.
+	DescribeSession(namespace string, shard int64, sessionId int64) (*Session, error)
+
+	// RevokeSession expires a session of the shard, deleting all its
+	// ephemeral records.
+	RevokeSession(namespace string, shard int64, sessionId int64) error
 }
 
 var _ Coordinator = &coordinator{}
@@ -77,6 +141,8 @@ type coordinator struct {
 	// nodes list. We keep sending them assignments updates
 	// because they might be still reachable to clients.
 	drainingNodes map[string]controllers.NodeController
+	// The drains started through the admin API
+	drains map[string]*nodeDrain
 
 	loadBalancer     balancer.LoadBalancer
 	ensembleSelector selectors.Selector[*ensemble.Context, []string]
@@ -150,6 +216,7 @@ func (c *coordinator) ConfigChanged(newConfig *model.ClusterConfig) {
 		c.Info("Detected a removed node", slog.Any("server", serverID))
 		// Moved the node
 		delete(c.nodeControllers, serverID)
+		c.stopDrain(serverID)
 		nc.SetStatus(controllers.Draining)
 		c.drainingNodes[serverID] = nc
 	}
@@ -222,6 +289,7 @@ func (c *coordinator) waitForAllNodesToBeAvailable() {
 // It uses the ensemble selector to choose appropriate servers and returns the selected server metadata or an error.
 func (c *coordinator) selectNewEnsemble(ns *model.NamespaceConfig, editingStatus *model.ClusterStatus) ([]model.Server, error) {
 	nodes, nodesMetadata := c.configResource.NodesWithMetadata()
+	utils.RemoveDrainingNodes(nodes, editingStatus)
 	ensembleContext := &ensemble.Context{
 		Candidates:         nodes,
 		CandidatesMetadata: nodesMetadata,
@@ -341,7 +409,7 @@ func (c *coordinator) handleActionElection(ac actions.Action) {
 	c.RUnlock()
 	if !ok {
 		c.Warn("Shard controller not found", slog.Int64("shard", electionAc.Shard))
-		electionAc.Done(nil)
+		electionAc.Done("")
 		return
 	}
 	electionAc.Done(sc.Election(electionAc))
@@ -369,6 +437,367 @@ func (c *coordinator) handleActionSwap(ac actions.Action) {
 	}
 }
 
+func (c *coordinator) SplitShard(namespace string, shard int64) ([]int64, error) {
+	c.Lock()
+	parentController, ok := c.shardControllers[shard]
+	if !ok {
+		c.Unlock()
+		return nil, errors.Wrapf(ErrShardNotFound, "shard %d", shard)
+	}
+	namespaceConfig, ok := c.configResource.NamespaceConfig(namespace)
+	if !ok {
+		c.Unlock()
+		return nil, errors.Wrap(constant.ErrNamespaceNotFound, namespace)
+	}
+
+	// Allocate the children shards and persist them, before starting to copy the data
+	var children map[int64]model.ShardMetadata
+	for {
+		currentStatus, version := c.statusResource.LoadWithVersion()
+		parent, err := getSteadyShard(currentStatus, namespace, shard)
+		if err != nil {
+			c.Unlock()
+			return nil, err
+		}
+		if parent.Int32HashRange.Min == parent.Int32HashRange.Max {
+			c.Unlock()
+			return nil, errors.Errorf("shard %d hash range cannot be split further", shard)
+		}
+
+		newStatus := currentStatus.Clone()
+		children = splitShardMetadata(shard, parent, newStatus.ShardIdGenerator)
+		for childId, child := range children {
+			newStatus.Namespaces[namespace].Shards[childId] = child
+		}
+		newStatus.ShardIdGenerator += int64(len(children))
+
+		if c.statusResource.Swap(newStatus, version) {
+			break
+		}
+	}
+	c.Unlock()
+
+	c.Info(
+		"Splitting shard",
+		slog.String("namespace", namespace),
+		slog.Int64("shard", shard),
+		slog.Any("children", children),
+	)
+
+	err := parentController.Split(children, func() error {
+		return c.startNewShards(namespace, namespaceConfig, children)
+	})
+
+	c.Lock()
+	defer c.Unlock()
+
+	if err != nil {
+		c.discardNewShards(namespace, namespaceConfig, children)
+		return nil, errors.Wrapf(err, "failed to split shard %d", shard)
+	}
+
+	// The parent controller is deleting the shard and will close itself
+	delete(c.shardControllers, shard)
+	c.computeNewAssignments()
+	c.loadBalancer.Trigger()
+
+	c.Info(
+		"Successfully split shard",
+		slog.String("namespace", namespace),
+		slog.Int64("shard", shard),
+		slog.Any("children", children),
+	)
+	return slices.Sorted(maps.Keys(children)), nil
+}
+
+func (c *coordinator) MergeShards(namespace string, shard1 int64, shard2 int64) (int64, error) {
+	if shard1 == shard2 {
+		return 0, errors.Wrapf(ErrInvalidMerge, "cannot merge shard %d with itself", shard1)
+	}
+
+	c.Lock()
+	for _, shard := range []int64{shard1, shard2} {
+		if _, ok := c.shardControllers[shard]; !ok {
+			c.Unlock()
+			return 0, errors.Wrapf(ErrShardNotFound, "shard %d", shard)
+		}
+	}
+	namespaceConfig, ok := c.configResource.NamespaceConfig(namespace)
+	if !ok {
+		c.Unlock()
+		return 0, errors.Wrap(constant.ErrNamespaceNotFound, namespace)
+	}
+
+	// Allocate the merged shard and persist it, before starting to copy the data
+	var left, right, targetId int64
+	var target model.ShardMetadata
+	for {
+		currentStatus, version := c.statusResource.LoadWithVersion()
+		leftMeta, err := getSteadyShard(currentStatus, namespace, shard1)
+		if err != nil {
+			c.Unlock()
+			return 0, err
+		}
+		rightMeta, err := getSteadyShard(currentStatus, namespace, shard2)
+		if err != nil {
+			c.Unlock()
+			return 0, err
+		}
+
+		left, right = shard1, shard2
+		if leftMeta.Int32HashRange.Min > rightMeta.Int32HashRange.Min {
+			left, right = right, left
+			leftMeta, rightMeta = rightMeta, leftMeta
+		}
+		if leftMeta.Int32HashRange.Max+1 != rightMeta.Int32HashRange.Min {
+			c.Unlock()
+			return 0, errors.Wrapf(ErrInvalidMerge, "shards %d and %d do not have adjacent hash ranges", left, right)
+		}
+
+		newStatus := currentStatus.Clone()
+		targetId = newStatus.ShardIdGenerator
+		target = mergeShardMetadata(left, leftMeta, right, rightMeta)
+		newStatus.Namespaces[namespace].Shards[targetId] = target
+		newStatus.ShardIdGenerator++
+
+		if c.statusResource.Swap(newStatus, version) {
+			break
+		}
+	}
+	leftController := c.shardControllers[left]
+	rightController := c.shardControllers[right]
+	c.Unlock()
+
+	c.Info(
+		"Merging shards",
+		slog.String("namespace", namespace),
+		slog.Int64("left-shard", left),
+		slog.Int64("right-shard", right),
+		slog.Int64("target-shard", targetId),
+		slog.Any("target", target),
+	)
+
+	targets := map[int64]model.ShardMetadata{targetId: target}
+
+	// The snapshots of both the shards are staged in the merged shard while
+	// they keep accepting writes. Then, the log entries that follow are copied,
+	// first the ones of the left shard, then the ones of the right shard. Each
+	// shard rejects writes from the moment its log entries are copied, until
+	// the merged shard replaces them, or the merge is aborted.
+	leftOffsets, err := leftController.StageMerge(targetId, target)
+	var rightOffsets map[string]int64
+	if err == nil {
+		rightOffsets, err = rightController.StageMerge(targetId, target)
+	}
+	if err == nil {
+		err = leftController.Merge(targetId, target, leftOffsets, func() error {
+			return rightController.Merge(targetId, target, rightOffsets, func() error {
+				if err := c.startNewShards(namespace, namespaceConfig, targets); err != nil {
+					return err
+				}
+
+				// Both the shards must be marked for deletion at once, to make
+				// the merged shard visible
+				return c.retireShards(namespace, left, right)
+			})
+		})
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if err != nil {
+		c.discardNewShards(namespace, namespaceConfig, targets)
+		return 0, errors.Wrapf(err, "failed to merge shards %d and %d", left, right)
+	}
+
+	// The source controllers are deleting the shards and will close themselves
+	delete(c.shardControllers, left)
+	delete(c.shardControllers, right)
+	c.computeNewAssignments()
+	c.loadBalancer.Trigger()
+
+	c.Info(
+		"Successfully merged shards",
+		slog.String("namespace", namespace),
+		slog.Int64("left-shard", left),
+		slog.Int64("right-shard", right),
+		slog.Int64("target-shard", targetId),
+	)
+	return targetId, nil
+}
+
+// startNewShards starts the controllers for the shards created by a split or
+// a merge, once the data is copied, and waits until they have a leader.
+// The new shards are not exposed to the clients until the shards they are
+// replacing are marked for deletion.
+func (c *coordinator) startNewShards(namespace string, namespaceConfig *model.NamespaceConfig, shards map[int64]model.ShardMetadata) error {
+	c.Lock()
+	newControllers := make([]controllers.ShardController, 0, len(shards))
+	for shardId, shardMeta := range shards {
+		sc := controllers.NewShardController(namespace, shardId, namespaceConfig,
+			shardMeta, c.configResource, c.statusResource, c, c.rpc, controllers.DefaultPeriodicTasksInterval)
+		c.shardControllers[shardId] = sc
+		newControllers = append(newControllers, sc)
+	}
+	c.Unlock()
+
+	ctx, cancel := context.WithTimeout(c.ctx, newShardsReadyTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for _, sc := range newControllers {
+		for sc.Metadata().Status() != model.ShardStatusSteadyState {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return errors.Wrap(ctx.Err(), "new shards failed to elect a leader")
+			}
+		}
+	}
+	return nil
+}
+
+// discardNewShards deletes the shards created by a split or a merge that was
+// aborted. The controllers will close themselves after the deletion.
+// This is called while already holding the lock on the coordinator.
+func (c *coordinator) discardNewShards(namespace string, namespaceConfig *model.NamespaceConfig, shards map[int64]model.ShardMetadata) {
+	for shardId, shardMeta := range shards {
+		if sc, exist := c.shardControllers[shardId]; exist {
+			sc.DeleteShard()
+		} else {
+			shardMeta.Status = model.ShardStatusDeleting
+			_ = controllers.NewShardController(namespace, shardId, namespaceConfig,
+				shardMeta, c.configResource, c.statusResource, c, c.rpc, controllers.DefaultPeriodicTasksInterval)
+		}
+		delete(c.shardControllers, shardId)
+	}
+}
+
+// retireShards marks the shards for deletion, with a single update of the
+// cluster status.
+func (c *coordinator) retireShards(namespace string, shards ...int64) error {
+	for {
+		currentStatus, version := c.statusResource.LoadWithVersion()
+		newStatus := currentStatus.Clone()
+		ns, exist := newStatus.Namespaces[namespace]
+		if !exist {
+			return errors.Wrap(constant.ErrNamespaceNotFound, namespace)
+		}
+
+		for _, shard := range shards {
+			shardMeta, exist := ns.Shards[shard]
+			if !exist {
+				return errors.Errorf("shard %d not found in namespace %s", shard, namespace)
+			}
+			shardMeta.Status = model.ShardStatusDeleting
+			ns.Shards[shard] = shardMeta
+		}
+
+		if c.statusResource.Swap(newStatus, version) {
+			return nil
+		}
+	}
+}
+
+// getSteadyShard returns the metadata of a shard that is ready to be split or merged.
+func getSteadyShard(status *model.ClusterStatus, namespace string, shard int64) (model.ShardMetadata, error) {
+	ns, exist := status.Namespaces[namespace]
+	if !exist {
+		return model.ShardMetadata{}, errors.Wrap(constant.ErrNamespaceNotFound, namespace)
+	}
+	shardMeta, exist := ns.Shards[shard]
+	switch {
+	case !exist:
+		return model.ShardMetadata{}, errors.Wrapf(ErrShardNotFound, "shard %d in namespace %s", shard, namespace)
+	case shardMeta.Status != model.ShardStatusSteadyState || isPendingShard(ns, shardMeta):
+		return model.ShardMetadata{}, errors.Errorf("shard %d is not in steady state: %s", shard, shardMeta.Status)
+	}
+	return shardMeta, nil
+}
+
+// splitShardMetadata splits the hash range of the parent shard in half,
+// assigning the same ensemble to both the children shards.
+func splitShardMetadata(parentId int64, parent model.ShardMetadata, nextShardId int64) map[int64]model.ShardMetadata {
+	middle := parent.Int32HashRange.Min + (parent.Int32HashRange.Max-parent.Int32HashRange.Min)/2
+	ranges := []model.Int32HashRange{
+		{Min: parent.Int32HashRange.Min, Max: middle},
+		{Min: middle + 1, Max: parent.Int32HashRange.Max},
+	}
+
+	children := make(map[int64]model.ShardMetadata)
+	for idx, hashRange := range ranges {
+		ensemble := make([]model.Server, len(parent.Ensemble))
+		copy(ensemble, parent.Ensemble)
+		splitParent := parentId
+
+		children[nextShardId+int64(idx)] = model.ShardMetadata{
+			Status:         model.ShardStatusUnknown,
+			Term:           0,
+			Leader:         nil,
+			Ensemble:       ensemble,
+			Int32HashRange: hashRange,
+			SplitParent:    &splitParent,
+		}
+	}
+	return children
+}
+
+// mergeShardMetadata combines the hash ranges of two adjacent shards, assigning
+// the ensemble of the left shard to the merged shard.
+func mergeShardMetadata(leftId int64, left model.ShardMetadata, rightId int64, right model.ShardMetadata) model.ShardMetadata {
+	ensemble := make([]model.Server, len(left.Ensemble))
+	copy(ensemble, left.Ensemble)
+
+	return model.ShardMetadata{
+		Status:   model.ShardStatusUnknown,
+		Term:     0,
+		Leader:   nil,
+		Ensemble: ensemble,
+		Int32HashRange: model.Int32HashRange{
+			Min: left.Int32HashRange.Min,
+			Max: right.Int32HashRange.Max,
+		},
+		MergedFrom: []int64{leftId, rightId},
+	}
+}
+
+// isPendingShard checks whether the shard was created by a split or a merge
+// that is not completed yet, in which case the original shards are still
+// serving its hash range.
+func isPendingShard(ns model.NamespaceStatus, shard model.ShardMetadata) bool {
+	sources := make([]int64, 0, len(shard.MergedFrom)+1)
+	sources = append(sources, shard.MergedFrom...)
+	if shard.SplitParent != nil {
+		sources = append(sources, *shard.SplitParent)
+	}
+
+	for _, source := range sources {
+		if sourceMeta, exist := ns.Shards[source]; exist && sourceMeta.Status != model.ShardStatusDeleting {
+			return true
+		}
+	}
+	return false
+}
+
+// abortPendingShards marks for deletion all the shards created by splits or
+// merges that were interrupted before completing.
+func abortPendingShards(clusterStatus *model.ClusterStatus) bool {
+	aborted := false
+	for _, ns := range clusterStatus.Namespaces {
+		for shard, shardMetadata := range ns.Shards {
+			if isPendingShard(ns, shardMetadata) && shardMetadata.Status != model.ShardStatusDeleting {
+				shardMetadata.Status = model.ShardStatusDeleting
+				ns.Shards[shard] = shardMetadata
+				aborted = true
+			}
+		}
+	}
+	return aborted
+}
+
 // This is called while already holding the lock on the coordinator.
 func (c *coordinator) computeNewAssignments() {
 	c.assignments = &proto.ShardAssignments{
@@ -384,14 +813,21 @@ func (c *coordinator) computeNewAssignments() {
 
 		for shard, a := range ns.Shards {
 			var leader string
+			var followers []string
 			if a.Leader != nil {
 				leader = a.Leader.Public
+				for _, server := range a.Ensemble {
+					if server.Public != leader {
+						followers = append(followers, server.Public)
+					}
+				}
 			}
-			if a.Status != model.ShardStatusDeleting {
+			if a.Status != model.ShardStatusDeleting && !isPendingShard(ns, a) {
 				nsAssignments.Assignments = append(nsAssignments.Assignments,
 					&proto.ShardAssignment{
-						Shard:  shard,
-						Leader: leader,
+						Shard:     shard,
+						Leader:    leader,
+						Followers: followers,
 						ShardBoundaries: &proto.ShardAssignment_Int32HashRange{
 							Int32HashRange: &proto.Int32HashRange{
 								MinHashInclusive: a.Int32HashRange.Min,
@@ -423,6 +859,7 @@ func NewCoordinator(meta metadata.Provider,
 		shardControllers:      make(map[int64]controllers.ShardController),
 		nodeControllers:       make(map[string]controllers.NodeController),
 		drainingNodes:         make(map[string]controllers.NodeController),
+		drains:                make(map[string]*nodeDrain),
 		rpc:                   rpcProvider,
 	}
 
@@ -434,10 +871,10 @@ func NewCoordinator(meta metadata.Provider,
 	c.Info("This coordinator is now leader")
 
 	c.ctx, c.cancel = context.WithCancel(context.Background())
-	c.configResource = resources.NewClusterConfigResource(c.ctx, clusterConfigProvider, clusterConfigNotificationsCh, c)
-	c.assignmentsChanged = concurrent.NewConditionContext(c)
-
 	c.statusResource = resources.NewStatusResource(meta)
+	c.configResource = resources.NewClusterConfigResource(c.ctx,
+		withDynamicNamespaces(clusterConfigProvider, c.statusResource), clusterConfigNotificationsCh, c)
+	c.assignmentsChanged = concurrent.NewConditionContext(c)
 
 	c.loadBalancer = balancer.NewLoadBalancer(balancer.Options{
 		Context:               c.ctx,
@@ -479,7 +916,9 @@ func NewCoordinator(meta metadata.Provider,
 		clusterStatus, shardsToAdd, shardsToDelete = utils.ApplyClusterChanges(clusterConfig,
 			clusterStatus, c.selectNewEnsemble)
 
-		if len(shardsToAdd) > 0 || len(shardsToDelete) > 0 {
+		// Any shard split or merge that was in progress when the
+		// coordinator stopped is aborted
+		if abortPendingShards(clusterStatus) || len(shardsToAdd) > 0 || len(shardsToDelete) > 0 {
 			c.statusResource.Update(clusterStatus)
 		}
 	}
@@ -503,6 +942,13 @@ func NewCoordinator(meta metadata.Provider,
 		"component": "coordinator-action-worker",
 	}, c.startBackgroundActionWorker)
 
+	// Resume the drains that were in progress when the coordinator stopped
+	c.Lock()
+	for _, node := range clusterStatus.DrainingNodes {
+		c.startDrain(node)
+	}
+	c.Unlock()
+
 	c.loadBalancer.Start()
 	return c, nil
 }
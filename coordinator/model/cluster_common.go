@@ -14,6 +14,8 @@
 
 package model
 
+import "github.com/oxia-db/oxia/coordinator/policies"
+
 type Server struct {
 	// Name is the unique identification for clusters
 	Name *string `json:"name" yaml:"name"`
@@ -28,6 +30,9 @@ type Server struct {
 type ServerMetadata struct {
 	// Labels represents a key-value map to store metadata associated with a server.
 	Labels map[string]string `json:"labels" yaml:"labels"`
+
+	// Taints keeps away the replicas of the namespaces that don't tolerate them.
+	Taints []policies.Taint `json:"taints,omitempty" yaml:"taints,omitempty"`
 }
 
 func (sv *Server) GetIdentifier() string {
@@ -39,6 +39,10 @@ type NamespaceConfig struct {
 	InitialShardCount    uint32                       `json:"initialShardCount" yaml:"initialShardCount"`
 	ReplicationFactor    uint32                       `json:"replicationFactor" yaml:"replicationFactor"`
 	NotificationsEnabled entity.OptBooleanDefaultTrue `json:"notificationsEnabled" yaml:"notificationsEnabled"`
+	// NotificationsIncludeValue and NotificationsIncludePreviousValue make the notifications
+	// carry the value written and replaced by each change. They apply from the next term of each shard.
+	NotificationsIncludeValue         bool `json:"notificationsIncludeValue,omitempty" yaml:"notificationsIncludeValue,omitempty"`
+	NotificationsIncludePreviousValue bool `json:"notificationsIncludePreviousValue,omitempty" yaml:"notificationsIncludePreviousValue,omitempty"`
 	// Policies represents additional configuration policies for the namespace, such as anti-affinity rules.
 	Policies *policies.Policies `json:"policies,omitempty" yaml:"policies,omitempty"`
 }
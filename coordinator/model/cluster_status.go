@@ -14,6 +14,8 @@
 
 package model
 
+import "slices"
+
 type Int32HashRange struct {
 	// The minimum inclusive hash that the shard can contain
 	Min uint32 `json:"min"`
@@ -39,6 +41,16 @@ type ShardMetadata struct {
 	// quorum.
 	PendingDeleteShardNodes []Server       `json:"pendingDeleteShardNodes" yaml:"pendingDeleteShardNodes"`
 	Int32HashRange          Int32HashRange `json:"int32HashRange" yaml:"int32HashRange"`
+
+	// SplitParent is the shard from which this shard was split. The shard
+	// is not exposed to the clients for as long as the parent shard is
+	// still present and not being deleted.
+	SplitParent *int64 `json:"splitParent,omitempty" yaml:"splitParent,omitempty"`
+
+	// MergedFrom are the shards that were merged into this shard. The shard
+	// is not exposed to the clients for as long as any of them is still
+	// present and not being deleted.
+	MergedFrom []int64 `json:"mergedFrom,omitempty" yaml:"mergedFrom,omitempty"`
 }
 
 type NamespaceStatus struct {
@@ -50,6 +62,19 @@ type ClusterStatus struct {
 	Namespaces       map[string]NamespaceStatus `json:"namespaces" yaml:"namespaces"`
 	ShardIdGenerator int64                      `json:"shardIdGenerator" yaml:"shardIdGenerator"`
 	ServerIdx        uint32                     `json:"serverIdx" yaml:"serverIdx"`
+
+	// DynamicNamespaces are the namespaces created through the admin API,
+	// in addition to the ones defined in the cluster config.
+	DynamicNamespaces []NamespaceConfig `json:"dynamicNamespaces,omitempty" yaml:"dynamicNamespaces,omitempty"`
+
+	// DrainingNodes are the nodes being drained through the admin API. They
+	// are not selected as leaders, nor to host new replicas, until they are
+	// removed from the cluster config or the drain is canceled.
+	DrainingNodes []string `json:"drainingNodes,omitempty" yaml:"drainingNodes,omitempty"`
+
+	// BalancerPaused is set when the load balancer was paused through the
+	// admin API, so that it stays paused across the coordinator restarts.
+	BalancerPaused bool `json:"balancerPaused,omitempty" yaml:"balancerPaused,omitempty"`
 }
 
 func NewClusterStatus() *ClusterStatus {
@@ -78,6 +103,16 @@ func (sm ShardMetadata) Clone() ShardMetadata {
 		Int32HashRange:          sm.Int32HashRange.Clone(),
 	}
 
+	if sm.SplitParent != nil {
+		splitParent := *sm.SplitParent
+		r.SplitParent = &splitParent
+	}
+
+	if sm.MergedFrom != nil {
+		r.MergedFrom = make([]int64, len(sm.MergedFrom))
+		copy(r.MergedFrom, sm.MergedFrom)
+	}
+
 	copy(r.Ensemble, sm.Ensemble)
 	copy(r.RemovedNodes, sm.RemovedNodes)
 	copy(r.PendingDeleteShardNodes, sm.PendingDeleteShardNodes)
@@ -109,5 +144,25 @@ func (c ClusterStatus) Clone() *ClusterStatus {
 		r.Namespaces[name] = n.Clone()
 	}
 
+	r.DynamicNamespaces = slices.Clone(c.DynamicNamespaces)
+	r.DrainingNodes = slices.Clone(c.DrainingNodes)
+	r.BalancerPaused = c.BalancerPaused
+
 	return r
 }
+
+// DynamicNamespace returns the config of a namespace created through the
+// admin API.
+func (c *ClusterStatus) DynamicNamespace(name string) (NamespaceConfig, bool) {
+	for _, nc := range c.DynamicNamespaces {
+		if nc.Name == name {
+			return nc, true
+		}
+	}
+	return NamespaceConfig{}, false
+}
+
+// IsDraining returns whether the node is being drained.
+func (c *ClusterStatus) IsDraining(node string) bool {
+	return slices.Contains(c.DrainingNodes, node)
+}
@@ -15,12 +15,16 @@
 package model
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/common/entity"
 )
 
 func TestClusterStatus_Clone(t *testing.T) {
+	splitParent := int64(0)
 	cs1 := &ClusterStatus{
 		Namespaces: map[string]NamespaceStatus{
 			"test-ns": {
@@ -47,11 +51,34 @@ func TestClusterStatus_Clone(t *testing.T) {
 						}},
 						PendingDeleteShardNodes: []Server{{}},
 					},
+					1: {
+						Status:                  ShardStatusUnknown,
+						Term:                    0,
+						Ensemble:                []Server{},
+						RemovedNodes:            []Server{},
+						PendingDeleteShardNodes: []Server{},
+						SplitParent:             &splitParent,
+					},
+					2: {
+						Status:                  ShardStatusUnknown,
+						Term:                    0,
+						Ensemble:                []Server{},
+						RemovedNodes:            []Server{},
+						PendingDeleteShardNodes: []Server{},
+						MergedFrom:              []int64{0, 1},
+					},
 				},
 			},
 		},
 		ShardIdGenerator: 5,
 		ServerIdx:        7,
+		DynamicNamespaces: []NamespaceConfig{{
+			Name:              "dynamic-ns",
+			InitialShardCount: 2,
+			ReplicationFactor: 3,
+		}},
+		DrainingNodes:  []string{"s1"},
+		BalancerPaused: true,
 	}
 
 	cs2 := cs1.Clone()
@@ -62,7 +89,52 @@ func TestClusterStatus_Clone(t *testing.T) {
 	assert.NotSame(t, &cs1.Namespaces, &cs2.Namespaces)
 	assert.Equal(t, cs1.Namespaces["test-ns"].Shards, cs2.Namespaces["test-ns"].Shards)
 	assert.Equal(t, cs1.Namespaces["test-ns"].Shards[0], cs2.Namespaces["test-ns"].Shards[0])
+	assert.Equal(t, cs1.Namespaces["test-ns"].Shards[1], cs2.Namespaces["test-ns"].Shards[1])
+	assert.NotSame(t, cs1.Namespaces["test-ns"].Shards[1].SplitParent, cs2.Namespaces["test-ns"].Shards[1].SplitParent)
+	assert.Equal(t, cs1.Namespaces["test-ns"].Shards[2], cs2.Namespaces["test-ns"].Shards[2])
+	assert.NotSame(t, &cs1.Namespaces["test-ns"].Shards[2].MergedFrom[0], &cs2.Namespaces["test-ns"].Shards[2].MergedFrom[0])
 
 	assert.Equal(t, cs1.ShardIdGenerator, cs2.ShardIdGenerator)
 	assert.Equal(t, cs1.ServerIdx, cs2.ServerIdx)
+
+	cs2.DynamicNamespaces[0].InitialShardCount = 4
+	assert.EqualValues(t, 2, cs1.DynamicNamespaces[0].InitialShardCount)
+
+	cs2.DrainingNodes[0] = "s2"
+	assert.Equal(t, []string{"s1"}, cs1.DrainingNodes)
+}
+
+func TestClusterStatus_DynamicNamespaces(t *testing.T) {
+	cs := &ClusterStatus{
+		Namespaces: map[string]NamespaceStatus{},
+		DynamicNamespaces: []NamespaceConfig{{
+			Name:                 "ns-1",
+			InitialShardCount:    1,
+			ReplicationFactor:    1,
+			NotificationsEnabled: entity.Bool(false),
+		}, {
+			Name:              "ns-2",
+			InitialShardCount: 2,
+			ReplicationFactor: 3,
+		}},
+	}
+
+	data, err := json.Marshal(cs)
+	assert.NoError(t, err)
+
+	cs2 := &ClusterStatus{}
+	assert.NoError(t, json.Unmarshal(data, cs2))
+
+	nc, exist := cs2.DynamicNamespace("ns-1")
+	assert.True(t, exist)
+	assert.EqualValues(t, 1, nc.InitialShardCount)
+	assert.False(t, nc.NotificationsEnabled.Get())
+
+	nc, exist = cs2.DynamicNamespace("ns-2")
+	assert.True(t, exist)
+	assert.EqualValues(t, 3, nc.ReplicationFactor)
+	assert.True(t, nc.NotificationsEnabled.Get())
+
+	_, exist = cs2.DynamicNamespace("ns-3")
+	assert.False(t, exist)
 }
@@ -64,6 +64,15 @@ func (r *Ratio) RatioGap() float64 {
 	return r.maxNodeLoadRatio - r.minNodeLoadRatio
 }
 
+func (r *Ratio) NodeLoadRatio(nodeID string) *NodeLoadRatio {
+	for iter := r.nodeLoadRatios.Iterator(); iter.Next(); {
+		if node := iter.Value(); node.NodeID == nodeID {
+			return node
+		}
+	}
+	return nil
+}
+
 func (r *Ratio) MoveShardToNode(shard *ShardLoadRatio, fromNode string, toNode string) {
 	for iter := r.nodeLoadRatios.Iterator(); iter.Next(); {
 		node := iter.Value()
@@ -26,6 +26,8 @@ const (
 	ShardStatusSteadyState
 	ShardStatusElection
 	ShardStatusDeleting
+	ShardStatusSplitting
+	ShardStatusMerging
 )
 
 func (s ShardStatus) String() string {
@@ -37,6 +39,8 @@ var toString = map[ShardStatus]string{
 	ShardStatusSteadyState: "SteadyState",
 	ShardStatusElection:    "Election",
 	ShardStatusDeleting:    "Deleting",
+	ShardStatusSplitting:   "Splitting",
+	ShardStatusMerging:     "Merging",
 }
 
 var toShardStatus = map[string]ShardStatus{
@@ -44,6 +48,8 @@ var toShardStatus = map[string]ShardStatus{
 	"SteadyState": ShardStatusSteadyState,
 	"Election":    ShardStatusElection,
 	"Deleting":    ShardStatusDeleting,
+	"Splitting":   ShardStatusSplitting,
+	"Merging":     ShardStatusMerging,
 }
 
 // MarshalJSON marshals the enum as a quoted json string.
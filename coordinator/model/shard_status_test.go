@@ -24,6 +24,9 @@ func TestShardStatus_String(t *testing.T) {
 	assert.Equal(t, "Unknown", ShardStatusUnknown.String())
 	assert.Equal(t, "SteadyState", ShardStatusSteadyState.String())
 	assert.Equal(t, "Election", ShardStatusElection.String())
+	assert.Equal(t, "Deleting", ShardStatusDeleting.String())
+	assert.Equal(t, "Splitting", ShardStatusSplitting.String())
+	assert.Equal(t, "Merging", ShardStatusMerging.String())
 }
 
 func TestShardStatus_JSON(t *testing.T) {
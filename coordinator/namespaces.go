@@ -0,0 +1,172 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"log/slog"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/coordinator/model"
+	"github.com/oxia-db/oxia/coordinator/policies"
+	"github.com/oxia-db/oxia/coordinator/resources"
+)
+
+var (
+	ErrInvalidNamespaceConfig = errors.New("invalid namespace config")
+	ErrNamespaceAlreadyExists = errors.New("namespace already exists")
+	ErrNamespaceNotDynamic    = errors.New("namespace is defined in the cluster config")
+)
+
+// withDynamicNamespaces returns a cluster config provider that adds the
+// namespaces created through the admin API to the ones defined in the
+// cluster config. If a namespace is defined in both, the cluster config wins.
+func withDynamicNamespaces(clusterConfigProvider func() (model.ClusterConfig, error),
+	statusResource resources.StatusResource) func() (model.ClusterConfig, error) {
+	return func() (model.ClusterConfig, error) {
+		config, err := clusterConfigProvider()
+		if err != nil {
+			return config, err
+		}
+
+		namespaces := slices.Clone(config.Namespaces)
+		for _, nc := range statusResource.Load().DynamicNamespaces {
+			if findNamespace(config.Namespaces, nc.Name) {
+				continue
+			}
+			namespaces = append(namespaces, nc)
+		}
+		config.Namespaces = namespaces
+		return config, nil
+	}
+}
+
+func findNamespace(namespaces []model.NamespaceConfig, name string) bool {
+	return slices.ContainsFunc(namespaces, func(nc model.NamespaceConfig) bool {
+		return nc.Name == name
+	})
+}
+
+func (c *coordinator) CreateNamespace(namespaceConfig model.NamespaceConfig) error {
+	if err := validateNamespaceConfig(namespaceConfig, c.configResource.Nodes().Size()); err != nil {
+		return err
+	}
+
+	c.Lock()
+	for {
+		if _, exist := c.configResource.NamespaceConfig(namespaceConfig.Name); exist {
+			c.Unlock()
+			return errors.Wrap(ErrNamespaceAlreadyExists, namespaceConfig.Name)
+		}
+
+		currentStatus, version := c.statusResource.LoadWithVersion()
+		if _, exist := currentStatus.DynamicNamespace(namespaceConfig.Name); exist {
+			c.Unlock()
+			return errors.Wrap(ErrNamespaceAlreadyExists, namespaceConfig.Name)
+		}
+		if _, exist := currentStatus.Namespaces[namespaceConfig.Name]; exist {
+			// The shards of a deleted namespace with the same name are still
+			// being removed from the servers
+			c.Unlock()
+			return errors.Wrapf(ErrNamespaceAlreadyExists, "%s is still being deleted", namespaceConfig.Name)
+		}
+
+		newStatus := currentStatus.Clone()
+		newStatus.DynamicNamespaces = append(newStatus.DynamicNamespaces, namespaceConfig)
+
+		if c.statusResource.Swap(newStatus, version) {
+			break
+		}
+	}
+	c.Unlock()
+
+	c.Info("Created namespace", slog.Any("namespace-config", namespaceConfig))
+	c.ConfigChanged(c.configResource.Reload())
+	return nil
+}
+
+func (c *coordinator) DeleteNamespace(namespace string) error {
+	c.Lock()
+	for {
+		currentStatus, version := c.statusResource.LoadWithVersion()
+		if _, exist := currentStatus.DynamicNamespace(namespace); !exist {
+			c.Unlock()
+			if _, exist := c.configResource.NamespaceConfig(namespace); exist {
+				return errors.Wrap(ErrNamespaceNotDynamic, namespace)
+			}
+			return errors.Wrap(constant.ErrNamespaceNotFound, namespace)
+		}
+
+		newStatus := currentStatus.Clone()
+		newStatus.DynamicNamespaces = slices.DeleteFunc(newStatus.DynamicNamespaces, func(nc model.NamespaceConfig) bool {
+			return nc.Name == namespace
+		})
+
+		if c.statusResource.Swap(newStatus, version) {
+			break
+		}
+	}
+	c.Unlock()
+
+	c.Info("Deleted namespace", slog.String("namespace", namespace))
+	c.ConfigChanged(c.configResource.Reload())
+	return nil
+}
+
+func validateNamespaceConfig(namespaceConfig model.NamespaceConfig, nodesCount int) error {
+	switch {
+	case namespaceConfig.Name == "":
+		return errors.Wrap(ErrInvalidNamespaceConfig, "the name must not be empty")
+	case namespaceConfig.InitialShardCount == 0:
+		return errors.Wrap(ErrInvalidNamespaceConfig, "the initial shard count must be positive")
+	case namespaceConfig.ReplicationFactor == 0:
+		return errors.Wrap(ErrInvalidNamespaceConfig, "the replication factor must be positive")
+	case int(namespaceConfig.ReplicationFactor) > nodesCount:
+		return errors.Wrapf(ErrInvalidNamespaceConfig, "the replication factor %d is greater than the number of servers %d",
+			namespaceConfig.ReplicationFactor, nodesCount)
+	}
+
+	if namespaceConfig.Policies != nil {
+		for _, antiAffinity := range namespaceConfig.Policies.AntiAffinities {
+			if antiAffinity.Mode != policies.Strict && antiAffinity.Mode != policies.Relaxed {
+				return errors.Wrapf(ErrInvalidNamespaceConfig, "unknown anti-affinity mode %q", antiAffinity.Mode)
+			}
+		}
+		for _, leaderAffinity := range namespaceConfig.Policies.LeaderAffinities {
+			if len(leaderAffinity.Labels) == 0 {
+				return errors.Wrap(ErrInvalidNamespaceConfig, "the leader affinity labels must not be empty")
+			}
+		}
+		for _, nodeAffinity := range namespaceConfig.Policies.NodeAffinities {
+			switch {
+			case len(nodeAffinity.Labels) == 0:
+				return errors.Wrap(ErrInvalidNamespaceConfig, "the node affinity labels must not be empty")
+			case nodeAffinity.Mode != policies.Required && nodeAffinity.Mode != policies.Preferred:
+				return errors.Wrapf(ErrInvalidNamespaceConfig, "unknown node affinity mode %q", nodeAffinity.Mode)
+			}
+		}
+		for _, toleration := range namespaceConfig.Policies.Tolerations {
+			switch {
+			case toleration.Key == "":
+				return errors.Wrap(ErrInvalidNamespaceConfig, "the toleration key must not be empty")
+			case toleration.Effect != "" && toleration.Effect != policies.NoSchedule && toleration.Effect != policies.PreferNoSchedule:
+				return errors.Wrapf(ErrInvalidNamespaceConfig, "unknown toleration effect %q", toleration.Effect)
+			}
+		}
+	}
+	return nil
+}
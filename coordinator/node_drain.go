@@ -0,0 +1,314 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/emirpasic/gods/v2/sets/linkedhashset"
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/common/process"
+	"github.com/oxia-db/oxia/coordinator/model"
+	"github.com/oxia-db/oxia/coordinator/utils"
+)
+
+var ErrNodeNotDraining = errors.New("node is not being drained")
+
+type DrainPhase uint32
+
+const (
+	MovingLeaderships DrainPhase = iota
+	MovingReplicas
+	Drained
+	DrainFailed
+)
+
+func (p DrainPhase) String() string {
+	switch p {
+	case MovingLeaderships:
+		return "MovingLeaderships"
+	case MovingReplicas:
+		return "MovingReplicas"
+	case Drained:
+		return "Drained"
+	case DrainFailed:
+		return "Failed"
+	}
+	return "Unknown"
+}
+
+// DrainStatus is the progress of the drain of a node.
+type DrainStatus struct {
+	Node  string
+	Phase DrainPhase
+
+	// LeaderShards and ReplicaShards are the number of shards that still have
+	// the node as leader, or in their ensemble
+	LeaderShards  int
+	ReplicaShards int
+
+	// TotalShards is the number of shards that had the node in their ensemble
+	// when the drain started
+	TotalShards int
+
+	// Err is the reason of the failure, if the drain failed
+	Err error
+}
+
+// SafeToShutdown returns whether the node holds no more replicas.
+func (s *DrainStatus) SafeToShutdown() bool {
+	return s.Phase == Drained && s.ReplicaShards == 0
+}
+
+type nodeDrain struct {
+	sync.RWMutex
+
+	node        string
+	cancel      context.CancelFunc
+	totalShards int
+	phase       DrainPhase
+	err         error
+}
+
+func (d *nodeDrain) setPhase(phase DrainPhase, err error) {
+	d.Lock()
+	defer d.Unlock()
+	d.phase = phase
+	d.err = err
+}
+
+func (d *nodeDrain) isRunning() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.phase == MovingLeaderships || d.phase == MovingReplicas
+}
+
+func (c *coordinator) DrainNode(node string) (*DrainStatus, error) {
+	if _, exist := c.configResource.Node(node); !exist {
+		return nil, errors.Wrap(ErrNodeNotFound, node)
+	}
+
+	c.Lock()
+	if d, exist := c.drains[node]; !exist || !d.isRunning() {
+		// Persist the draining node first, so that it is not selected
+		// as leader while its leaderships are moved away
+		for {
+			currentStatus, version := c.statusResource.LoadWithVersion()
+			if currentStatus.IsDraining(node) {
+				break
+			}
+			newStatus := currentStatus.Clone()
+			newStatus.DrainingNodes = append(newStatus.DrainingNodes, node)
+			if c.statusResource.Swap(newStatus, version) {
+				break
+			}
+		}
+		c.startDrain(node)
+	}
+	c.Unlock()
+
+	return c.DrainStatus(node)
+}
+
+func (c *coordinator) DrainStatus(node string) (*DrainStatus, error) {
+	c.RLock()
+	d, exist := c.drains[node]
+	c.RUnlock()
+	if !exist {
+		return nil, errors.Wrap(ErrNodeNotDraining, node)
+	}
+
+	status := c.statusResource.Load()
+	d.RLock()
+	defer d.RUnlock()
+	return &DrainStatus{
+		Node:          node,
+		Phase:         d.phase,
+		LeaderShards:  len(nodeShards(status, node, true)),
+		ReplicaShards: len(nodeShards(status, node, false)),
+		TotalShards:   d.totalShards,
+		Err:           d.err,
+	}, nil
+}
+
+func (c *coordinator) CancelDrain(node string) error {
+	c.Lock()
+	d, exist := c.drains[node]
+	if !exist {
+		c.Unlock()
+		return errors.Wrap(ErrNodeNotDraining, node)
+	}
+	d.cancel()
+	delete(c.drains, node)
+
+	for {
+		currentStatus, version := c.statusResource.LoadWithVersion()
+		newStatus := currentStatus.Clone()
+		newStatus.DrainingNodes = slices.DeleteFunc(newStatus.DrainingNodes, func(n string) bool {
+			return n == node
+		})
+		if c.statusResource.Swap(newStatus, version) {
+			break
+		}
+	}
+	c.Unlock()
+
+	c.Info("Canceled node drain", slog.String("node", node))
+	c.loadBalancer.Trigger()
+	return nil
+}
+
+// startDrain starts moving the leaderships and the replicas out of the
+// node in background. It must be called with the lock held.
+func (c *coordinator) startDrain(node string) {
+	ctx, cancel := context.WithCancel(c.ctx)
+	d := &nodeDrain{
+		node:        node,
+		cancel:      cancel,
+		totalShards: len(nodeShards(c.statusResource.Load(), node, false)),
+		phase:       MovingLeaderships,
+	}
+	c.drains[node] = d
+
+	c.Add(1)
+	go process.DoWithLabels(ctx, map[string]string{
+		"component": "coordinator-node-drain",
+		"node":      node,
+	}, func() {
+		defer c.Done()
+		c.drainNode(ctx, d)
+	})
+}
+
+// selectDrainLeader selects the node that takes over the leadership of a
+// shard led by a draining node: the member of its ensemble that leads the
+// fewest shards. If there is none, the new leader is chosen by a regular
+// election.
+func (c *coordinator) selectDrainLeader(s utils.NamespaceAndShard) string {
+	status := c.statusResource.Load()
+	shardMetadata, exist := status.Namespaces[s.Namespace].Shards[s.ShardID]
+	if !exist {
+		return ""
+	}
+
+	candidates := linkedhashset.New[string]()
+	for _, server := range shardMetadata.Ensemble {
+		candidates.Add(server.GetIdentifier())
+	}
+	utils.RemoveDrainingNodes(candidates, status)
+
+	_, _, nodeLeaders := utils.NodeShardLeaders(candidates, status)
+	selected := ""
+	for iter := candidates.Iterator(); iter.Next(); {
+		if selected == "" || nodeLeaders[iter.Value()].Size() < nodeLeaders[selected].Size() {
+			selected = iter.Value()
+		}
+	}
+	return selected
+}
+
+// stopDrain stops the drain of a node that was removed from the cluster
+// config. It must be called with the lock held.
+func (c *coordinator) stopDrain(node string) {
+	if d, exist := c.drains[node]; exist {
+		d.cancel()
+		delete(c.drains, node)
+	}
+}
+
+func (c *coordinator) drainNode(ctx context.Context, d *nodeDrain) {
+	c.Info("Draining node", slog.String("node", d.node), slog.Int("shards", d.totalShards))
+
+	// Move the leaderships first, so that the clients are redirected before
+	// the replicas start to be moved. The leaderships are transferred to the
+	// followers, without failing the writes in progress
+	for _, s := range nodeShards(c.statusResource.Load(), d.node, true) {
+		if ctx.Err() != nil {
+			return
+		}
+		leader, err := c.electLeader(s.Namespace, s.ShardID, c.selectDrainLeader(s))
+		if err != nil {
+			c.failDrain(d, errors.Wrapf(err, "failed to move the leadership of shard %d", s.ShardID))
+			return
+		}
+		if leader == d.node {
+			// The node was the only one with all the entries. The
+			// leadership moves when its replica is swapped out.
+			c.Warn("The leadership did not move out of the draining node",
+				slog.String("node", d.node), slog.Int64("shard", s.ShardID))
+		}
+	}
+
+	d.setPhase(MovingReplicas, nil)
+	for _, s := range nodeShards(c.statusResource.Load(), d.node, false) {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Reload the status, to account for the changes done by the previous swaps
+		shardMetadata, exist := c.statusResource.Load().Namespaces[s.Namespace].Shards[s.ShardID]
+		if !exist || shardMetadata.Status == model.ShardStatusDeleting ||
+			findEnsembleNode(shardMetadata.Ensemble, d.node) == nil {
+			continue
+		}
+
+		target, err := c.selectReplacementNode(s.Namespace, shardMetadata, d.node)
+		if err == nil {
+			// The swap returns once the new replica is caught up
+			err = c.SwapNode(s.Namespace, s.ShardID, d.node, target)
+		}
+		if err != nil {
+			c.failDrain(d, errors.Wrapf(err, "failed to move the replica of shard %d", s.ShardID))
+			return
+		}
+	}
+
+	d.setPhase(Drained, nil)
+	c.Info("Drained node, it can be safely shut down", slog.String("node", d.node))
+}
+
+func (c *coordinator) failDrain(d *nodeDrain, err error) {
+	c.Warn("Failed to drain node", slog.String("node", d.node), slog.Any("error", err))
+	d.setPhase(DrainFailed, err)
+}
+
+// nodeShards returns the shards, not being deleted, that have the node in
+// their ensemble, or as leader, sorted by namespace and shard id.
+func nodeShards(status *model.ClusterStatus, node string, leaderOnly bool) []utils.NamespaceAndShard {
+	var shards []utils.NamespaceAndShard
+	for namespace, ns := range status.Namespaces {
+		for shard, shardMetadata := range ns.Shards {
+			if shardMetadata.Status == model.ShardStatusDeleting {
+				continue
+			}
+			if leaderOnly && (shardMetadata.Leader == nil || shardMetadata.Leader.GetIdentifier() != node) {
+				continue
+			}
+			if !leaderOnly && findEnsembleNode(shardMetadata.Ensemble, node) == nil {
+				continue
+			}
+			shards = append(shards, utils.NamespaceAndShard{Namespace: namespace, ShardID: shard})
+		}
+	}
+	slices.SortFunc(shards, func(a, b utils.NamespaceAndShard) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.ShardID, b.ShardID))
+	})
+	return shards
+}
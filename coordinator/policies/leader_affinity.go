@@ -0,0 +1,26 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+type LeaderAffinity struct {
+
+	// Labels defines the label values a server must have to be preferred as leader, e.g. the zone closest to the clients.
+	Labels map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+// Matches returns whether a server with the given labels satisfies the rule.
+func (la *LeaderAffinity) Matches(labels map[string]string) bool {
+	return matchLabels(la.Labels, labels)
+}
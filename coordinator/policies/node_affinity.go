@@ -0,0 +1,49 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+type NodeAffinityMode string
+
+const (
+	// Required Only place the replicas on the servers that satisfy the rule. Ensemble choosing fails if not enough servers do.
+	Required NodeAffinityMode = "Required"
+
+	// Preferred Place the replicas on the servers that satisfy the rule when possible, and on other servers otherwise.
+	Preferred NodeAffinityMode = "Preferred"
+)
+
+// NodeAffinity defines a rule to place the replicas on the servers with the given labels, e.g. the servers with SSDs.
+type NodeAffinity struct {
+
+	// Labels defines the label values a server must have to satisfy the rule.
+	Labels map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+
+	// Mode specifies the enforcement level of the rule, such as Required or Preferred behavior.
+	Mode NodeAffinityMode `json:"mode,omitempty" yaml:"mode,omitempty"`
+}
+
+// Matches returns whether a server with the given labels satisfies the rule.
+func (na *NodeAffinity) Matches(labels map[string]string) bool {
+	return matchLabels(na.Labels, labels)
+}
+
+func matchLabels(expected map[string]string, labels map[string]string) bool {
+	for label, value := range expected {
+		if serverValue, exist := labels[label]; !exist || serverValue != value {
+			return false
+		}
+	}
+	return true
+}
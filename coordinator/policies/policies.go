@@ -17,4 +17,26 @@ package policies
 type Policies struct {
 	// AntiAffinities defines a list of anti-affinity rules for placement policies, ensuring resources don't coexist undesirably.
 	AntiAffinities []AntiAffinity `json:"antiAffinities,omitempty" yaml:"antiAffinities,omitempty"`
+
+	// LeaderAffinities defines a list of rules, in order of preference, to select the servers that lead the shards.
+	LeaderAffinities []LeaderAffinity `json:"leaderAffinities,omitempty" yaml:"leaderAffinities,omitempty"`
+
+	// NodeAffinities defines a list of rules to restrict the servers that host the replicas.
+	NodeAffinities []NodeAffinity `json:"nodeAffinities,omitempty" yaml:"nodeAffinities,omitempty"`
+
+	// Tolerations defines the server taints that the replicas can ignore.
+	Tolerations []Toleration `json:"tolerations,omitempty" yaml:"tolerations,omitempty"`
+}
+
+// Tolerates returns whether any of the tolerations matches the taint.
+func (p *Policies) Tolerates(taint Taint) bool {
+	if p == nil {
+		return false
+	}
+	for idx := range p.Tolerations {
+		if p.Tolerations[idx].Tolerates(taint) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,58 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+type TaintEffect string
+
+const (
+	// NoSchedule Never place replicas on the server, unless the namespace tolerates the taint.
+	NoSchedule TaintEffect = "NoSchedule"
+
+	// PreferNoSchedule Avoid placing replicas on the server when possible, unless the namespace tolerates the taint.
+	PreferNoSchedule TaintEffect = "PreferNoSchedule"
+)
+
+// Taint marks a server to keep away the replicas of the namespaces that don't tolerate it.
+type Taint struct {
+
+	// Key is the name of the taint.
+	Key string `json:"key" yaml:"key"`
+
+	// Value is the optional value of the taint.
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+
+	// Effect specifies how the taint affects the placement of the replicas.
+	Effect TaintEffect `json:"effect" yaml:"effect"`
+}
+
+// Toleration allows the replicas of a namespace to be placed on the servers with a matching taint.
+type Toleration struct {
+
+	// Key is the name of the tolerated taint.
+	Key string `json:"key" yaml:"key"`
+
+	// Value is the value of the tolerated taint. If empty, any value is tolerated.
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+
+	// Effect is the effect of the tolerated taint. If empty, any effect is tolerated.
+	Effect TaintEffect `json:"effect,omitempty" yaml:"effect,omitempty"`
+}
+
+// Tolerates returns whether the toleration matches the taint.
+func (t *Toleration) Tolerates(taint Taint) bool {
+	return t.Key == taint.Key &&
+		(t.Value == "" || t.Value == taint.Value) &&
+		(t.Effect == "" || t.Effect == taint.Effect)
+}
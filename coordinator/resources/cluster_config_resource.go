@@ -46,6 +46,10 @@ type ClusterConfigResource interface {
 
 	Load() *model.ClusterConfig
 
+	// Reload reads the cluster config again from its provider, without
+	// notifying the listener.
+	Reload() *model.ClusterConfig
+
 	LoadLoadBalancer() *model.LoadBalancer
 
 	Nodes() *linkedhashset.Set[string]
@@ -125,6 +129,14 @@ func (ccf *clusterConfig) Load() *model.ClusterConfig {
 	return ccf.currentClusterConfig
 }
 
+func (ccf *clusterConfig) Reload() *model.ClusterConfig {
+	ccf.clusterConfigLock.Lock()
+	ccf.currentClusterConfig = nil
+	ccf.clusterConfigLock.Unlock()
+
+	return ccf.Load()
+}
+
 func (ccf *clusterConfig) LoadLoadBalancer() *model.LoadBalancer {
 	conf := ccf.Load()
 	var balancer *model.LoadBalancer
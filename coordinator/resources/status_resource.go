@@ -204,7 +204,8 @@ func (s *status) IsReady(clusterConfig *model.ClusterConfig) bool {
 		if !ok {
 			return false
 		}
-		if len(namespaceStatus.Shards) != int(count) {
+		// Shards can be split, so there might be more than the initial count
+		if len(namespaceStatus.Shards) < int(count) {
 			return false
 		}
 		for _, shard := range namespaceStatus.Shards {
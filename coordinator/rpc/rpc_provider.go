@@ -36,6 +36,11 @@ type Provider interface {
 	AddFollower(ctx context.Context, node model.Server, req *proto.AddFollowerRequest) (*proto.AddFollowerResponse, error)
 	GetStatus(ctx context.Context, node model.Server, req *proto.GetStatusRequest) (*proto.GetStatusResponse, error)
 	DeleteShard(ctx context.Context, node model.Server, req *proto.DeleteShardRequest) (*proto.DeleteShardResponse, error)
+	SplitShard(ctx context.Context, node model.Server, req *proto.SplitShardRequest) (*proto.SplitShardResponse, error)
+	MergeShard(ctx context.Context, node model.Server, req *proto.MergeShardRequest) (*proto.MergeShardResponse, error)
+	TransferLeadership(ctx context.Context, node model.Server, req *proto.TransferLeadershipRequest) (*proto.TransferLeadershipResponse, error)
+	ListShardSessions(ctx context.Context, node model.Server, req *proto.ListShardSessionsRequest) (*proto.ListShardSessionsResponse, error)
+	RevokeShardSession(ctx context.Context, node model.Server, req *proto.RevokeShardSessionRequest) (*proto.RevokeShardSessionResponse, error)
 
 	GetHealthClient(node model.Server) (grpc_health_v1.HealthClient, io.Closer, error)
 
@@ -119,6 +124,64 @@ func (r *rpcProvider) DeleteShard(ctx context.Context, node model.Server, req *p
 	return client.DeleteShard(ctx, req)
 }
 
+func (r *rpcProvider) SplitShard(ctx context.Context, node model.Server, req *proto.SplitShardRequest) (*proto.SplitShardResponse, error) {
+	client, err := r.pool.GetCoordinationRpc(node.Internal)
+	if err != nil {
+		return nil, err
+	}
+
+	// The time taken by the split depends on the amount of data to copy,
+	// so we're not applying the default rpc timeout
+	return client.SplitShard(ctx, req)
+}
+
+func (r *rpcProvider) MergeShard(ctx context.Context, node model.Server, req *proto.MergeShardRequest) (*proto.MergeShardResponse, error) {
+	client, err := r.pool.GetCoordinationRpc(node.Internal)
+	if err != nil {
+		return nil, err
+	}
+
+	// The time taken by the merge depends on the amount of data to copy,
+	// so we're not applying the default rpc timeout
+	return client.MergeShard(ctx, req)
+}
+
+func (r *rpcProvider) TransferLeadership(ctx context.Context, node model.Server, req *proto.TransferLeadershipRequest) (*proto.TransferLeadershipResponse, error) {
+	client, err := r.pool.GetCoordinationRpc(node.Internal)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	return client.TransferLeadership(ctx, req)
+}
+
+func (r *rpcProvider) ListShardSessions(ctx context.Context, node model.Server, req *proto.ListShardSessionsRequest) (*proto.ListShardSessionsResponse, error) {
+	client, err := r.pool.GetCoordinationRpc(node.Internal)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	return client.ListShardSessions(ctx, req)
+}
+
+func (r *rpcProvider) RevokeShardSession(ctx context.Context, node model.Server, req *proto.RevokeShardSessionRequest) (*proto.RevokeShardSessionResponse, error) {
+	client, err := r.pool.GetCoordinationRpc(node.Internal)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	return client.RevokeShardSession(ctx, req)
+}
+
 func (r *rpcProvider) GetHealthClient(node model.Server) (grpc_health_v1.HealthClient, io.Closer, error) {
 	return r.pool.GetHealthRpc(node.Internal)
 }
@@ -58,3 +58,108 @@ func TestSelectMultipleAntiAffinitiesSatisfied(t *testing.T) {
 	assert.Contains(t, esm, "s3")
 	assert.Contains(t, esm, "s4")
 }
+
+func TestSelectNodeAffinitiesWithAntiAffinities(t *testing.T) {
+	ensembleSelector := NewSelector()
+	candidatesMetadata := map[string]model.ServerMetadata{
+		"s1": {Labels: map[string]string{"region": "us-east", "disk": "ssd"}},
+		"s2": {Labels: map[string]string{"region": "us-east", "disk": "ssd"}},
+		"s3": {Labels: map[string]string{"region": "us-north", "disk": "ssd"}},
+		"s4": {Labels: map[string]string{"region": "us-south", "disk": "hdd"}},
+		"s5": {
+			Labels: map[string]string{"region": "us-west", "disk": "ssd"},
+			Taints: []policies.Taint{{Key: "dedicated", Effect: policies.NoSchedule}},
+		},
+	}
+	nsPolicies := &policies.Policies{
+		AntiAffinities: []policies.AntiAffinity{
+			{Labels: []string{"region"}, Mode: policies.Strict},
+		},
+		NodeAffinities: []policies.NodeAffinity{
+			{Labels: map[string]string{"disk": "ssd"}, Mode: policies.Required},
+		},
+	}
+
+	context := &Context{
+		CandidatesMetadata: candidatesMetadata,
+		Candidates:         linkedhashset.New("s1", "s2", "s3", "s4", "s5"),
+		Status: &model.ClusterStatus{
+			ServerIdx: 0,
+		},
+		Policies: nsPolicies,
+		Replicas: 2,
+	}
+
+	esm, err := ensembleSelector.Select(context)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(esm))
+	assert.True(t, slices.Contains(esm, "s1") || slices.Contains(esm, "s2"))
+	assert.Contains(t, esm, "s3")
+
+	// The tainted server can only be used if the namespace tolerates it
+	context.Candidates = linkedhashset.New("s1", "s2", "s3", "s4", "s5")
+	context.Replicas = 3
+	_, err = ensembleSelector.Select(context)
+	assert.Error(t, err)
+
+	nsPolicies.Tolerations = []policies.Toleration{{Key: "dedicated"}}
+	esm, err = ensembleSelector.Select(context)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(esm))
+	assert.Contains(t, esm, "s3")
+	assert.Contains(t, esm, "s5")
+
+	// The preferred servers share the same region, so only one of them can be
+	// used and the other replicas fall back to the servers that are not preferred
+	context.Candidates = linkedhashset.New("s1", "s2", "s3", "s4")
+	nsPolicies.Tolerations = nil
+	nsPolicies.NodeAffinities = []policies.NodeAffinity{
+		{Labels: map[string]string{"region": "us-east"}, Mode: policies.Preferred},
+	}
+	esm, err = ensembleSelector.Select(context)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(esm))
+	assert.True(t, slices.Contains(esm, "s1") != slices.Contains(esm, "s2"))
+	assert.Contains(t, esm, "s3")
+	assert.Contains(t, esm, "s4")
+}
+
+func TestSelectPreferredNodeAffinitiesBelowReplicas(t *testing.T) {
+	ensembleSelector := NewSelector()
+	candidatesMetadata := map[string]model.ServerMetadata{
+		"s1": {Labels: map[string]string{"disk": "ssd"}},
+		"s2": {Labels: map[string]string{"disk": "ssd"}},
+		"s3": {Labels: map[string]string{"disk": "hdd"}},
+		"s4": {
+			Labels: map[string]string{"disk": "hdd"},
+			Taints: []policies.Taint{{Key: "maintenance", Effect: policies.PreferNoSchedule}},
+		},
+	}
+	nsPolicies := &policies.Policies{
+		NodeAffinities: []policies.NodeAffinity{
+			{Labels: map[string]string{"disk": "ssd"}, Mode: policies.Preferred},
+		},
+	}
+
+	context := &Context{
+		CandidatesMetadata: candidatesMetadata,
+		Candidates:         linkedhashset.New("s1", "s2", "s3", "s4"),
+		Status: &model.ClusterStatus{
+			ServerIdx: 0,
+		},
+		Policies: nsPolicies,
+		Replicas: 3,
+	}
+
+	// Only 2 servers are preferred, so the third replica is placed on the
+	// server that is not tainted
+	esm, err := ensembleSelector.Select(context)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"s1", "s2", "s3"}, esm)
+
+	context.Candidates = linkedhashset.New("s1", "s2", "s3", "s4")
+	context.Replicas = 4
+	esm, err = ensembleSelector.Select(context)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"s1", "s2", "s3", "s4"}, esm)
+}
@@ -16,9 +16,12 @@ package leader
 
 import (
 	"github.com/oxia-db/oxia/coordinator/model"
+	p "github.com/oxia-db/oxia/coordinator/policies"
 )
 
 type Context struct {
-	Candidates []model.Server
-	Status     *model.ClusterStatus
+	Candidates         []model.Server
+	CandidatesMetadata map[string]model.ServerMetadata
+	Policies           *p.Policies
+	Status             *model.ClusterStatus
 }
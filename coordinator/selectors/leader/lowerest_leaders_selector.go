@@ -16,6 +16,7 @@ package leader
 
 import (
 	"math/rand"
+	"slices"
 
 	"github.com/emirpasic/gods/v2/sets/linkedhashset"
 
@@ -30,8 +31,9 @@ type leader struct{}
 
 func (*leader) Select(context *Context) (model.Server, error) {
 	status := context.Status
+	contextCandidates := preferredCandidates(availableCandidates(context.Candidates, status), context)
 	candidates := linkedhashset.New[string]()
-	for _, candidate := range context.Candidates {
+	for _, candidate := range contextCandidates {
 		candidates.Add(candidate.GetIdentifier())
 	}
 	_, _, leaders := utils.NodeShardLeaders(candidates, status)
@@ -39,21 +41,55 @@ func (*leader) Select(context *Context) (model.Server, error) {
 	minLeaders := -1
 	var minLeadersNode model.Server
 
-	for idx, candidate := range context.Candidates {
+	for idx, candidate := range contextCandidates {
 		if shards, exist := leaders[candidate.GetIdentifier()]; exist {
 			leaderNum := shards.Size()
 			if minLeaders == -1 || leaderNum < minLeaders {
 				minLeaders = leaderNum
-				minLeadersNode = context.Candidates[idx]
+				minLeadersNode = contextCandidates[idx]
 			}
 		}
 	}
 	if minLeaders == -1 {
-		return context.Candidates[rand.Intn(len(context.Candidates))], nil //nolint:gosec
+		return contextCandidates[rand.Intn(len(contextCandidates))], nil //nolint:gosec
 	}
 	return minLeadersNode, nil
 }
 
+// availableCandidates filters out the nodes that are being drained, unless
+// there is no other candidate left.
+func availableCandidates(candidates []model.Server, status *model.ClusterStatus) []model.Server {
+	if status == nil || len(status.DrainingNodes) == 0 {
+		return candidates
+	}
+	available := make([]model.Server, 0, len(candidates))
+	for _, candidate := range candidates {
+		if !status.IsDraining(candidate.GetIdentifier()) {
+			available = append(available, candidate)
+		}
+	}
+	if len(available) == 0 {
+		return candidates
+	}
+	return available
+}
+
+// preferredCandidates keeps the candidates that satisfy the leader affinity
+// rules of the namespace, if any of them does.
+func preferredCandidates(candidates []model.Server, context *Context) []model.Server {
+	if context.Policies == nil || len(context.Policies.LeaderAffinities) == 0 {
+		return candidates
+	}
+	ids := linkedhashset.New[string]()
+	for _, candidate := range candidates {
+		ids.Add(candidate.GetIdentifier())
+	}
+	preferred := utils.PreferredLeaders(ids, context.Policies, context.CandidatesMetadata)
+	return slices.DeleteFunc(slices.Clone(candidates), func(candidate model.Server) bool {
+		return !preferred.Contains(candidate.GetIdentifier())
+	})
+}
+
 func NewSelector() selectors.Selector[*Context, model.Server] {
 	return &leader{}
 }
@@ -0,0 +1,77 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/coordinator/model"
+	p "github.com/oxia-db/oxia/coordinator/policies"
+)
+
+func TestSelectLeaderAffinity(t *testing.T) {
+	s1 := model.Server{Internal: "s1"}
+	s2 := model.Server{Internal: "s2"}
+	s3 := model.Server{Internal: "s3"}
+	status := &model.ClusterStatus{
+		Namespaces: map[string]model.NamespaceStatus{
+			"ns": {Shards: map[int64]model.ShardMetadata{
+				0: {Leader: &s1, Ensemble: []model.Server{s1, s2, s3}},
+				1: {Leader: &s2, Ensemble: []model.Server{s1, s2, s3}},
+			}},
+		},
+	}
+	metadata := map[string]model.ServerMetadata{
+		"s1": {Labels: map[string]string{"zone": "zone-a"}},
+		"s2": {Labels: map[string]string{"zone": "zone-b"}},
+		"s3": {Labels: map[string]string{"zone": "zone-b"}},
+	}
+	selector := NewSelector()
+
+	// Without rules, the node with the fewest leaders is selected
+	leader, err := selector.Select(&Context{
+		Candidates:         []model.Server{s1, s2, s3},
+		CandidatesMetadata: metadata,
+		Status:             status,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, s3, leader)
+
+	// The preferred node is selected even if it has more leaders
+	leader, err = selector.Select(&Context{
+		Candidates:         []model.Server{s1, s2, s3},
+		CandidatesMetadata: metadata,
+		Policies: &p.Policies{LeaderAffinities: []p.LeaderAffinity{
+			{Labels: map[string]string{"zone": "zone-a"}},
+		}},
+		Status: status,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, s1, leader)
+
+	// Rules that no candidate satisfies are ignored
+	leader, err = selector.Select(&Context{
+		Candidates:         []model.Server{s2, s3},
+		CandidatesMetadata: metadata,
+		Policies: &p.Policies{LeaderAffinities: []p.LeaderAffinity{
+			{Labels: map[string]string{"zone": "zone-a"}},
+		}},
+		Status: status,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, s3, leader)
+}
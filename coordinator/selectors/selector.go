@@ -24,6 +24,8 @@ var (
 	ErrUnsatisfiedEnsembleReplicas = errors.New("selector: unsatisfied ensemble replicas")
 	ErrUnsatisfiedAntiAffinity     = errors.New("selector: unsatisfied anti-affinity")
 	ErrUnsupportedAntiAffinityMode = errors.New("selector: unsupported anti-affinity mode")
+	ErrUnsatisfiedNodeAffinity     = errors.New("selector: unsatisfied node affinity")
+	ErrUntoleratedTaints           = errors.New("selector: untolerated taints")
 	ErrNoFunctioning               = errors.New("selector: no functioning selection")
 	ErrMultipleResult              = errors.New("selector: multiple results")
 )
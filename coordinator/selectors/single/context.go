@@ -58,6 +58,13 @@ func (so *Context) SetSelected(selected *linkedhashset.Set[string]) {
 	so.Candidates = so.Candidates.Difference(so.selected)
 }
 
+// setCandidates narrows the candidates, so that the label grouping only
+// considers the remaining ones.
+func (so *Context) setCandidates(candidates *linkedhashset.Set[string]) {
+	so.Candidates = candidates
+	so.candidateOnce = sync.Once{}
+}
+
 func (so *Context) maybeGrouping() {
 	so.candidateOnce.Do(func() {
 		so.labelValueGroupedCandidates = utils.GroupingCandidatesWithLabelValue(so.Candidates, so.CandidatesMetadata)
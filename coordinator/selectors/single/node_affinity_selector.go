@@ -0,0 +1,122 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package single
+
+import (
+	"github.com/emirpasic/gods/v2/sets/linkedhashset"
+
+	p "github.com/oxia-db/oxia/coordinator/policies"
+	"github.com/oxia-db/oxia/coordinator/selectors"
+	"github.com/oxia-db/oxia/coordinator/utils"
+)
+
+var (
+	_ selectors.Selector[*Context, string] = &serverNodeAffinitiesSelector{}
+	_ selectors.Selector[*Context, string] = &serverNodePreferencesSelector{}
+)
+
+// serverNodeAffinitiesSelector narrows the candidates to the servers allowed
+// by the required node affinities and the NoSchedule taints.
+type serverNodeAffinitiesSelector struct{}
+
+func (*serverNodeAffinitiesSelector) Select(ssContext *Context) (string, error) {
+	policies := ssContext.Policies
+	candidates := utils.FilterCandidates(ssContext.Candidates, func(candidate string) bool {
+		return tolerated(policies, ssContext.CandidatesMetadata[candidate].Taints, p.NoSchedule)
+	})
+	if candidates.Empty() && !ssContext.Candidates.Empty() {
+		return "", selectors.ErrUntoleratedTaints
+	}
+
+	for _, nodeAffinity := range nodeAffinities(policies, p.Required) {
+		candidates = utils.FilterCandidates(candidates, func(candidate string) bool {
+			return nodeAffinity.Matches(ssContext.CandidatesMetadata[candidate].Labels)
+		})
+		if candidates.Empty() {
+			return "", selectors.ErrUnsatisfiedNodeAffinity
+		}
+	}
+
+	if candidates.Size() == ssContext.Candidates.Size() {
+		return "", selectors.ErrNoFunctioning
+	}
+	// Even a single candidate is left to the next selectors, as it might
+	// still violate the anti-affinities
+	ssContext.setCandidates(candidates)
+	return "", selectors.ErrMultipleResult
+}
+
+// serverNodePreferencesSelector narrows the candidates to the servers
+// preferred by the node affinities and the PreferNoSchedule taints. It runs
+// after the anti-affinities, so that the preferences never make a selection
+// fail, and they are only applied while some candidates satisfy them. The
+// candidates are narrowed for the current selection only, as the preferred
+// servers might all be selected before the ensemble is complete.
+type serverNodePreferencesSelector struct{}
+
+func (*serverNodePreferencesSelector) Select(ssContext *Context) (string, error) {
+	policies := ssContext.Policies
+	candidates := ssContext.Candidates
+	for _, nodeAffinity := range nodeAffinities(policies, p.Preferred) {
+		candidates = preferCandidates(candidates, func(candidate string) bool {
+			return nodeAffinity.Matches(ssContext.CandidatesMetadata[candidate].Labels)
+		})
+	}
+	candidates = preferCandidates(candidates, func(candidate string) bool {
+		return tolerated(policies, ssContext.CandidatesMetadata[candidate].Taints, p.PreferNoSchedule)
+	})
+
+	if candidates.Size() == ssContext.Candidates.Size() {
+		return "", selectors.ErrNoFunctioning
+	}
+	ssContext.setCandidates(candidates)
+	return "", selectors.ErrMultipleResult
+}
+
+// nodeAffinities returns the node affinities of the policies with the given
+// mode.
+func nodeAffinities(policies *p.Policies, mode p.NodeAffinityMode) []p.NodeAffinity {
+	var result []p.NodeAffinity
+	if policies == nil {
+		return result
+	}
+	for _, nodeAffinity := range policies.NodeAffinities {
+		if nodeAffinity.Mode == mode {
+			result = append(result, nodeAffinity)
+		}
+	}
+	return result
+}
+
+// preferCandidates keeps the candidates that satisfy the condition, unless
+// none of them does.
+func preferCandidates(candidates *linkedhashset.Set[string], condition func(candidate string) bool) *linkedhashset.Set[string] {
+	preferred := utils.FilterCandidates(candidates, condition)
+	if preferred.Empty() {
+		return candidates
+	}
+	return preferred
+}
+
+// tolerated returns whether all the taints with the given effect are
+// tolerated by the policies.
+func tolerated(policies *p.Policies, taints []p.Taint, effect p.TaintEffect) bool {
+	for _, taint := range taints {
+		if taint.Effect == effect && !policies.Tolerates(taint) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,150 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package single
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/emirpasic/gods/v2/sets/linkedhashset"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/coordinator/model"
+	"github.com/oxia-db/oxia/coordinator/policies"
+	"github.com/oxia-db/oxia/coordinator/selectors"
+)
+
+var nodeAffinityMetadata = map[string]model.ServerMetadata{
+	"server1": {Labels: map[string]string{"disk": "ssd", "zone": "zone-a"}},
+	"server2": {Labels: map[string]string{"disk": "ssd", "zone": "zone-b"}},
+	"server3": {Labels: map[string]string{"disk": "hdd", "zone": "zone-a"}},
+	"server4": {
+		Labels: map[string]string{"disk": "hdd", "zone": "zone-b"},
+		Taints: []policies.Taint{{Key: "dedicated", Value: "critical", Effect: policies.NoSchedule}},
+	},
+	"server5": {
+		Labels: map[string]string{"disk": "hdd", "zone": "zone-c"},
+		Taints: []policies.Taint{{Key: "maintenance", Effect: policies.PreferNoSchedule}},
+	},
+}
+
+func selectWithNodeAffinities(nsPolicies *policies.Policies, selected ...string) (string, []string, error) {
+	context := &Context{
+		CandidatesMetadata: nodeAffinityMetadata,
+		Candidates:         linkedhashset.New("server1", "server2", "server3", "server4", "server5"),
+		Policies:           nsPolicies,
+	}
+	context.SetSelected(linkedhashset.New(selected...))
+	// Run the required rules and the preferences like the server selector,
+	// which only reports no narrowing when neither of them narrowed
+	err := selectors.ErrNoFunctioning
+	for _, selector := range []selectors.Selector[*Context, string]{
+		&serverNodeAffinitiesSelector{},
+		&serverNodePreferencesSelector{},
+	} {
+		result, selectErr := selector.Select(context)
+		switch {
+		case errors.Is(selectErr, selectors.ErrNoFunctioning):
+		case errors.Is(selectErr, selectors.ErrMultipleResult):
+			err = selectErr
+		default:
+			return result, context.Candidates.Values(), selectErr
+		}
+	}
+	return "", context.Candidates.Values(), err
+}
+
+func TestSelectNodeAffinitiesUntoleratedTaints(t *testing.T) {
+	_, candidates, err := selectWithNodeAffinities(nil)
+	assert.ErrorIs(t, err, selectors.ErrMultipleResult)
+	assert.ElementsMatch(t, []string{"server1", "server2", "server3"}, candidates)
+
+	// Servers with untolerated PreferNoSchedule taints are used as a last resort
+	_, candidates, err = selectWithNodeAffinities(nil, "server1", "server2", "server3")
+	assert.ErrorIs(t, err, selectors.ErrMultipleResult)
+	assert.Equal(t, []string{"server5"}, candidates)
+
+	_, _, err = selectWithNodeAffinities(nil, "server1", "server2", "server3", "server5")
+	assert.ErrorIs(t, err, selectors.ErrUntoleratedTaints)
+}
+
+func TestSelectNodeAffinitiesToleratedTaints(t *testing.T) {
+	for _, toleration := range []policies.Toleration{
+		{Key: "dedicated"},
+		{Key: "dedicated", Value: "critical"},
+		{Key: "dedicated", Value: "critical", Effect: policies.NoSchedule},
+	} {
+		_, candidates, err := selectWithNodeAffinities(&policies.Policies{
+			Tolerations: []policies.Toleration{toleration},
+		})
+		assert.ErrorIs(t, err, selectors.ErrMultipleResult)
+		assert.ElementsMatch(t, []string{"server1", "server2", "server3", "server4"}, candidates)
+	}
+
+	for _, toleration := range []policies.Toleration{
+		{Key: "other"},
+		{Key: "dedicated", Value: "other"},
+		{Key: "dedicated", Effect: policies.PreferNoSchedule},
+	} {
+		_, candidates, err := selectWithNodeAffinities(&policies.Policies{
+			Tolerations: []policies.Toleration{toleration},
+		})
+		assert.ErrorIs(t, err, selectors.ErrMultipleResult)
+		assert.ElementsMatch(t, []string{"server1", "server2", "server3"}, candidates)
+	}
+
+	_, _, err := selectWithNodeAffinities(&policies.Policies{
+		Tolerations: []policies.Toleration{{Key: "dedicated"}, {Key: "maintenance"}},
+	})
+	assert.ErrorIs(t, err, selectors.ErrNoFunctioning)
+}
+
+func TestSelectRequiredNodeAffinities(t *testing.T) {
+	ssdOnly := &policies.Policies{
+		NodeAffinities: []policies.NodeAffinity{
+			{Labels: map[string]string{"disk": "ssd"}, Mode: policies.Required},
+		},
+	}
+	_, candidates, err := selectWithNodeAffinities(ssdOnly)
+	assert.ErrorIs(t, err, selectors.ErrMultipleResult)
+	assert.ElementsMatch(t, []string{"server1", "server2"}, candidates)
+
+	_, candidates, err = selectWithNodeAffinities(ssdOnly, "server1")
+	assert.ErrorIs(t, err, selectors.ErrMultipleResult)
+	assert.Equal(t, []string{"server2"}, candidates)
+
+	_, _, err = selectWithNodeAffinities(ssdOnly, "server1", "server2")
+	assert.ErrorIs(t, err, selectors.ErrUnsatisfiedNodeAffinity)
+}
+
+func TestSelectPreferredNodeAffinities(t *testing.T) {
+	ssdPreferred := &policies.Policies{
+		NodeAffinities: []policies.NodeAffinity{
+			{Labels: map[string]string{"disk": "ssd"}, Mode: policies.Preferred},
+			{Labels: map[string]string{"zone": "zone-a"}, Mode: policies.Preferred},
+		},
+	}
+	_, candidates, err := selectWithNodeAffinities(ssdPreferred)
+	assert.ErrorIs(t, err, selectors.ErrMultipleResult)
+	assert.Equal(t, []string{"server1"}, candidates)
+
+	_, candidates, err = selectWithNodeAffinities(ssdPreferred, "server1")
+	assert.ErrorIs(t, err, selectors.ErrMultipleResult)
+	assert.Equal(t, []string{"server2"}, candidates)
+
+	// Fall back to the servers without SSDs
+	_, candidates, err = selectWithNodeAffinities(ssdPreferred, "server1", "server2")
+	assert.ErrorIs(t, err, selectors.ErrMultipleResult)
+	assert.Equal(t, []string{"server3"}, candidates)
+}
@@ -27,6 +27,13 @@ type server struct {
 }
 
 func (s *server) Select(selectorContext *Context) (string, error) {
+	// The selectors narrow the candidates down while choosing the server.
+	// Restore them once done, so that the next selection with the same
+	// context, e.g. for the next replica of an ensemble, starts again from
+	// all the remaining candidates
+	candidates := selectorContext.Candidates
+	defer selectorContext.setCandidates(candidates)
+
 	var serverId string
 	var err error
 	for _, selector := range s.selectors {
@@ -49,7 +56,9 @@ func (s *server) Select(selectorContext *Context) (string, error) {
 func NewSelector() selectors.Selector[*Context, string] {
 	return &server{
 		selectors: []selectors.Selector[*Context, string]{
+			&serverNodeAffinitiesSelector{},
 			&serverAntiAffinitiesSelector{},
+			&serverNodePreferencesSelector{},
 			&lowerestLoadSelector{},
 			&finalSelector{},
 		},
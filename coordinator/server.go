@@ -30,6 +30,7 @@ import (
 	"github.com/oxia-db/oxia/coordinator/metadata"
 	"github.com/oxia-db/oxia/coordinator/model"
 	coordinatorrpc "github.com/oxia-db/oxia/coordinator/rpc"
+	"github.com/oxia-db/oxia/proto"
 
 	"github.com/oxia-db/oxia/common/rpc"
 
@@ -47,6 +48,11 @@ type Config struct {
 	K8SMetadataConfigMapName  string
 	FileMetadataPath          string
 
+	// When the authentication is enabled, the admin service is served on a
+	// separate address, while the internal one stays open to health checks
+	AdminServiceAddr string
+	AuthOptions      auth.Options
+
 	RaftBootstrapNodes []string
 	RaftAddress        string
 	RaftDataDir        string
@@ -64,16 +70,22 @@ func NewConfig() Config {
 }
 
 type GrpcServer struct {
-	grpcServer   rpc.GrpcServer
-	healthServer *health.Server
-	coordinator  Coordinator
-	clientPool   rpc.ClientPool
-	metrics      *metric.PrometheusMetrics
+	grpcServer       rpc.GrpcServer
+	adminGrpcServer  rpc.GrpcServer
+	healthServer     *health.Server
+	coordinator      Coordinator
+	clientPool       rpc.ClientPool
+	metadataProvider metadata.Provider
+	metrics          *metric.PrometheusMetrics
 }
 
 func NewGrpcServer(config Config) (*GrpcServer, error) {
 	slog.Info("Starting Oxia coordinator", slog.Any("config", config))
 
+	if config.AuthOptions.IsEnabled() && config.AdminServiceAddr == "" {
+		return nil, errors.New("the admin service address must be set when the authentication is enabled")
+	}
+
 	var metadataProvider metadata.Provider
 	switch config.MetadataProviderName {
 	case metadata.ProviderNameMemory:
@@ -105,32 +117,66 @@ func NewGrpcServer(config Config) (*GrpcServer, error) {
 
 	healthServer := health.NewServer()
 
+	adminServer := newAdminServer(coordinatorInstance)
 	grpcServer, err := rpc.Default.StartGrpcServer("coordinator", config.InternalServiceAddr, func(registrar grpc.ServiceRegistrar) {
 		grpc_health_v1.RegisterHealthServer(registrar, healthServer)
+		if !config.AuthOptions.IsEnabled() {
+			proto.RegisterOxiaAdminServer(registrar, adminServer)
+		}
 	}, config.ServerTLS, &auth.Disabled)
 	if err != nil {
 		return nil, err
 	}
+
+	var adminGrpcServer rpc.GrpcServer
+	if config.AuthOptions.IsEnabled() {
+		adminGrpcServer, err = rpc.Default.StartGrpcServer("coordinator-admin", config.AdminServiceAddr, func(registrar grpc.ServiceRegistrar) {
+			proto.RegisterOxiaAdminServer(registrar, adminServer)
+		}, config.ServerTLS, &config.AuthOptions)
+		if err != nil {
+			return nil, multierr.Combine(err, grpcServer.Close())
+		}
+	}
 	metrics, err := metric.Start(config.MetricsServiceAddr)
 	if err != nil {
 		return nil, err
 	}
 
 	return &GrpcServer{
-		grpcServer:   grpcServer,
-		healthServer: healthServer,
-		clientPool:   clientPool,
-		coordinator:  coordinatorInstance,
-		metrics:      metrics,
+		grpcServer:       grpcServer,
+		adminGrpcServer:  adminGrpcServer,
+		healthServer:     healthServer,
+		clientPool:       clientPool,
+		coordinator:      coordinatorInstance,
+		metadataProvider: metadataProvider,
+		metrics:          metrics,
 	}, nil
 }
 
+func (s *GrpcServer) InternalPort() int {
+	return s.grpcServer.Port()
+}
+
+// AdminPort returns the port of the admin service.
+func (s *GrpcServer) AdminPort() int {
+	if s.adminGrpcServer != nil {
+		return s.adminGrpcServer.Port()
+	}
+	return s.grpcServer.Port()
+}
+
 func (s *GrpcServer) Close() error {
 	s.healthServer.Shutdown()
+	var err error
+	if s.adminGrpcServer != nil {
+		err = s.adminGrpcServer.Close()
+	}
 	return multierr.Combine(
+		err,
 		s.clientPool.Close(),
 		s.grpcServer.Close(),
 		s.coordinator.Close(),
+		s.metadataProvider.Close(),
 		s.metrics.Close(),
 	)
 }
@@ -0,0 +1,166 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/coordinator/model"
+	"github.com/oxia-db/oxia/proto"
+)
+
+var ErrLeaderNotAvailable = errors.New("shard leader not available")
+
+// Session is a client session, as tracked by the leader of its shard.
+type Session struct {
+	Shard          int64
+	SessionId      int64
+	ClientIdentity string
+	Timeout        time.Duration
+	// Leader is the identifier of the node that leads the shard
+	Leader string
+
+	// EphemeralKeys are the keys of the ephemeral records owned by the
+	// session. They are only set by DescribeSession
+	EphemeralKeys []string
+}
+
+func (c *coordinator) ListSessions(namespace string, shard *int64) ([]Session, map[int64]error, error) {
+	if shard != nil {
+		sessions, err := c.listShardSessions(namespace, *shard)
+		return sessions, nil, err
+	}
+
+	ns, exist := c.statusResource.Load().Namespaces[namespace]
+	if !exist {
+		return nil, nil, errors.Wrap(constant.ErrNamespaceNotFound, namespace)
+	}
+	var shards []int64
+	for id, shardMetadata := range ns.Shards {
+		// The shards being deleted have been replaced by a split or merge
+		if shardMetadata.Status != model.ShardStatusDeleting {
+			shards = append(shards, id)
+		}
+	}
+	slices.Sort(shards)
+
+	// A shard without an available leader doesn't prevent listing the
+	// sessions of the other shards
+	var sessions []Session
+	failedShards := map[int64]error{}
+	for _, s := range shards {
+		shardSessions, err := c.listShardSessions(namespace, s)
+		if err != nil {
+			failedShards[s] = err
+			continue
+		}
+		sessions = append(sessions, shardSessions...)
+	}
+	return sessions, failedShards, nil
+}
+
+func (c *coordinator) listShardSessions(namespace string, shard int64) ([]Session, error) {
+	leader, err := c.shardLeader(namespace, shard)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.rpc.ListShardSessions(c.ctx, leader, &proto.ListShardSessionsRequest{
+		Namespace: namespace,
+		Shard:     shard,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list the sessions of shard %d", shard)
+	}
+	sessions := make([]Session, 0, len(res.Sessions))
+	for _, shardSession := range res.Sessions {
+		sessions = append(sessions, toSession(shard, leader, shardSession))
+	}
+	return sessions, nil
+}
+
+func (c *coordinator) DescribeSession(namespace string, shard int64, sessionId int64) (*Session, error) {
+	leader, err := c.shardLeader(namespace, shard)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.rpc.ListShardSessions(c.ctx, leader, &proto.ListShardSessionsRequest{
+		Namespace:            namespace,
+		Shard:                shard,
+		SessionId:            &sessionId,
+		IncludeEphemeralKeys: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Sessions) != 1 {
+		return nil, constant.ErrSessionNotFound
+	}
+	session := toSession(shard, leader, res.Sessions[0])
+	return &session, nil
+}
+
+func (c *coordinator) RevokeSession(namespace string, shard int64, sessionId int64) error {
+	leader, err := c.shardLeader(namespace, shard)
+	if err != nil {
+		return err
+	}
+
+	c.Info(
+		"Revoking session",
+		slog.String("namespace", namespace),
+		slog.Int64("shard", shard),
+		slog.Int64("session-id", sessionId),
+	)
+	_, err = c.rpc.RevokeShardSession(c.ctx, leader, &proto.RevokeShardSessionRequest{
+		Namespace: namespace,
+		Shard:     shard,
+		SessionId: sessionId,
+	})
+	return err
+}
+
+// shardLeader returns the node that currently leads the shard, which is the
+// one tracking its sessions.
+func (c *coordinator) shardLeader(namespace string, shard int64) (model.Server, error) {
+	ns, exist := c.statusResource.Load().Namespaces[namespace]
+	if !exist {
+		return model.Server{}, errors.Wrap(constant.ErrNamespaceNotFound, namespace)
+	}
+	shardMetadata, exist := ns.Shards[shard]
+	if !exist {
+		return model.Server{}, errors.Wrapf(ErrShardNotFound, "shard %d in namespace %s", shard, namespace)
+	}
+	if shardMetadata.Leader == nil || shardMetadata.Status == model.ShardStatusElection ||
+		shardMetadata.Status == model.ShardStatusDeleting {
+		return model.Server{}, errors.Wrapf(ErrLeaderNotAvailable, "shard %d in namespace %s", shard, namespace)
+	}
+	return *shardMetadata.Leader, nil
+}
+
+func toSession(shard int64, leader model.Server, shardSession *proto.ShardSession) Session {
+	return Session{
+		Shard:          shard,
+		SessionId:      shardSession.SessionId,
+		ClientIdentity: shardSession.ClientIdentity,
+		Timeout:        time.Duration(shardSession.TimeoutMs) * time.Millisecond,
+		Leader:         leader.GetIdentifier(),
+		EphemeralKeys:  shardSession.EphemeralKeys,
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"log/slog"
+
+	"github.com/emirpasic/gods/v2/sets/linkedhashset"
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/coordinator/actions"
+	"github.com/oxia-db/oxia/coordinator/controllers"
+	"github.com/oxia-db/oxia/coordinator/model"
+	"github.com/oxia-db/oxia/coordinator/selectors/single"
+	"github.com/oxia-db/oxia/coordinator/utils"
+)
+
+var (
+	ErrShardNotFound = errors.New("shard not found")
+	ErrNodeNotFound  = errors.New("node not found")
+	ErrInvalidSwap   = errors.New("invalid node swap")
+	ErrInvalidMerge  = errors.New("invalid shards merge")
+
+	ErrInvalidLeaderTransfer = errors.New("invalid leadership transfer")
+)
+
+func (c *coordinator) ElectLeader(namespace string, shard int64) (string, error) {
+	c.Info("Electing a new leader", slog.String("namespace", namespace), slog.Int64("shard", shard))
+	return c.electLeader(namespace, shard, "")
+}
+
+func (c *coordinator) TransferLeadership(namespace string, shard int64, to string) (string, error) {
+	sc, err := c.getShardController(namespace, shard)
+	if err != nil {
+		return "", err
+	}
+
+	shardMetadata := sc.Metadata().Load()
+	switch {
+	case findEnsembleNode(shardMetadata.Ensemble, to) == nil:
+		return "", errors.Wrapf(ErrInvalidLeaderTransfer, "node %s is not in the ensemble of shard %d", to, shard)
+	case shardMetadata.Leader != nil && shardMetadata.Leader.GetIdentifier() == to:
+		return to, nil
+	}
+
+	c.Info(
+		"Transferring leadership",
+		slog.String("namespace", namespace),
+		slog.Int64("shard", shard),
+		slog.String("to", to),
+	)
+	leader, err := c.electLeader(namespace, shard, to)
+	if err != nil {
+		return "", err
+	}
+	if leader != to {
+		return leader, errors.Errorf("the leadership of shard %d was moved to %s instead of %s", shard, leader, to)
+	}
+	return leader, nil
+}
+
+// electLeader runs a leader election for the shard. If the preferred leader
+// is set, the leadership is transferred to it.
+func (c *coordinator) electLeader(namespace string, shard int64, preferredLeader string) (string, error) {
+	sc, err := c.getShardController(namespace, shard)
+	if err != nil {
+		return "", err
+	}
+
+	leader := sc.Election(&actions.ElectionAction{Shard: shard, PreferredLeader: preferredLeader})
+	if leader == "" {
+		return "", errors.Errorf("failed to elect a leader for shard %d", shard)
+	}
+	return leader, nil
+}
+
+func (c *coordinator) SwapNode(namespace string, shard int64, from string, to string) error {
+	sc, err := c.getShardController(namespace, shard)
+	if err != nil {
+		return err
+	}
+
+	toNode, exist := c.configResource.Node(to)
+	if !exist {
+		return errors.Wrap(ErrNodeNotFound, to)
+	}
+
+	// The node to remove might not be in the cluster config anymore
+	ensemble := sc.Metadata().Load().Ensemble
+	fromNode := findEnsembleNode(ensemble, from)
+	switch {
+	case fromNode == nil:
+		return errors.Wrapf(ErrInvalidSwap, "node %s is not in the ensemble of shard %d", from, shard)
+	case findEnsembleNode(ensemble, to) != nil:
+		return errors.Wrapf(ErrInvalidSwap, "node %s is already in the ensemble of shard %d", to, shard)
+	}
+
+	c.Info(
+		"Swapping node",
+		slog.String("namespace", namespace),
+		slog.Int64("shard", shard),
+		slog.String("from", from),
+		slog.String("to", to),
+	)
+	return sc.SwapNode(*fromNode, *toNode)
+}
+
+// selectReplacementNode selects the node that takes the place of the given
+// node in the ensemble of a shard, following the namespace policies.
+func (c *coordinator) selectReplacementNode(namespace string, shardMetadata model.ShardMetadata, node string) (string, error) {
+	nsConfig, exist := c.configResource.NamespaceConfig(namespace)
+	if !exist {
+		return "", errors.Wrap(constant.ErrNamespaceNotFound, namespace)
+	}
+
+	status := c.statusResource.Load()
+	candidates, metadata := c.configResource.NodesWithMetadata()
+	candidates.Remove(node)
+	utils.RemoveDrainingNodes(candidates, status)
+
+	selected := linkedhashset.New[string]()
+	for _, server := range shardMetadata.Ensemble {
+		if server.GetIdentifier() != node {
+			selected.Add(server.GetIdentifier())
+		}
+	}
+	if candidates.Difference(selected).Empty() {
+		return "", errors.New("no available node to replace the drained one")
+	}
+
+	selectorContext := &single.Context{
+		Candidates:         candidates,
+		CandidatesMetadata: metadata,
+		Policies:           nsConfig.Policies,
+		Status:             status,
+		LoadRatioSupplier: func() *model.Ratio {
+			groupedStatus, historyNodes := utils.GroupingShardsNodeByStatus(candidates, status)
+			return c.loadBalancer.LoadRatioAlgorithm()(&model.RatioParams{NodeShardsInfos: groupedStatus, HistoryNodes: historyNodes})
+		},
+	}
+	selectorContext.SetSelected(selected)
+	return single.NewSelector().Select(selectorContext)
+}
+
+func (c *coordinator) getShardController(namespace string, shard int64) (controllers.ShardController, error) {
+	ns, exist := c.statusResource.Load().Namespaces[namespace]
+	if !exist {
+		return nil, errors.Wrap(constant.ErrNamespaceNotFound, namespace)
+	}
+	if _, exist := ns.Shards[shard]; !exist {
+		return nil, errors.Wrapf(ErrShardNotFound, "shard %d in namespace %s", shard, namespace)
+	}
+
+	c.RLock()
+	defer c.RUnlock()
+	sc, exist := c.shardControllers[shard]
+	if !exist {
+		return nil, errors.Wrapf(ErrShardNotFound, "shard %d in namespace %s", shard, namespace)
+	}
+	return sc, nil
+}
+
+func findEnsembleNode(ensemble []model.Server, node string) *model.Server {
+	for idx := range ensemble {
+		if ensemble[idx].GetIdentifier() == node {
+			return &ensemble[idx]
+		}
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ package utils
 
 import (
 	"log/slog"
+	"slices"
 
 	"github.com/oxia-db/oxia/common/sharding"
 	"github.com/oxia-db/oxia/coordinator/model"
@@ -47,6 +48,17 @@ func ApplyClusterChanges(config *model.ClusterConfig, currentStatus *model.Clust
 	for k, v := range currentStatus.Namespaces {
 		newStatus.Namespaces[k] = v.Clone()
 	}
+	newStatus.DynamicNamespaces = slices.Clone(currentStatus.DynamicNamespaces)
+	newStatus.BalancerPaused = currentStatus.BalancerPaused
+
+	// A node is not draining anymore once it gets removed from the config
+	for _, node := range currentStatus.DrainingNodes {
+		if slices.ContainsFunc(config.Servers, func(server model.Server) bool {
+			return server.GetIdentifier() == node
+		}) {
+			newStatus.DrainingNodes = append(newStatus.DrainingNodes, node)
+		}
+	}
 
 	// Check for new namespaces
 	for _, nc := range config.Namespaces {
@@ -306,3 +306,22 @@ func TestClientUpdates_NamespaceRemoved(t *testing.T) {
 	assert.Equal(t, []int64{1, 2}, shardsToRemove)
 	assert.Equal(t, map[int64]string{}, shardsAdded)
 }
+
+func TestClientUpdates_DrainingNodeRemoved(t *testing.T) {
+	servers := []model.Server{s1, s2, s3}
+	newStatus, shardsAdded, shardsToRemove := ApplyClusterChanges(&model.ClusterConfig{
+		Servers: servers,
+	}, &model.ClusterStatus{
+		Namespaces:    map[string]model.NamespaceStatus{},
+		DrainingNodes: []string{s2.GetIdentifier(), s4.GetIdentifier()},
+	}, func(namespaceConfig *model.NamespaceConfig, status *model.ClusterStatus) ([]model.Server, error) {
+		return SimpleEnsembleSupplier(servers, namespaceConfig, status), nil
+	})
+
+	// The drained node that was removed from the config is not draining anymore
+	assert.Equal(t, []string{s2.GetIdentifier()}, newStatus.DrainingNodes)
+	assert.True(t, newStatus.IsDraining(s2.GetIdentifier()))
+	assert.False(t, newStatus.IsDraining(s4.GetIdentifier()))
+	assert.Empty(t, shardsAdded)
+	assert.Empty(t, shardsToRemove)
+}
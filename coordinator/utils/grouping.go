@@ -22,6 +22,7 @@ import (
 	"github.com/emirpasic/gods/v2/sets/linkedhashset"
 
 	"github.com/oxia-db/oxia/coordinator/model"
+	p "github.com/oxia-db/oxia/coordinator/policies"
 )
 
 type NamespaceAndShard struct {
@@ -65,6 +66,48 @@ func NodeShardLeaders(candidates *linkedhashset.Set[string], status *model.Clust
 	return totalShards, electedShards, result
 }
 
+// RemoveDrainingNodes removes from the candidates the nodes that are being
+// drained, which must not receive new leaders or replicas.
+func RemoveDrainingNodes(candidates *linkedhashset.Set[string], status *model.ClusterStatus) {
+	if status == nil {
+		return
+	}
+	for _, node := range status.DrainingNodes {
+		candidates.Remove(node)
+	}
+}
+
+// FilterCandidates returns the candidates that satisfy the condition, in
+// their original order.
+func FilterCandidates(candidates *linkedhashset.Set[string], condition func(candidate string) bool) *linkedhashset.Set[string] {
+	filtered := linkedhashset.New[string]()
+	for iter := candidates.Iterator(); iter.Next(); {
+		if condition(iter.Value()) {
+			filtered.Add(iter.Value())
+		}
+	}
+	return filtered
+}
+
+// PreferredLeaders returns the candidates that satisfy the first leader
+// affinity rule matched by any of them. If there are no rules, or none is
+// matched, all the candidates are returned.
+func PreferredLeaders(candidates *linkedhashset.Set[string], policies *p.Policies,
+	candidatesMetadata map[string]model.ServerMetadata) *linkedhashset.Set[string] {
+	if policies == nil {
+		return candidates
+	}
+	for idx := range policies.LeaderAffinities {
+		preferred := FilterCandidates(candidates, func(candidate string) bool {
+			return policies.LeaderAffinities[idx].Matches(candidatesMetadata[candidate].Labels)
+		})
+		if !preferred.Empty() {
+			return preferred
+		}
+	}
+	return candidates
+}
+
 func GroupingShardsNodeByStatus(candidates *linkedhashset.Set[string], status *model.ClusterStatus) (map[string][]model.ShardInfo, map[string]model.Server) {
 	groupingShardByNode := make(map[string][]model.ShardInfo)
 	historyNodes := make(map[string]model.Server)
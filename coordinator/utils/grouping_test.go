@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/oxia-db/oxia/coordinator/model"
+	p "github.com/oxia-db/oxia/coordinator/policies"
 )
 
 func TestGroupingCandidatesNormalCase(t *testing.T) {
@@ -97,3 +98,48 @@ func TestGroupingCandidatesAllSameLabelValue(t *testing.T) {
 	assert.True(t, regionGroup["us-east"].Contains("server1"))
 	assert.True(t, regionGroup["us-east"].Contains("server2"))
 }
+
+func TestRemoveDrainingNodes(t *testing.T) {
+	candidates := linkedhashset.New("server1", "server2", "server3")
+	RemoveDrainingNodes(candidates, &model.ClusterStatus{DrainingNodes: []string{"server2", "server4"}})
+	assert.Equal(t, []string{"server1", "server3"}, candidates.Values())
+
+	RemoveDrainingNodes(candidates, nil)
+	assert.Equal(t, []string{"server1", "server3"}, candidates.Values())
+}
+
+func TestPreferredLeaders(t *testing.T) {
+	candidatesMetadata := map[string]model.ServerMetadata{
+		"server1": {Labels: map[string]string{"zone": "zone-a", "rack": "r1"}},
+		"server2": {Labels: map[string]string{"zone": "zone-b", "rack": "r1"}},
+		"server3": {Labels: map[string]string{"zone": "zone-b", "rack": "r2"}},
+	}
+	candidates := linkedhashset.New("server1", "server2", "server3")
+
+	for _, test := range []struct {
+		name     string
+		policies *p.Policies
+		expected []string
+	}{
+		{"no policies", nil, []string{"server1", "server2", "server3"}},
+		{"no rules", &p.Policies{}, []string{"server1", "server2", "server3"}},
+		{"single label", &p.Policies{LeaderAffinities: []p.LeaderAffinity{
+			{Labels: map[string]string{"zone": "zone-b"}},
+		}}, []string{"server2", "server3"}},
+		{"multiple labels", &p.Policies{LeaderAffinities: []p.LeaderAffinity{
+			{Labels: map[string]string{"zone": "zone-b", "rack": "r2"}},
+		}}, []string{"server3"}},
+		{"first matched rule", &p.Policies{LeaderAffinities: []p.LeaderAffinity{
+			{Labels: map[string]string{"zone": "zone-c"}},
+			{Labels: map[string]string{"zone": "zone-a"}},
+			{Labels: map[string]string{"zone": "zone-b"}},
+		}}, []string{"server1"}},
+		{"no matched rule", &p.Policies{LeaderAffinities: []p.LeaderAffinity{
+			{Labels: map[string]string{"zone": "zone-c"}},
+		}}, []string{"server1", "server2", "server3"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, PreferredLeaders(candidates, test.policies, candidatesMetadata).Values())
+		})
+	}
+}
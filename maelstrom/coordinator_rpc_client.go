@@ -105,6 +105,26 @@ func (m *maelstromCoordinatorRpcProvider) DeleteShard(ctx context.Context, node
 	return res.(*proto.DeleteShardResponse), nil
 }
 
+func (*maelstromCoordinatorRpcProvider) SplitShard(context.Context, model.Server, *proto.SplitShardRequest) (*proto.SplitShardResponse, error) {
+	return nil, ErrNotImplement
+}
+
+func (*maelstromCoordinatorRpcProvider) MergeShard(context.Context, model.Server, *proto.MergeShardRequest) (*proto.MergeShardResponse, error) {
+	return nil, ErrNotImplement
+}
+
+func (*maelstromCoordinatorRpcProvider) TransferLeadership(context.Context, model.Server, *proto.TransferLeadershipRequest) (*proto.TransferLeadershipResponse, error) {
+	return nil, ErrNotImplement
+}
+
+func (*maelstromCoordinatorRpcProvider) ListShardSessions(context.Context, model.Server, *proto.ListShardSessionsRequest) (*proto.ListShardSessionsResponse, error) {
+	return nil, ErrNotImplement
+}
+
+func (*maelstromCoordinatorRpcProvider) RevokeShardSession(context.Context, model.Server, *proto.RevokeShardSessionRequest) (*proto.RevokeShardSessionResponse, error) {
+	return nil, ErrNotImplement
+}
+
 func (m *maelstromCoordinatorRpcProvider) GetHealthClient(node model.Server) (grpc_health_v1.HealthClient, io.Closer, error) {
 	c := &maelstromHealthCheckClient{
 		provider: m,
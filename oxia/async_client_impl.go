@@ -19,13 +19,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"sync"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
 	"github.com/oxia-db/oxia/common/concurrent"
 	"github.com/oxia-db/oxia/common/rpc"
+	time2 "github.com/oxia-db/oxia/common/time"
 	commonbatch "github.com/oxia-db/oxia/oxia/batch"
 
 	"github.com/oxia-db/oxia/common/compare"
@@ -44,11 +48,21 @@ type clientImpl struct {
 	readBatchManager  *batch.Manager
 	executor          internal.Executor
 	sessions          *sessions
-	notifications     []*notifications
+	notifications     []Notifications
 
-	clientPool rpc.ClientPool
-	ctx        context.Context
-	cancel     context.CancelFunc
+	// The reads that tolerate stale data are batched separately, so that
+	// they are not sent to the leader along with the regular reads
+	followerReadBatchManager *batch.Manager
+
+	// The resources that are shared with the clients of the other namespaces
+	clientPool       rpc.ClientPool
+	shardAssignments internal.ShardAssignments
+	metrics          *metrics.Metrics
+	// Whether the shared resources are closed along with this client
+	ownsShared bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // NewAsyncClient creates a new Oxia client with the async interface
@@ -67,9 +81,20 @@ func NewAsyncClient(serviceAddress string, opts ...ClientOption) (AsyncClient, e
 	}
 
 	clientPool := rpc.NewClientPool(options.tls, options.authentication)
+	shardAssignments := internal.NewShardAssignments(internal.NewShardStrategy(), clientPool, serviceAddress,
+		options.requestTimeout)
 
-	shardManager, err := internal.NewShardManager(internal.NewShardStrategy(), clientPool, serviceAddress,
-		options.namespace, options.requestTimeout)
+	c, err := newClientImpl(options, clientPool, shardAssignments, metrics.NewMetrics(options.meterProvider))
+	if err != nil {
+		return nil, multierr.Combine(err, shardAssignments.Close(), clientPool.Close())
+	}
+	c.ownsShared = true
+	return c, nil
+}
+
+func newClientImpl(options clientOptions, clientPool rpc.ClientPool, shardAssignments internal.ShardAssignments,
+	clientMetrics *metrics.Metrics) (*clientImpl, error) {
+	shardManager, err := shardAssignments.ShardManager(options.namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -81,17 +106,20 @@ func NewAsyncClient(serviceAddress string, opts ...ClientOption) (AsyncClient, e
 		options.namespace,
 		options.batchLinger,
 		options.maxRequestsPerBatch,
-		metrics.NewMetrics(options.meterProvider),
+		clientMetrics,
 		options.requestTimeout)
 	c := &clientImpl{
-		options:      options,
-		clientPool:   clientPool,
-		shardManager: shardManager,
+		options:          options,
+		clientPool:       clientPool,
+		shardAssignments: shardAssignments,
+		metrics:          clientMetrics,
+		shardManager:     shardManager,
 		writeBatchManager: batch.NewManager(ctx, func(ctx context.Context, shard *int64) commonbatch.Batcher {
 			return batcherFactory.NewWriteBatcher(ctx, shard, options.maxBatchSize)
 		}),
-		readBatchManager: batch.NewManager(ctx, batcherFactory.NewReadBatcher),
-		executor:         executor,
+		readBatchManager:         batch.NewManager(ctx, batcherFactory.NewReadBatcher),
+		followerReadBatchManager: batch.NewManager(ctx, batcherFactory.NewReadBatcher),
+		executor:                 executor,
 	}
 
 	c.ctx, c.cancel = ctx, cancel
@@ -99,13 +127,31 @@ func NewAsyncClient(serviceAddress string, opts ...ClientOption) (AsyncClient, e
 	return c, nil
 }
 
+func (c *clientImpl) Namespace(namespace string) (AsyncClient, error) {
+	if namespace == "" {
+		return nil, ErrInvalidOptionNamespace
+	}
+
+	options := c.options
+	options.namespace = namespace
+	return newClientImpl(options, c.clientPool, c.shardAssignments, c.metrics)
+}
+
 func (c *clientImpl) Close() error {
 	err := multierr.Combine(
 		c.sessions.Close(),
 		c.writeBatchManager.Close(),
 		c.readBatchManager.Close(),
-		c.clientPool.Close(),
+		c.followerReadBatchManager.Close(),
 	)
+	if c.ownsShared {
+		err = multierr.Combine(err,
+			c.shardAssignments.Close(),
+			c.clientPool.Close(),
+		)
+	} else {
+		c.shardAssignments.Release(c.options.namespace)
+	}
 	c.cancel()
 
 	err = multierr.Append(err, c.closeNotifications())
@@ -130,21 +176,36 @@ func (c *clientImpl) Put(key string, value []byte, options ...PutOption) <-chan
 		return ch
 	}
 
-	shardId := c.getShardForKey(key, opts)
-	putCall := model.PutCall{
-		Key:                key,
-		Value:              value,
-		ExpectedVersionId:  opts.expectedVersion,
-		SequenceKeysDeltas: opts.sequenceKeysDeltas,
-		PartitionKey:       opts.partitionKey,
-		Callback:           callback,
-		SecondaryIndexes:   toSecondaryIndexes(opts.secondaryIndexes),
+	c.doPut(model.PutCall{
+		Key:                  key,
+		Value:                value,
+		ExpectedVersionId:    opts.expectedVersion,
+		ExpectedRecordExists: toExpectedRecordExists(opts),
+		ExpectedValueHash:    opts.expectedValueHash,
+		SequenceKeysDeltas:   opts.sequenceKeysDeltas,
+		PartitionKey:         opts.partitionKey,
+		SecondaryIndexes:     toSecondaryIndexes(opts.secondaryIndexes),
+		TtlMs:                toTtlMs(opts),
+	}, opts, callback)
+	return ch
+}
+
+func (c *clientImpl) doPut(putCall model.PutCall, opts *putOptions, callback func(*proto.PutResponse, error)) {
+	shardId := c.getShardForKey(putCall.Key, opts)
+	putCall.Callback = func(response *proto.PutResponse, err error) {
+		if errors.Is(err, internal.ErrShardNotFound) {
+			// The shard was split, retry on the new shard
+			c.doPut(putCall, opts, callback)
+			return
+		}
+		callback(response, err)
 	}
+
 	if opts.ephemeral {
 		putCall.ClientIdentity = &c.options.identity
 		c.sessions.executeWithSessionId(shardId, func(sessionId int64, err error) {
 			if err != nil {
-				callback(nil, err)
+				putCall.Callback(nil, err)
 				return
 			}
 			putCall.SessionId = &sessionId
@@ -153,7 +214,6 @@ func (c *clientImpl) Put(key string, value []byte, options ...PutOption) <-chan
 	} else {
 		c.writeBatchManager.Get(shardId).Add(putCall)
 	}
-	return ch
 }
 
 func (c *clientImpl) Delete(key string, options ...DeleteOption) <-chan error {
@@ -167,21 +227,32 @@ func (c *clientImpl) Delete(key string, options ...DeleteOption) <-chan error {
 		close(ch)
 	}
 	opts := newDeleteOptions(options)
-	shardId := c.getShardForKey(key, opts)
-	c.writeBatchManager.Get(shardId).Add(model.DeleteCall{
+	c.doDelete(model.DeleteCall{
 		Key:               key,
 		ExpectedVersionId: opts.expectedVersion,
-		Callback:          callback,
-	})
+		ExpectedValueHash: opts.expectedValueHash,
+	}, opts, callback)
 	return ch
 }
 
+func (c *clientImpl) doDelete(deleteCall model.DeleteCall, opts *deleteOptions, callback func(*proto.DeleteResponse, error)) {
+	shardId := c.getShardForKey(deleteCall.Key, opts)
+	deleteCall.Callback = func(response *proto.DeleteResponse, err error) {
+		if errors.Is(err, internal.ErrShardNotFound) {
+			// The shard was split, retry on the new shard
+			c.doDelete(deleteCall, opts, callback)
+			return
+		}
+		callback(response, err)
+	}
+	c.writeBatchManager.Get(shardId).Add(deleteCall)
+}
+
 func (c *clientImpl) DeleteRange(minKeyInclusive string, maxKeyExclusive string, options ...DeleteRangeOption) <-chan error {
 	ch := make(chan error, 1)
 	opts := newDeleteRangeOptions(options)
 	if opts.partitionKey != nil {
-		shardId := c.getShardForKey("", opts)
-		c.doSingleShardDeleteRange(shardId, minKeyInclusive, maxKeyExclusive, ch)
+		c.doSingleShardDeleteRange(minKeyInclusive, maxKeyExclusive, opts, ch)
 		return ch
 	}
 
@@ -216,11 +287,18 @@ func (c *clientImpl) DeleteRange(minKeyInclusive string, maxKeyExclusive string,
 	return ch
 }
 
-func (c *clientImpl) doSingleShardDeleteRange(shardId int64, minKeyInclusive string, maxKeyExclusive string, ch chan error) {
+func (c *clientImpl) doSingleShardDeleteRange(minKeyInclusive string, maxKeyExclusive string, opts *deleteRangeOptions, ch chan error) {
+	shardId := c.getShardForKey("", opts)
 	c.writeBatchManager.Get(shardId).Add(model.DeleteRangeCall{
 		MinKeyInclusive: minKeyInclusive,
 		MaxKeyExclusive: maxKeyExclusive,
 		Callback: func(response *proto.DeleteRangeResponse, err error) {
+			if errors.Is(err, internal.ErrShardNotFound) {
+				// The shard was split, retry on the new shard
+				c.doSingleShardDeleteRange(minKeyInclusive, maxKeyExclusive, opts, ch)
+				return
+			}
+
 			if err != nil {
 				ch <- err
 			} else {
@@ -232,6 +310,113 @@ func (c *clientImpl) doSingleShardDeleteRange(shardId int64, minKeyInclusive str
 	})
 }
 
+func (c *clientImpl) Increment(key string, delta int64, options ...IncrementOption) <-chan IncrementResult {
+	ch := make(chan IncrementResult, 1)
+	callback := func(response *proto.IncrementResponse, err error) {
+		if err != nil {
+			ch <- IncrementResult{Err: err}
+		} else {
+			ch <- toIncrementResult(response)
+		}
+		close(ch)
+	}
+
+	opts, err := newIncrementOptions(options)
+	if err != nil {
+		callback(nil, err)
+		return ch
+	}
+
+	c.doIncrement(model.IncrementCall{
+		Key:          key,
+		Delta:        delta,
+		MinValue:     opts.minValue,
+		MaxValue:     opts.maxValue,
+		PartitionKey: opts.partitionKey,
+	}, opts, callback)
+	return ch
+}
+
+func (c *clientImpl) doIncrement(incrementCall model.IncrementCall, opts *incrementOptions, callback func(*proto.IncrementResponse, error)) {
+	shardId := c.getShardForKey(incrementCall.Key, opts)
+	incrementCall.Callback = func(response *proto.IncrementResponse, err error) {
+		if errors.Is(err, internal.ErrShardNotFound) {
+			// The shard was split, retry on the new shard
+			c.doIncrement(incrementCall, opts, callback)
+			return
+		}
+		callback(response, err)
+	}
+	c.writeBatchManager.Get(shardId).Add(incrementCall)
+}
+
+func (c *clientImpl) Append(key string, value []byte, options ...AppendOption) <-chan PutResult {
+	ch := make(chan PutResult, 1)
+	callback := func(response *proto.AppendResponse, err error) {
+		if err != nil {
+			ch <- PutResult{Err: err}
+		} else {
+			ch <- toAppendResult(key, response)
+		}
+		close(ch)
+	}
+
+	opts := newAppendOptions(options)
+	c.doAppend(model.AppendCall{
+		Key:          key,
+		Value:        value,
+		PartitionKey: opts.partitionKey,
+	}, opts, callback)
+	return ch
+}
+
+func (c *clientImpl) doAppend(appendCall model.AppendCall, opts *appendOptions, callback func(*proto.AppendResponse, error)) {
+	shardId := c.getShardForKey(appendCall.Key, opts)
+	appendCall.Callback = func(response *proto.AppendResponse, err error) {
+		if errors.Is(err, internal.ErrShardNotFound) {
+			// The shard was split, retry on the new shard
+			c.doAppend(appendCall, opts, callback)
+			return
+		}
+		callback(response, err)
+	}
+	c.writeBatchManager.Get(shardId).Add(appendCall)
+}
+
+func (c *clientImpl) MergePatch(key string, patch []byte, options ...MergePatchOption) <-chan PutResult {
+	ch := make(chan PutResult, 1)
+	callback := func(response *proto.MergePatchResponse, err error) {
+		if err != nil {
+			ch <- PutResult{Err: err}
+		} else {
+			ch <- toMergePatchResult(key, response)
+		}
+		close(ch)
+	}
+
+	opts := newMergePatchOptions(options)
+	c.doMergePatch(model.MergePatchCall{
+		Key:          key,
+		Patch:        patch,
+		PartitionKey: opts.partitionKey,
+	}, opts, callback)
+	return ch
+}
+
+func (c *clientImpl) doMergePatch(mergePatchCall model.MergePatchCall, opts *mergePatchOptions,
+	callback func(*proto.MergePatchResponse, error)) {
+	shardId := c.getShardForKey(mergePatchCall.Key, opts)
+	mergePatchCall.Callback = func(response *proto.MergePatchResponse, err error) {
+		if errors.Is(err, internal.ErrShardNotFound) {
+			// The shard was split, retry on the new shard
+			c.doMergePatch(mergePatchCall, opts, callback)
+			return
+		}
+		callback(response, err)
+	}
+	c.writeBatchManager.Get(shardId).Add(mergePatchCall)
+}
+
 func (c *clientImpl) Get(key string, options ...GetOption) <-chan GetResult {
 	ch := make(chan GetResult)
 
@@ -249,18 +434,33 @@ func (c *clientImpl) Get(key string, options ...GetOption) <-chan GetResult {
 
 func (c *clientImpl) doSingleShardGet(key string, opts *getOptions, ch chan GetResult) {
 	shardId := c.getShardForKey(key, opts)
-	c.readBatchManager.Get(shardId).Add(model.GetCall{
+	c.getReadBatchManager(opts).Get(shardId).Add(model.GetCall{
 		Key:                key,
 		ComparisonType:     opts.comparisonType,
 		IncludeValue:       opts.includeValue,
 		SecondaryIndexName: opts.secondaryIndexName,
+		MaxStalenessMs:     opts.maxStalenessMs(),
+		Linearizable:       opts.linearizable,
 		Callback: func(response *proto.GetResponse, err error) {
+			if errors.Is(err, internal.ErrShardNotFound) {
+				// The shard was split, retry on the new shard
+				c.doSingleShardGet(key, opts, ch)
+				return
+			}
+
 			ch <- toGetResult(response, key, err)
 			close(ch)
 		},
 	})
 }
 
+func (c *clientImpl) getReadBatchManager(opts *getOptions) *batch.Manager {
+	if opts.maxStalenessMs() != nil {
+		return c.followerReadBatchManager
+	}
+	return c.readBatchManager
+}
+
 func compareGetResponse(a, b *proto.GetResponse) int {
 	if a.SecondaryIndexKey != nil && b.SecondaryIndexKey != nil {
 		c := compare.CompareWithSlash([]byte(a.GetSecondaryIndexKey()), []byte(b.GetSecondaryIndexKey()))
@@ -326,11 +526,13 @@ func (c *clientImpl) doMultiShardGet(key string, options *getOptions, ch chan Ge
 	selected := keyNotFound
 
 	for _, shardId := range shards {
-		c.readBatchManager.Get(shardId).Add(model.GetCall{
+		c.getReadBatchManager(options).Get(shardId).Add(model.GetCall{
 			Key:                key,
 			ComparisonType:     options.comparisonType,
 			IncludeValue:       options.includeValue,
 			SecondaryIndexName: options.secondaryIndexName,
+			MaxStalenessMs:     options.maxStalenessMs(),
+			Linearizable:       options.linearizable,
 			Callback: func(response *proto.GetResponse, err error) {
 				m.Lock()
 				defer m.Unlock()
@@ -358,33 +560,49 @@ func (c *clientImpl) doMultiShardGet(key string, options *getOptions, ch chan Ge
 	}
 }
 
-func (c *clientImpl) listFromShard(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, shardId int64, secondaryIndexName *string,
+func (c *clientImpl) listFromShard(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, shardId int64, opts *listOptions,
 	ch chan<- ListResult) {
-	request := &proto.ListRequest{
+	err := c.doListFromShard(ctx, &proto.ListRequest{
 		Shard:              &shardId,
 		StartInclusive:     minKeyInclusive,
 		EndExclusive:       maxKeyExclusive,
-		SecondaryIndexName: secondaryIndexName,
+		SecondaryIndexName: opts.secondaryIndexName,
+		MaxStalenessMs:     opts.maxStalenessMs(),
+		Linearizable:       opts.linearizable,
+		Reverse:            opts.reverse,
+	}, func(response *proto.ListResponse) {
+		ch <- ListResult{Keys: response.Keys}
+	})
+	if err != nil {
+		ch <- ListResult{Err: err}
 	}
+}
 
+func (c *clientImpl) doListFromShard(ctx context.Context, request *proto.ListRequest, onResponse func(*proto.ListResponse)) error {
 	client, err := c.executor.ExecuteList(ctx, request)
 	if err != nil {
-		ch <- ListResult{Err: err}
-		return
+		return err
 	}
 
+	received := false
 	for {
 		response, err := client.Recv()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
-				return
+				return nil
 			}
 
-			ch <- ListResult{Err: err}
-			return
+			if !received && request.MaxStalenessMs != nil && batch.IsFollowerReadRejected(err) {
+				// The follower cannot serve the request, fall back to the leader
+				request.MaxStalenessMs = nil
+				return c.doListFromShard(ctx, request, onResponse)
+			}
+
+			return err
 		}
 
-		ch <- ListResult{Keys: response.Keys}
+		received = true
+		onResponse(response)
 	}
 }
 
@@ -392,11 +610,13 @@ func (c *clientImpl) List(ctx context.Context, minKeyInclusive string, maxKeyExc
 	ch := make(chan ListResult)
 
 	opts := newListOptions(options)
-	if opts.partitionKey != nil {
+	if opts.limit != nil {
+		go c.listPage(ctx, minKeyInclusive, maxKeyExclusive, opts, ch)
+	} else if opts.partitionKey != nil {
 		// If the partition key is specified, we only need to make the request to one shard
 		shardId := c.getShardForKey("", opts)
 		go func() {
-			c.listFromShard(ctx, minKeyInclusive, maxKeyExclusive, shardId, opts.secondaryIndexName, ch)
+			c.listFromShard(ctx, minKeyInclusive, maxKeyExclusive, shardId, opts, ch)
 			close(ch)
 		}()
 	} else {
@@ -409,7 +629,7 @@ func (c *clientImpl) List(ctx context.Context, minKeyInclusive string, maxKeyExc
 			go func() {
 				defer wg.Done()
 
-				c.listFromShard(ctx, minKeyInclusive, maxKeyExclusive, shardIdPtr, opts.secondaryIndexName, ch)
+				c.listFromShard(ctx, minKeyInclusive, maxKeyExclusive, shardIdPtr, opts, ch)
 			}()
 		}
 
@@ -422,36 +642,54 @@ func (c *clientImpl) List(ctx context.Context, minKeyInclusive string, maxKeyExc
 	return ch
 }
 
-func (c *clientImpl) rangeScanFromShard(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, shardId int64, secondaryIndexName *string,
+func (c *clientImpl) rangeScanFromShard(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, shardId int64, opts *rangeScanOptions,
 	ch chan<- GetResult) {
-	request := &proto.RangeScanRequest{
+	defer close(ch)
+
+	err := c.doRangeScanFromShard(ctx, &proto.RangeScanRequest{
 		Shard:              &shardId,
 		StartInclusive:     minKeyInclusive,
 		EndExclusive:       maxKeyExclusive,
-		SecondaryIndexName: secondaryIndexName,
+		SecondaryIndexName: opts.secondaryIndexName,
+		MaxStalenessMs:     opts.maxStalenessMs(),
+		Linearizable:       opts.linearizable,
+		Reverse:            opts.reverse,
+		Filter:             opts.filter,
+		MetadataOnly:       opts.metadataOnly,
+	}, func(record *proto.GetResponse) {
+		ch <- toGetResult(record, "", nil)
+	})
+	if err != nil {
+		ch <- GetResult{Err: err}
 	}
+}
 
+func (c *clientImpl) doRangeScanFromShard(ctx context.Context, request *proto.RangeScanRequest, onRecord func(*proto.GetResponse)) error {
 	client, err := c.executor.ExecuteRangeScan(ctx, request)
 	if err != nil {
-		ch <- GetResult{Err: err}
-		return
+		return err
 	}
 
-	defer close(ch)
-
+	received := false
 	for {
 		response, err := client.Recv()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
-				return
+				return nil
 			}
 
-			ch <- GetResult{Err: err}
-			return
+			if !received && request.MaxStalenessMs != nil && batch.IsFollowerReadRejected(err) {
+				// The follower cannot serve the request, fall back to the leader
+				request.MaxStalenessMs = nil
+				return c.doRangeScanFromShard(ctx, request, onRecord)
+			}
+
+			return err
 		}
 
+		received = true
 		for _, record := range response.Records {
-			ch <- toGetResult(record, "", nil)
+			onRecord(record)
 		}
 	}
 }
@@ -459,12 +697,20 @@ func (c *clientImpl) rangeScanFromShard(ctx context.Context, minKeyInclusive str
 func (c *clientImpl) RangeScan(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, options ...RangeScanOption) <-chan GetResult {
 	outCh := make(chan GetResult, 100)
 
-	opts := newRangeScanOptions(options)
-	if opts.partitionKey != nil {
+	opts, err := newRangeScanOptions(options)
+	if err != nil {
+		outCh <- GetResult{Err: err}
+		close(outCh)
+		return outCh
+	}
+
+	if opts.limit != nil {
+		go c.rangeScanPage(ctx, minKeyInclusive, maxKeyExclusive, opts, outCh)
+	} else if opts.partitionKey != nil {
 		// If the partition key is specified, we only need to make the request to one shard
 		shardId := c.getShardForKey("", opts)
 		go func() {
-			c.rangeScanFromShard(ctx, minKeyInclusive, maxKeyExclusive, shardId, opts.secondaryIndexName, outCh)
+			c.rangeScanFromShard(ctx, minKeyInclusive, maxKeyExclusive, shardId, opts, outCh)
 		}()
 	} else {
 		// Do the list on all shards and aggregate the responses
@@ -476,11 +722,11 @@ func (c *clientImpl) RangeScan(ctx context.Context, minKeyInclusive string, maxK
 			ch := make(chan GetResult)
 			channels[i] = ch
 			go func() {
-				c.rangeScanFromShard(ctx, minKeyInclusive, maxKeyExclusive, shardIdPtr, opts.secondaryIndexName, ch)
+				c.rangeScanFromShard(ctx, minKeyInclusive, maxKeyExclusive, shardIdPtr, opts, ch)
 			}()
 		}
 
-		go aggregateAndSortRangeScanAcrossShards(channels, outCh)
+		go aggregateAndSortRangeScanAcrossShards(channels, outCh, opts.reverse)
 	}
 
 	return outCh
@@ -496,9 +742,12 @@ func (c *clientImpl) GetSequenceUpdates(ctx context.Context, prefixKey string, o
 }
 
 // We do range scan on all the shards, and we need to always pick the lowest key
-// across all the shards.
-func aggregateAndSortRangeScanAcrossShards(channels []chan GetResult, outCh chan GetResult) {
-	h := &ResultHeap{}
+// across all the shards, or the highest one for reverse scans.
+func aggregateAndSortRangeScanAcrossShards(channels []chan GetResult, outCh chan GetResult, reverse bool) {
+	var h heap.Interface = &ResultHeap{}
+	if reverse {
+		h = reverseResultHeap{&ResultHeap{}}
+	}
 	heap.Init(h)
 
 	// First make sure we have 1 key from each channel
@@ -553,8 +802,14 @@ func (c *clientImpl) getShardForKey(key string, options baseOptionsIf) int64 {
 	return c.shardManager.Get(key)
 }
 
-func (c *clientImpl) GetNotifications() (Notifications, error) {
-	nm, err := newNotifications(c.ctx, c.options, c.clientPool, c.shardManager)
+func (c *clientImpl) GetNotifications(options ...NotificationsOption) (Notifications, error) {
+	var nm Notifications
+	var err error
+	if opts := newNotificationsOptions(options); opts.consumerGroup != nil {
+		nm, err = newConsumerGroup(c.ctx, c, c.options, opts, c.clientPool, c.shardManager)
+	} else {
+		nm, err = newNotifications(c.ctx, c.options, opts, c.clientPool, c.shardManager)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to create notification stream")
 	}
@@ -565,3 +820,28 @@ func (c *clientImpl) GetNotifications() (Notifications, error) {
 
 	return nm, nil
 }
+
+// executeWithRetries performs a request to the leader of a shard, retrying it
+// in case of transient failures, eg: during a leader election.
+func executeWithRetries[T any](ctx context.Context, c *clientImpl, shardId int64,
+	request func(ctx context.Context) (T, error)) (response T, err error) {
+	ctx, cancel := context.WithTimeout(ctx, c.options.requestTimeout)
+	defer cancel()
+
+	err = backoff.RetryNotify(func() error {
+		response, err = request(ctx)
+		if !batch.IsRetriable(err) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, time2.NewBackOff(ctx), func(err error, duration time.Duration) {
+		slog.Debug(
+			"Failed to perform request, retrying later",
+			slog.Any("error", err),
+			slog.String("namespace", c.options.namespace),
+			slog.Int64("shard", shardId),
+			slog.Duration("retry-after", duration),
+		)
+	})
+	return response, err
+}
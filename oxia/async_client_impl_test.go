@@ -175,6 +175,183 @@ func TestSyncClientImpl_Notifications(t *testing.T) {
 	assert.NoError(t, standaloneServer.Close())
 }
 
+func TestSyncClientImpl_NotificationsFiltered(t *testing.T) {
+	standaloneServer, err := server.NewStandalone(server.NewTestConfig(t.TempDir()))
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr(), WithBatchLinger(0))
+	assert.NoError(t, err)
+
+	prefixNotifications, err := client.GetNotifications(KeyPrefix("/jobs/"))
+	assert.NoError(t, err)
+	rangeNotifications, err := client.GetNotifications(KeyRange("/b", "/d"), NotificationTypes(KeyDeleted))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, _, _ = client.Put(ctx, "/a", []byte("0"))
+	_, _, _ = client.Put(ctx, "/c", []byte("0"))
+	_, s1, _ := client.Put(ctx, "/jobs/j1", []byte("0"))
+	assert.NoError(t, client.Delete(ctx, "/a"))
+	assert.NoError(t, client.Delete(ctx, "/c"))
+	_, s2, _ := client.Put(ctx, "/jobs/j1", []byte("1"))
+
+	n := <-prefixNotifications.Ch()
+	assert.Equal(t, KeyCreated, n.Type)
+	assert.Equal(t, "/jobs/j1", n.Key)
+	assert.Equal(t, s1.VersionId, n.VersionId)
+
+	n = <-prefixNotifications.Ch()
+	assert.Equal(t, KeyModified, n.Type)
+	assert.Equal(t, "/jobs/j1", n.Key)
+	assert.Equal(t, s2.VersionId, n.VersionId)
+
+	n = <-rangeNotifications.Ch()
+	assert.Equal(t, KeyDeleted, n.Type)
+	assert.Equal(t, "/c", n.Key)
+
+	select {
+	case n := <-prefixNotifications.Ch():
+		assert.Failf(t, "unexpected notification", "%+v", n)
+	case n := <-rangeNotifications.Ch():
+		assert.Failf(t, "unexpected notification", "%+v", n)
+	case <-time.After(100 * time.Millisecond):
+		// Ok, we expect it to time out
+	}
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestSyncClientImpl_NotificationsValues(t *testing.T) {
+	config := server.NewTestConfig(t.TempDir())
+	config.NotificationsIncludeValue = true
+	config.NotificationsIncludePreviousValue = true
+	standaloneServer, err := server.NewStandalone(config)
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr(), WithBatchLinger(0))
+	assert.NoError(t, err)
+
+	notifications, err := client.GetNotifications()
+	assert.NoError(t, err)
+	valueNotifications, err := client.GetNotifications(IncludeNotificationValues())
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, _, _ = client.Put(ctx, "/a", []byte("0"))
+	_, _, _ = client.Put(ctx, "/a", []byte("1"))
+	assert.NoError(t, client.Delete(ctx, "/a"))
+
+	n := <-valueNotifications.Ch()
+	assert.Equal(t, KeyCreated, n.Type)
+	assert.Equal(t, []byte("0"), n.Value)
+	assert.Nil(t, n.PreviousValue)
+
+	n = <-valueNotifications.Ch()
+	assert.Equal(t, KeyModified, n.Type)
+	assert.Equal(t, []byte("1"), n.Value)
+	assert.Equal(t, []byte("0"), n.PreviousValue)
+
+	n = <-valueNotifications.Ch()
+	assert.Equal(t, KeyDeleted, n.Type)
+	assert.Nil(t, n.Value)
+	assert.Equal(t, []byte("1"), n.PreviousValue)
+
+	// The values are only sent when requested
+	for _, expected := range []NotificationType{KeyCreated, KeyModified, KeyDeleted} {
+		n = <-notifications.Ch()
+		assert.Equal(t, expected, n.Type)
+		assert.Nil(t, n.Value)
+		assert.Nil(t, n.PreviousValue)
+	}
+
+	// The ephemeral records deleted with their session carry their value too
+	ephemeralClient, err := NewSyncClient(standaloneServer.ServiceAddr(), WithBatchLinger(0))
+	assert.NoError(t, err)
+	_, _, err = ephemeralClient.Put(ctx, "/e", []byte("e"), Ephemeral())
+	assert.NoError(t, err)
+	assert.NoError(t, ephemeralClient.Close())
+
+	n = <-valueNotifications.Ch()
+	assert.Equal(t, KeyCreated, n.Type)
+	assert.Equal(t, []byte("e"), n.Value)
+
+	n = <-valueNotifications.Ch()
+	assert.Equal(t, KeyDeleted, n.Type)
+	assert.Equal(t, "/e", n.Key)
+	assert.Equal(t, []byte("e"), n.PreviousValue)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestSyncClientImpl_NotificationsResume(t *testing.T) {
+	standaloneServer, err := server.NewStandalone(server.NewTestConfig(t.TempDir()))
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr(), WithBatchLinger(0))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	store := NewOxiaCheckpointStore(client, "/checkpoints/consumer-1")
+
+	notifications, err := client.GetNotifications(KeyPrefix("/data/"), Checkpoints(store))
+	assert.NoError(t, err)
+
+	_, _, _ = client.Put(ctx, "/data/a", []byte("0"))
+	_, _, _ = client.Put(ctx, "/data/b", []byte("0"))
+
+	n := <-notifications.Ch()
+	assert.Equal(t, "/data/a", n.Key)
+	assert.EqualValues(t, 0, n.Shard)
+	assert.NoError(t, notifications.Commit(ctx, n))
+	processed := n.Offset
+
+	n = <-notifications.Ch()
+	assert.Equal(t, "/data/b", n.Key)
+	assert.Greater(t, n.Offset, processed)
+	assert.NoError(t, notifications.Close())
+
+	offsets, err := store.Load(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int64{0: processed}, offsets)
+
+	_, _, _ = client.Put(ctx, "/data/c", []byte("0"))
+
+	// The changes after the checkpoint are delivered again
+	notifications, err = client.GetNotifications(KeyPrefix("/data/"), Checkpoints(store))
+	assert.NoError(t, err)
+
+	n = <-notifications.Ch()
+	assert.Equal(t, "/data/b", n.Key)
+	n = <-notifications.Ch()
+	assert.Equal(t, "/data/c", n.Key)
+	assert.NoError(t, notifications.Commit(ctx, n))
+	assert.NoError(t, notifications.Close())
+
+	// The explicit offsets take precedence over the checkpoint
+	notifications, err = client.GetNotifications(KeyPrefix("/data/"), Checkpoints(store),
+		StartOffsets(map[int64]int64{0: processed}))
+	assert.NoError(t, err)
+
+	n = <-notifications.Ch()
+	assert.Equal(t, "/data/b", n.Key)
+	n = <-notifications.Ch()
+	assert.Equal(t, "/data/c", n.Key)
+
+	select {
+	case n := <-notifications.Ch():
+		assert.Failf(t, "unexpected notification", "%+v", n)
+	case <-time.After(100 * time.Millisecond):
+		// Ok, we expect it to time out
+	}
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
 func TestAsyncClientImpl_NotificationsClose(t *testing.T) {
 	standaloneServer, err := server.NewStandalone(server.NewTestConfig(t.TempDir()))
 	assert.NoError(t, err)
@@ -373,6 +550,375 @@ func TestSyncClientImpl_SessionNotifications(t *testing.T) {
 	assert.NoError(t, standaloneServer.Close())
 }
 
+func TestSyncClientImpl_TTL(t *testing.T) {
+	standaloneServer, err := server.NewStandalone(server.NewTestConfig(t.TempDir()))
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr())
+	assert.NoError(t, err)
+
+	notifications, err := client.GetNotifications()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, _, err = client.Put(ctx, "/a", []byte("0"), TTL(0))
+	assert.ErrorIs(t, err, ErrInvalidOptions)
+
+	_, v1, err := client.Put(ctx, "/a", []byte("0"), TTL(100*time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModifiedTimestamp+100, v1.ExpirationTimestamp)
+
+	_, v2, err := client.Put(ctx, "/b", []byte("0"))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, v2.ExpirationTimestamp)
+
+	for _, key := range []string{"/a", "/b"} {
+		n := <-notifications.Ch()
+		assert.Equal(t, KeyCreated, n.Type)
+		assert.Equal(t, key, n.Key)
+	}
+
+	select {
+	case n := <-notifications.Ch():
+		assert.Equal(t, KeyDeleted, n.Type)
+		assert.Equal(t, "/a", n.Key)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "read from channel timed out")
+	}
+
+	_, _, _, err = client.Get(ctx, "/a")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	_, _, _, err = client.Get(ctx, "/b")
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestSyncClientImpl_Linearizable(t *testing.T) {
+	standaloneServer, err := server.NewStandalone(server.NewTestConfig(t.TempDir()))
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr())
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	_, _, err = client.Put(ctx, "/a", []byte("0"))
+	assert.NoError(t, err)
+
+	_, value, _, err := client.Get(ctx, "/a", Linearizable())
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("0"), value)
+
+	// Linearizable takes precedence over the follower reads
+	_, value, _, err = client.Get(ctx, "/a", FollowerReads(time.Minute), Linearizable())
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("0"), value)
+
+	keys, err := client.List(ctx, "/", "//", Linearizable())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/a"}, keys)
+
+	results := client.RangeScan(ctx, "/", "//", Linearizable())
+	res := <-results
+	assert.NoError(t, res.Err)
+	assert.Equal(t, "/a", res.Key)
+	_, ok := <-results
+	assert.False(t, ok)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestAsyncClientImpl_Pagination(t *testing.T) {
+	config := server.NewTestConfig(t.TempDir())
+	config.NumShards = 3
+	standaloneServer, err := server.NewStandalone(config)
+	assert.NoError(t, err)
+
+	client, err := NewAsyncClient(standaloneServer.ServiceAddr())
+	assert.NoError(t, err)
+
+	var expectedKeys []string
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("/key-%02d", i)
+		expectedKeys = append(expectedKeys, key)
+		// Index the records in reverse order, with duplicated secondary keys
+		r := <-client.Put(key, []byte("0"), SecondaryIndex("idx", fmt.Sprintf("%02d", (19-i)/2)))
+		assert.NoError(t, r.Err)
+	}
+
+	ctx := context.Background()
+
+	var keys []string
+	token := ""
+	for pages := 0; ; pages++ {
+		res := <-client.List(ctx, "/", "//", Limit(7), ContinueFrom(token))
+		assert.NoError(t, res.Err)
+		assert.LessOrEqual(t, len(res.Keys), 7)
+		keys = append(keys, res.Keys...)
+		if token = res.ContinuationToken; token == "" {
+			assert.Equal(t, 2, pages)
+			break
+		}
+	}
+	assert.Equal(t, expectedKeys, keys)
+
+	keys = nil
+	token = ""
+	for token != "" || keys == nil {
+		res := <-client.List(ctx, "00", "99", UseIndex("idx"), Limit(3), ContinueFrom(token))
+		assert.NoError(t, res.Err)
+		keys = append(keys, res.Keys...)
+		token = res.ContinuationToken
+	}
+	assert.Len(t, keys, 20)
+	assert.Equal(t, []string{"/key-18", "/key-19", "/key-16"}, keys[:3])
+	assert.ElementsMatch(t, expectedKeys, keys)
+
+	keys = nil
+	token = ""
+	for token != "" || keys == nil {
+		ch := client.RangeScan(ctx, "/", "//", Limit(6), ContinueFrom(token))
+		token = ""
+		for res := range ch {
+			assert.NoError(t, res.Err)
+			keys = append(keys, res.Key)
+			token = res.ContinuationToken
+		}
+	}
+	assert.Equal(t, expectedKeys, keys)
+
+	res := <-client.List(ctx, "/", "//", Limit(0))
+	assert.ErrorIs(t, res.Err, ErrInvalidOptions)
+	res = <-client.List(ctx, "/", "//", Limit(1), ContinueFrom("invalid"))
+	assert.ErrorIs(t, res.Err, ErrInvalidOptions)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestAsyncClientImpl_Reverse(t *testing.T) {
+	config := server.NewTestConfig(t.TempDir())
+	config.NumShards = 3
+	standaloneServer, err := server.NewStandalone(config)
+	assert.NoError(t, err)
+
+	client, err := NewAsyncClient(standaloneServer.ServiceAddr())
+	assert.NoError(t, err)
+
+	var expectedKeys []string
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("/key-%02d", i)
+		expectedKeys = append([]string{key}, expectedKeys...)
+		r := <-client.Put(key, []byte("0"), SecondaryIndex("idx", fmt.Sprintf("%02d", i)))
+		assert.NoError(t, r.Err)
+	}
+
+	ctx := context.Background()
+
+	var keys []string
+	for res := range client.RangeScan(ctx, "/", "//", Reverse()) {
+		assert.NoError(t, res.Err)
+		keys = append(keys, res.Key)
+	}
+	assert.Equal(t, expectedKeys, keys)
+
+	// Latest records
+	res := <-client.List(ctx, "/", "//", Reverse(), Limit(3))
+	assert.NoError(t, res.Err)
+	assert.Equal(t, []string{"/key-19", "/key-18", "/key-17"}, res.Keys)
+	assert.NotEmpty(t, res.ContinuationToken)
+
+	keys = nil
+	token := ""
+	for token != "" || keys == nil {
+		res := <-client.List(ctx, "00", "99", UseIndex("idx"), Reverse(), Limit(7), ContinueFrom(token))
+		assert.NoError(t, res.Err)
+		keys = append(keys, res.Keys...)
+		token = res.ContinuationToken
+	}
+	assert.Equal(t, expectedKeys, keys)
+
+	keys = nil
+	token = ""
+	for token != "" || keys == nil {
+		ch := client.RangeScan(ctx, "/", "//", Reverse(), Limit(6), ContinueFrom(token))
+		token = ""
+		for res := range ch {
+			assert.NoError(t, res.Err)
+			keys = append(keys, res.Key)
+			token = res.ContinuationToken
+		}
+	}
+	assert.Equal(t, expectedKeys, keys)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestAsyncClientImpl_RangeScanFilter(t *testing.T) {
+	config := server.NewTestConfig(t.TempDir())
+	config.NumShards = 3
+	standaloneServer, err := server.NewStandalone(config)
+	assert.NoError(t, err)
+
+	client, err := NewAsyncClient(standaloneServer.ServiceAddr())
+	assert.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		r := <-client.Put(fmt.Sprintf("/key-%d", i), []byte(fmt.Sprintf("value-%d", i%2)))
+		assert.NoError(t, r.Err)
+	}
+
+	ctx := context.Background()
+	rangeScanKeys := func(options ...RangeScanOption) []string {
+		var keys []string
+		for res := range client.RangeScan(ctx, "/", "//", options...) {
+			assert.NoError(t, res.Err)
+			keys = append(keys, res.Key)
+		}
+		return keys
+	}
+
+	assert.Equal(t, []string{"/key-1", "/key-3", "/key-5", "/key-7", "/key-9"},
+		rangeScanKeys(FilterValuePrefix([]byte("value-1"))))
+	assert.Equal(t, []string{"/key-3", "/key-5"},
+		rangeScanKeys(FilterKeyRegex("[3-6]$"), FilterValuePrefix([]byte("value-1"))))
+	assert.Equal(t, []string{"/key-2", "/key-4"},
+		rangeScanKeys(FilterKeyGlob("/key-[2-4]"), FilterValuePrefix([]byte("value-0"))))
+	assert.Empty(t, rangeScanKeys(FilterEphemeral()))
+
+	// The limit applies to the records matching the filter
+	assert.Equal(t, []string{"/key-0", "/key-2"},
+		rangeScanKeys(FilterValuePrefix([]byte("value-0")), Limit(2)))
+
+	for res := range client.RangeScan(ctx, "/", "//", MetadataOnly()) {
+		assert.NoError(t, res.Err)
+		assert.Nil(t, res.Value)
+		assert.EqualValues(t, 0, res.Version.ModificationsCount)
+	}
+
+	res := <-client.RangeScan(ctx, "/", "//", FilterKeyRegex("("))
+	assert.ErrorIs(t, res.Err, ErrInvalidOptions)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestSyncClientImpl_RangeStats(t *testing.T) {
+	config := server.NewTestConfig(t.TempDir())
+	config.NumShards = 3
+	standaloneServer, err := server.NewStandalone(config)
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr())
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		_, _, err = client.Put(ctx, fmt.Sprintf("/key-%d", i), []byte("value"), SecondaryIndex("idx", fmt.Sprintf("%d", 9-i)))
+		assert.NoError(t, err)
+	}
+
+	stats, err := client.RangeStats(ctx, "/", "//")
+	assert.NoError(t, err)
+	assert.Equal(t, RangeStats{Count: 10, TotalValueSize: 50, MinKey: "/key-0", MaxKey: "/key-9"}, stats)
+
+	stats, err = client.RangeStats(ctx, "/key-3", "/key-6", FollowerReads(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, RangeStats{Count: 3, TotalValueSize: 15, MinKey: "/key-3", MaxKey: "/key-5"}, stats)
+
+	stats, err = client.RangeStats(ctx, "0", "3", UseIndex("idx"), Linearizable())
+	assert.NoError(t, err)
+	assert.Equal(t, RangeStats{Count: 3, TotalValueSize: 15, MinKey: "/key-7", MaxKey: "/key-9"}, stats)
+
+	stats, err = client.RangeStats(ctx, "/x", "/y")
+	assert.NoError(t, err)
+	assert.Equal(t, RangeStats{}, stats)
+
+	_, err = client.RangeStats(ctx, "/", "//", Limit(1))
+	assert.ErrorIs(t, err, ErrInvalidOptions)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestSyncClientImpl_Increment(t *testing.T) {
+	standaloneServer, err := server.NewStandalone(server.NewTestConfig(t.TempDir()))
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr())
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	value, version, err := client.Increment(ctx, "/counter", 3)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, value)
+	assert.EqualValues(t, 0, version.ModificationsCount)
+
+	value, version, err = client.Increment(ctx, "/counter", -1, MinValue(0))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, value)
+	assert.EqualValues(t, 1, version.ModificationsCount)
+
+	_, _, err = client.Increment(ctx, "/counter", -3, MinValue(0))
+	assert.ErrorIs(t, err, ErrValueOutOfBounds)
+
+	_, _, err = client.Increment(ctx, "/counter", 1, MinValue(5), MaxValue(4))
+	assert.ErrorIs(t, err, ErrInvalidOptions)
+
+	_, res, _, err := client.Get(ctx, "/counter")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("2"), res)
+
+	_, _, err = client.Put(ctx, "/text", []byte("hello"))
+	assert.NoError(t, err)
+	_, _, err = client.Increment(ctx, "/text", 1)
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestSyncClientImpl_AppendAndMergePatch(t *testing.T) {
+	standaloneServer, err := server.NewStandalone(server.NewTestConfig(t.TempDir()))
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr())
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	version, err := client.Append(ctx, "/log", []byte("a"))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, version.ModificationsCount)
+
+	version, err = client.Append(ctx, "/log", []byte("b"))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, version.ModificationsCount)
+
+	_, value, _, err := client.Get(ctx, "/log")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("ab"), value)
+
+	_, err = client.MergePatch(ctx, "/doc", []byte(`{"a":1,"b":2}`))
+	assert.NoError(t, err)
+	version, err = client.MergePatch(ctx, "/doc", []byte(`{"a":null,"c":3}`))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, version.ModificationsCount)
+
+	_, value, _, err = client.Get(ctx, "/doc")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"b":2,"c":3}`, string(value))
+
+	_, err = client.MergePatch(ctx, "/log", []byte(`{"a":1}`))
+	assert.ErrorIs(t, err, ErrInvalidJSON)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
 func TestSyncClientImpl_FloorCeilingGet(t *testing.T) {
 	config := server.NewTestConfig(t.TempDir())
 	// Test with multiple shards to ensure correctness across shards
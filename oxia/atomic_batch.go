@@ -0,0 +1,142 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/proto"
+)
+
+type atomicBatch struct {
+	sync.Mutex
+
+	client    *clientImpl
+	shardId   *int64
+	request   *proto.WriteRequest
+	completed bool
+}
+
+func (c *clientImpl) NewAtomicBatch() AtomicBatch {
+	return &atomicBatch{
+		client:  c,
+		request: &proto.WriteRequest{Atomic: true},
+	}
+}
+
+func (b *atomicBatch) Put(key string, value []byte, options ...PutOption) error {
+	opts, err := newPutOptions(options)
+	if err != nil {
+		return err
+	}
+	if opts.ephemeral {
+		return errors.Wrap(ErrInvalidOptions, "ephemeral records are not supported in atomic batches")
+	}
+
+	b.Lock()
+	defer b.Unlock()
+	if err = b.checkShard(b.client.getShardForKey(key, opts)); err != nil {
+		return err
+	}
+
+	b.request.Puts = append(b.request.Puts, &proto.PutRequest{
+		Key:                  key,
+		Value:                value,
+		ExpectedVersionId:    opts.expectedVersion,
+		ExpectedRecordExists: toExpectedRecordExists(opts),
+		ExpectedValueHash:    opts.expectedValueHash,
+		PartitionKey:         opts.partitionKey,
+		SequenceKeyDelta:     opts.sequenceKeysDeltas,
+		SecondaryIndexes:     toSecondaryIndexes(opts.secondaryIndexes),
+		TtlMs:                toTtlMs(opts),
+	})
+	return nil
+}
+
+func (b *atomicBatch) Delete(key string, options ...DeleteOption) error {
+	opts := newDeleteOptions(options)
+
+	b.Lock()
+	defer b.Unlock()
+	if err := b.checkShard(b.client.getShardForKey(key, opts)); err != nil {
+		return err
+	}
+
+	b.request.Deletes = append(b.request.Deletes, &proto.DeleteRequest{
+		Key:               key,
+		ExpectedVersionId: opts.expectedVersion,
+		ExpectedValueHash: opts.expectedValueHash,
+	})
+	return nil
+}
+
+func (b *atomicBatch) checkShard(shardId int64) error {
+	if b.completed {
+		return ErrTransactionCompleted
+	}
+
+	if b.shardId == nil {
+		b.shardId = &shardId
+	} else if *b.shardId != shardId {
+		return errors.Wrap(ErrInvalidOptions, "all the records of an atomic batch must be stored in the same shard, "+
+			"PartitionKey() can be used to co-locate them")
+	}
+	return nil
+}
+
+func (b *atomicBatch) Commit(ctx context.Context) error {
+	b.Lock()
+	defer b.Unlock()
+	if b.completed {
+		return ErrTransactionCompleted
+	}
+	b.completed = true
+
+	if b.shardId == nil {
+		return nil
+	}
+
+	b.request.Shard = b.shardId
+	res, err := executeWithRetries(ctx, b.client, *b.shardId, func(ctx context.Context) (*proto.WriteResponse, error) {
+		return b.client.executor.ExecuteWrite(ctx, b.request)
+	})
+	if err != nil {
+		return err
+	}
+	return toAtomicBatchError(res)
+}
+
+// toAtomicBatchError returns the error of the first operation that prevented
+// the batch from being applied.
+func toAtomicBatchError(res *proto.WriteResponse) error {
+	for _, put := range res.Puts {
+		if put.Status != proto.Status_BATCH_ABORTED {
+			if err := toError(put.Status); err != nil {
+				return err
+			}
+		}
+	}
+	for _, del := range res.Deletes {
+		if del.Status != proto.Status_BATCH_ABORTED {
+			if err := toError(del.Status); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/server"
+)
+
+func TestSyncClientImpl_AtomicBatch(t *testing.T) {
+	config := server.NewTestConfig(t.TempDir())
+	config.NumShards = 10
+	standaloneServer, err := server.NewStandalone(config)
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr())
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	batch := client.NewAtomicBatch()
+	assert.NoError(t, batch.Put("/lock", []byte("owner-1"), ExpectedRecordNotExists(), PartitionKey("x")))
+	assert.NoError(t, batch.Put("/data", []byte("0"), PartitionKey("x")))
+	assert.NoError(t, batch.Commit(ctx))
+
+	// A failed condition prevents all the operations from being applied
+	batch = client.NewAtomicBatch()
+	assert.NoError(t, batch.Put("/lock", []byte("owner-2"), ExpectedValue([]byte("owner-2")), PartitionKey("x")))
+	assert.NoError(t, batch.Put("/data", []byte("1"), PartitionKey("x")))
+	assert.ErrorIs(t, batch.Commit(ctx), ErrUnexpectedValue)
+
+	_, value, _, err := client.Get(ctx, "/data", PartitionKey("x"))
+	assert.NoError(t, err)
+	assert.Equal(t, "0", string(value))
+
+	batch = client.NewAtomicBatch()
+	assert.NoError(t, batch.Delete("/lock", ExpectedValue([]byte("owner-1")), PartitionKey("x")))
+	assert.NoError(t, batch.Put("/data", []byte("1"), ExpectedRecordExists(), PartitionKey("x")))
+	assert.NoError(t, batch.Commit(ctx))
+	assert.ErrorIs(t, batch.Commit(ctx), ErrTransactionCompleted)
+
+	_, value, _, err = client.Get(ctx, "/data", PartitionKey("x"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1", string(value))
+	_, _, _, err = client.Get(ctx, "/lock", PartitionKey("x"))
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+
+	// All the records must be in the same shard
+	batch = client.NewAtomicBatch()
+	assert.NoError(t, batch.Put("/a", []byte("0"), PartitionKey("x")))
+	assert.ErrorIs(t, batch.Put("/b", []byte("0"), PartitionKey("y")), ErrInvalidOptions)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
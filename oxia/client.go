@@ -35,11 +35,35 @@ var (
 	// the current version id of the stored record.
 	ErrUnexpectedVersionId = errors.New("unexpected version id")
 
+	// ErrUnexpectedValue The expected value passed as a condition does not match
+	// the current value of the stored record.
+	ErrUnexpectedValue = errors.New("unexpected value")
+
 	ErrInvalidOptions = errors.New("invalid options")
 
 	// ErrRequestTooLarge is returned when a request is larger than the maximum batch size.
 	ErrRequestTooLarge = batch.ErrRequestTooLarge
 
+	// ErrKeyLocked The record is locked by a transaction that is not completed yet.
+	ErrKeyLocked = errors.New("key locked by a pending transaction")
+
+	// ErrTransactionAborted The transaction was aborted and none of its operations were applied.
+	ErrTransactionAborted = errors.New("transaction aborted")
+
+	// ErrTransactionCompleted The transaction was already committed and cannot be used anymore.
+	ErrTransactionCompleted = errors.New("transaction already completed")
+
+	// ErrInvalidNumber The value of the record is not a valid counter.
+	ErrInvalidNumber = errors.New("invalid number")
+
+	// ErrValueOutOfBounds The new value of the counter would be out of the bounds
+	// set with [MinValue] and [MaxValue], or it would overflow.
+	ErrValueOutOfBounds = errors.New("value out of bounds")
+
+	// ErrInvalidJSON The value of the record, or the merge patch, is not a valid
+	// JSON document.
+	ErrInvalidJSON = errors.New("invalid json")
+
 	// ErrUnknownStatus Unknown error.
 	ErrUnknownStatus = errors.New("unknown status")
 )
@@ -64,6 +88,9 @@ type AsyncClient interface {
 	//  - The Put operation can be made conditional on that the record hasn't changed from
 	//    a specific existing version by passing the [ExpectedVersionId] option.
 	//  - Client can assert that the record does not exist by passing [ExpectedRecordNotExists]
+	//  - Client can assert that the record exists by passing [ExpectedRecordExists]
+	//  - The Put operation can be made conditional on the current value of the record
+	//    by passing the [ExpectedValue] option.
 	//  - Client can create an ephemeral record with [Ephemeral]
 	//
 	// Returns a [Version] object that contains information about the newly updated record
@@ -74,7 +101,8 @@ type AsyncClient interface {
 	// Delete removes the key and its associated value from the data store.
 	//
 	// The Delete operation can be made conditional on that the record hasn't changed from
-	// a specific existing version by passing the [ExpectedVersionId] option.
+	// a specific existing version by passing the [ExpectedVersionId] option, or on the
+	// current value of the record by passing the [ExpectedValue] option.
 	// Returns [ErrorUnexpectedVersionId] if the expected version id does not match the
 	// current version id of the record
 	Delete(key string, options ...DeleteOption) <-chan error
@@ -85,6 +113,30 @@ type AsyncClient interface {
 	// https://oxia-db.github.io/docs/features/oxia-key-sorting
 	DeleteRange(minKeyInclusive string, maxKeyExclusive string, options ...DeleteRangeOption) <-chan error
 
+	// Increment atomically adds a delta to the counter stored with the key, and
+	// returns its new value. A negative delta decrements the counter.
+	//
+	// The value of a counter is stored as the decimal representation of an int64,
+	// and a counter that does not exist has the value 0.
+	// The new value can be bounded by passing the [MinValue] and [MaxValue] options.
+	// Returns [ErrValueOutOfBounds] if the new value would be out of the bounds
+	// Returns [ErrInvalidNumber] if the stored value is not a valid counter
+	Increment(key string, delta int64, options ...IncrementOption) <-chan IncrementResult
+
+	// Append atomically appends bytes to the value of the record stored with the
+	// key, or creates the record if it does not exist.
+	//
+	// The record keeps its secondary indexes, its session and its expiration.
+	Append(key string, value []byte, options ...AppendOption) <-chan PutResult
+
+	// MergePatch atomically applies a JSON merge patch, as defined by RFC 7386, to
+	// the JSON value of the record stored with the key, or creates the record if it
+	// does not exist.
+	//
+	// The record keeps its secondary indexes, its session and its expiration.
+	// Returns [ErrInvalidJSON] if the patch or the stored value is not valid JSON
+	MergePatch(key string, patch []byte, options ...MergePatchOption) <-chan PutResult
+
 	// Get returns the value associated with the specified key.
 	// In addition to the value, a version object is also returned, with information
 	// about the record state.
@@ -103,6 +155,12 @@ type AsyncClient interface {
 	// https://oxia-db.github.io/docs/features/oxia-key-sorting
 	RangeScan(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, options ...RangeScanOption) <-chan GetResult
 
+	// RangeStats computes the statistics of the existing records with any keys
+	// within the specified range: their count, the total size of their values
+	// and the lowest and highest keys.
+	// The statistics are computed by the servers, without transferring the records.
+	RangeStats(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, options ...ListOption) <-chan RangeStatsResult
+
 	// GetSequenceUpdates allows to subscribe to the updates happening on a sequential key
 	// The channel will report the current latest sequence for a given key.
 	// Multiple updates can be collapsed into one single event with the
@@ -110,8 +168,29 @@ type AsyncClient interface {
 	GetSequenceUpdates(ctx context.Context, prefixKey string, options ...GetSequenceUpdatesOption) (<-chan string, error)
 
 	// GetNotifications creates a new subscription to receive the notifications
-	// from Oxia for any change that is applied to the database
-	GetNotifications() (Notifications, error)
+	// from Oxia for any change that is applied to the database.
+	// The options allow to only receive the notifications for some keys or
+	// types of changes.
+	GetNotifications(options ...NotificationsOption) (Notifications, error)
+
+	// Begin starts a new transaction.
+	//
+	// The operations added to the transaction are applied atomically when it is
+	// committed, even if the records are stored in different shards.
+	Begin(options ...TransactionOption) Transaction
+
+	// NewAtomicBatch creates a new batch of operations on records stored in the
+	// same shard, which are applied atomically when it is committed.
+	NewAtomicBatch() AtomicBatch
+
+	// Namespace returns a client for the operations on another namespace. The
+	// returned client shares the connections, the stream of shard assignments
+	// and the metrics with this one.
+	//
+	// Closing the returned client only releases its own resources, such as its
+	// sessions and notifications. It cannot be used anymore once this client is
+	// closed.
+	Namespace(namespace string) (AsyncClient, error)
 }
 
 // SyncClient is the main interface to perform operations with Oxia.
@@ -131,6 +210,9 @@ type SyncClient interface {
 	//  - The Put operation can be made conditional on that the record hasn't changed from
 	//    a specific existing version by passing the [ExpectedVersionId] option.
 	//  - Client can assert that the record does not exist by passing [ExpectedRecordNotExists]
+	//  - Client can assert that the record exists by passing [ExpectedRecordExists]
+	//  - The Put operation can be made conditional on the current value of the record
+	//    by passing the [ExpectedValue] option.
 	//  - Client can create an ephemeral record with [Ephemeral]
 	//
 	// Returns the actual key of the inserted record
@@ -142,7 +224,8 @@ type SyncClient interface {
 	// Delete removes the key and its associated value from the data store.
 	//
 	// The Delete operation can be made conditional on that the record hasn't changed from
-	// a specific existing version by passing the [ExpectedVersionId] option.
+	// a specific existing version by passing the [ExpectedVersionId] option, or on the
+	// current value of the record by passing the [ExpectedValue] option.
 	// Returns [ErrorUnexpectedVersionId] if the expected version id does not match the
 	// current version id of the record
 	Delete(ctx context.Context, key string, options ...DeleteOption) error
@@ -153,6 +236,30 @@ type SyncClient interface {
 	// https://oxia-db.github.io/docs/features/oxia-key-sorting
 	DeleteRange(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, options ...DeleteRangeOption) error
 
+	// Increment atomically adds a delta to the counter stored with the key, and
+	// returns its new value. A negative delta decrements the counter.
+	//
+	// The value of a counter is stored as the decimal representation of an int64,
+	// and a counter that does not exist has the value 0.
+	// The new value can be bounded by passing the [MinValue] and [MaxValue] options.
+	// Returns [ErrValueOutOfBounds] if the new value would be out of the bounds
+	// Returns [ErrInvalidNumber] if the stored value is not a valid counter
+	Increment(ctx context.Context, key string, delta int64, options ...IncrementOption) (value int64, version Version, err error)
+
+	// Append atomically appends bytes to the value of the record stored with the
+	// key, or creates the record if it does not exist.
+	//
+	// The record keeps its secondary indexes, its session and its expiration.
+	Append(ctx context.Context, key string, value []byte, options ...AppendOption) (Version, error)
+
+	// MergePatch atomically applies a JSON merge patch, as defined by RFC 7386, to
+	// the JSON value of the record stored with the key, or creates the record if it
+	// does not exist.
+	//
+	// The record keeps its secondary indexes, its session and its expiration.
+	// Returns [ErrInvalidJSON] if the patch or the stored value is not valid JSON
+	MergePatch(ctx context.Context, key string, patch []byte, options ...MergePatchOption) (Version, error)
+
 	// Get returns the value associated with the specified key.
 	// In addition to the value, a version object is also returned, with information
 	// about the record state.
@@ -170,6 +277,12 @@ type SyncClient interface {
 	// inserted with that partition key).
 	RangeScan(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, options ...RangeScanOption) <-chan GetResult
 
+	// RangeStats computes the statistics of the existing records with any keys
+	// within the specified range: their count, the total size of their values
+	// and the lowest and highest keys.
+	// The statistics are computed by the servers, without transferring the records.
+	RangeStats(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, options ...ListOption) (RangeStats, error)
+
 	// GetSequenceUpdates allows to subscribe to the updates happening on a sequential key
 	// The channel will report the current latest sequence for a given key.
 	// Multiple updates can be collapsed into one single event with the
@@ -177,8 +290,85 @@ type SyncClient interface {
 	GetSequenceUpdates(ctx context.Context, prefixKey string, options ...GetSequenceUpdatesOption) (<-chan string, error)
 
 	// GetNotifications creates a new subscription to receive the notifications
-	// from Oxia for any change that is applied to the database
-	GetNotifications() (Notifications, error)
+	// from Oxia for any change that is applied to the database.
+	// The options allow to only receive the notifications for some keys or
+	// types of changes.
+	GetNotifications(options ...NotificationsOption) (Notifications, error)
+
+	// Begin starts a new transaction.
+	//
+	// The operations added to the transaction are applied atomically when it is
+	// committed, even if the records are stored in different shards.
+	Begin(options ...TransactionOption) Transaction
+
+	// NewAtomicBatch creates a new batch of operations on records stored in the
+	// same shard, which are applied atomically when it is committed.
+	NewAtomicBatch() AtomicBatch
+
+	// Namespace returns a client for the operations on another namespace. The
+	// returned client shares the connections, the stream of shard assignments
+	// and the metrics with this one.
+	//
+	// Closing the returned client only releases its own resources, such as its
+	// sessions and notifications. It cannot be used anymore once this client is
+	// closed.
+	Namespace(namespace string) (SyncClient, error)
+}
+
+// Transaction groups a set of write operations that are applied atomically,
+// across one or more shards.
+//
+// The operations are buffered in the client and sent to the servers only when
+// the transaction is committed. During the commit, the records involved in the
+// transaction are locked until its outcome is decided on all the shards, and
+// any other write on them fails with [ErrKeyLocked].
+//
+// The reads are never blocked by a transaction: they return the last committed
+// value of the records. Since the shards are committed one after the other, a
+// read on records stored in different shards can observe the transaction
+// applied on some of the shards only, ie: the isolation level is read committed.
+type Transaction interface {
+	// Put adds to the transaction the association of a value with a key.
+	//
+	// The [ExpectedVersionId], [ExpectedRecordNotExists], [ExpectedRecordExists],
+	// [ExpectedValue], [PartitionKey] and [SecondaryIndex] options are supported.
+	// Ephemeral records and sequential keys cannot be part of a transaction.
+	Put(key string, value []byte, options ...PutOption) error
+
+	// Delete adds to the transaction the removal of the record associated with the key.
+	// A Delete operation on a record that does not exist aborts the transaction.
+	Delete(key string, options ...DeleteOption) error
+
+	// Commit applies all the operations of the transaction.
+	//
+	// If any of the conditions of the operations is not satisfied, the
+	// transaction is aborted and the error of the first failed operation is
+	// returned, eg: [ErrUnexpectedVersionId] or [ErrKeyLocked].
+	Commit(ctx context.Context) error
+}
+
+// AtomicBatch groups a set of write operations on records that are stored in
+// the same shard. When the batch is committed, either all the operations are
+// applied, or none of them is.
+//
+// The conditions of all the operations, eg: [ExpectedVersionId] or [ExpectedValue],
+// are verified against the records stored before the batch is applied.
+// A Delete operation on a record that does not exist fails the batch.
+//
+// Records stored in different shards can be co-located with the [PartitionKey]
+// option. Otherwise, a [Transaction] should be used.
+type AtomicBatch interface {
+	// Put adds to the batch the association of a value with a key.
+	Put(key string, value []byte, options ...PutOption) error
+
+	// Delete adds to the batch the removal of the record associated with the key.
+	Delete(key string, options ...DeleteOption) error
+
+	// Commit applies all the operations of the batch.
+	//
+	// If any of the operations fails, the error of the first failed operation
+	// is returned, eg: [ErrUnexpectedVersionId], and none of the operations is applied.
+	Commit(ctx context.Context) error
 }
 
 // Version includes some information regarding the state of a record.
@@ -213,10 +403,15 @@ type Version struct {
 	// For ephemeral records, the unique identity of the Oxia client that did last modify it.
 	// It will be empty for all non-ephemeral records.
 	ClientIdentity string
+
+	// For records with a TTL, the time after which the record will be deleted.
+	// Records without a TTL will always report 0. See [TTL]
+	ExpirationTimestamp uint64
 }
 
 // PutResult structure is wrapping the version information for the result
-// of a `Put` operation and an eventual error in the [AsyncClient].
+// of a `Put`, `Append` or `MergePatch` operation and an eventual error in
+// the [AsyncClient].
 type PutResult struct {
 	// The Key of the inserted record
 	Key string
@@ -228,6 +423,20 @@ type PutResult struct {
 	Err error
 }
 
+// IncrementResult structure is wrapping the new value of a counter and its version
+// information, and an eventual error as results for an `Increment` operation in the
+// [AsyncClient].
+type IncrementResult struct {
+	// The new value of the counter
+	Value int64
+
+	// The Version information
+	Version Version
+
+	// The error if the `Increment` operation failed
+	Err error
+}
+
 // GetResult structure is wrapping a record, with its Key and Value, its version information and
 // an eventual error as results for a `Get` operation in the [AsyncClient].
 type GetResult struct {
@@ -242,6 +451,11 @@ type GetResult struct {
 
 	// The error if the `Get` operation failed
 	Err error
+
+	// In a [RangeScan] with [Limit], the last record of the page carries the
+	// token to pass with [ContinueFrom] to get the next page. It's empty if
+	// there are no more records.
+	ContinuationToken string
 }
 
 // ListResult structure is wrapping a list of keys, and a potential error as
@@ -251,6 +465,31 @@ type ListResult struct {
 	Keys []string
 	// The eventual error in the [List] operation
 	Err error
+
+	// In a [List] with [Limit], the token to pass with [ContinueFrom] to get
+	// the next page. It's empty if there are no more keys.
+	ContinuationToken string
+}
+
+// RangeStats contains the statistics of the records within a range of keys.
+type RangeStats struct {
+	// The number of records
+	Count uint64
+	// The total size of the values of the records, in bytes
+	TotalValueSize uint64
+	// The lowest key of the records. It's empty if there are no records
+	MinKey string
+	// The highest key of the records. It's empty if there are no records
+	MaxKey string
+}
+
+// RangeStatsResult structure is wrapping the statistics of a range, and a
+// potential error as results for a `RangeStats` operation in the [AsyncClient].
+type RangeStatsResult struct {
+	// The statistics returned by [RangeStats]
+	Stats RangeStats
+	// The eventual error in the [RangeStats] operation
+	Err error
 }
 
 // Notifications allow applications to receive the feed of changes
@@ -260,6 +499,12 @@ type Notifications interface {
 
 	// Ch exposes the channel where all the notification events are published
 	Ch() <-chan *Notification
+
+	// Commit marks the notification, and all the previous ones of its shard,
+	// as processed. The position is saved in the checkpoint store, if any, so
+	// that a new subscription can resume from it.
+	// The notifications must be committed in the order they are received.
+	Commit(ctx context.Context, notification *Notification) error
 }
 
 // NotificationType represents the type of the notification event.
@@ -305,4 +550,24 @@ type Notification struct {
 	// In case of a KeyRangeRangeDeleted notification, this would represent
 	// the end (excluded) of the range of keys
 	KeyRangeEnd string
+
+	// The value written by the change, for KeyCreated and KeyModified
+	// notifications. It is only set with [IncludeNotificationValues], if the
+	// namespace captures the values
+	Value []byte
+
+	// The value replaced by the change, for KeyModified and KeyDeleted
+	// notifications. It is only set with [IncludeNotificationValues], if the
+	// namespace captures the previous values
+	PreviousValue []byte
+
+	// The shard where the change was applied
+	Shard int64
+
+	// The offset of the change in the shard. All the notifications of a
+	// change share the same offset
+	Offset int64
+
+	// Whether this is the last notification of its change
+	lastInBatch bool
 }
@@ -99,7 +99,12 @@ func (b *readBatch) doRequestWithRetries(request *proto.ReadRequest) (response *
 
 	err = backoff.RetryNotify(func() error {
 		response, err = b.doRequest(ctx, request)
-		if !isRetriable(err) {
+		if request.MaxStalenessMs != nil && IsFollowerReadRejected(err) {
+			// Fall back to the leader
+			request.MaxStalenessMs = nil
+			response, err = b.doRequest(ctx, request)
+		}
+		if !IsRetriable(err) {
 			return backoff.Permanent(err)
 		}
 		return err
@@ -150,7 +155,35 @@ func (b *readBatch) handle(response *proto.ReadResponse) {
 
 func (b *readBatch) toProto() *proto.ReadRequest {
 	return &proto.ReadRequest{
-		Shard: b.shardId,
-		Gets:  model.Convert[model.GetCall, *proto.GetRequest](b.gets, model.GetCall.ToProto),
+		Shard:          b.shardId,
+		Gets:           model.Convert[model.GetCall, *proto.GetRequest](b.gets, model.GetCall.ToProto),
+		MaxStalenessMs: b.maxStalenessMs(),
+		Linearizable:   b.linearizable(),
+	}
+}
+
+// maxStalenessMs returns the staleness that is acceptable for all the gets in
+// the batch, if any.
+func (b *readBatch) maxStalenessMs() *uint64 {
+	var res *uint64
+	for _, get := range b.gets {
+		if get.MaxStalenessMs == nil {
+			return nil
+		}
+		if res == nil || *get.MaxStalenessMs < *res {
+			res = get.MaxStalenessMs
+		}
+	}
+	return res
+}
+
+// linearizable returns whether any of the gets in the batch requires a
+// linearizable read.
+func (b *readBatch) linearizable() bool {
+	for _, get := range b.gets {
+		if get.Linearizable {
+			return true
+		}
 	}
+	return false
 }
@@ -21,17 +21,30 @@ import (
 	"github.com/oxia-db/oxia/common/constant"
 )
 
-func isRetriable(err error) bool {
+// IsRetriable returns whether the request that failed with the given error
+// can be attempted again.
+func IsRetriable(err error) bool {
 	code := status.Code(err)
 	switch code {
 	case
-		codes.Unavailable,            // Failure to connect is ok to re-attempt
-		constant.CodeInvalidStatus,   // Leader has fenced the shard, though we expect a new leader to be elected
-		constant.CodeAlreadyClosed,   // Leader is closing, though we expect a new leader to be elected
-		constant.CodeNodeIsNotLeader: /* We're making a request to a node that is not leader anymore. Retry to make
+		codes.Unavailable,               // Failure to connect is ok to re-attempt
+		constant.CodeInvalidStatus,      // Leader has fenced the shard, though we expect a new leader to be elected
+		constant.CodeAlreadyClosed,      // Leader is closing, though we expect a new leader to be elected
+		constant.CodeShardSplitting,     // Shard is being split, the request will be re-routed once the new shards are ready
+		constant.CodeShardMerging,       // Shard is being merged, the request will be re-routed once the new shard is ready
+		constant.CodeLeaderTransferring, // Leadership is being handed off, the request will be sent to the new leader
+		constant.CodeNodeIsNotLeader:    /* We're making a request to a node that is not leader anymore. Retry to make
 		   the request to the new leader */
 		return true
 	default:
 		return false
 	}
 }
+
+// IsFollowerReadRejected returns whether a read request that tolerates stale
+// data could not be served by a follower. The request should be sent to the
+// leader instead.
+func IsFollowerReadRejected(err error) bool {
+	code := status.Code(err)
+	return code == constant.CodeFollowerTooStale || code == constant.CodeNodeIsNotFollower
+}
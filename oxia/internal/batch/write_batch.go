@@ -48,6 +48,9 @@ func (b writeBatchFactory) newBatch(shardId *int64) batch.Batch {
 		puts:           make([]model.PutCall, 0),
 		deletes:        make([]model.DeleteCall, 0),
 		deleteRanges:   make([]model.DeleteRangeCall, 0),
+		increments:     make([]model.IncrementCall, 0),
+		appends:        make([]model.AppendCall, 0),
+		mergePatches:   make([]model.MergePatchCall, 0),
 		requestTimeout: b.requestTimeout,
 		metrics:        b.metrics,
 		callback:       b.metrics.WriteCallback(),
@@ -63,6 +66,9 @@ type writeBatch struct {
 	puts           []model.PutCall
 	deletes        []model.DeleteCall
 	deleteRanges   []model.DeleteRangeCall
+	increments     []model.IncrementCall
+	appends        []model.AppendCall
+	mergePatches   []model.MergePatchCall
 	metrics        *metrics.Metrics
 	requestTimeout time.Duration
 	callback       func(time.Time, *proto.WriteRequest, *proto.WriteResponse, error)
@@ -83,6 +89,12 @@ func (b *writeBatch) Add(call any) {
 		b.deletes = append(b.deletes, b.metrics.DecorateDelete(c))
 	case model.DeleteRangeCall:
 		b.deleteRanges = append(b.deleteRanges, b.metrics.DecorateDeleteRange(c))
+	case model.IncrementCall:
+		b.increments = append(b.increments, b.metrics.DecorateIncrement(c))
+	case model.AppendCall:
+		b.appends = append(b.appends, b.metrics.DecorateAppend(c))
+	case model.MergePatchCall:
+		b.mergePatches = append(b.mergePatches, b.metrics.DecorateMergePatch(c))
 	default:
 		panic("invalid call")
 	}
@@ -90,7 +102,8 @@ func (b *writeBatch) Add(call any) {
 }
 
 func (b *writeBatch) Size() int {
-	return len(b.puts) + len(b.deletes) + len(b.deleteRanges)
+	return len(b.puts) + len(b.deletes) + len(b.deleteRanges) + len(b.increments) +
+		len(b.appends) + len(b.mergePatches)
 }
 
 func (b *writeBatch) Complete() {
@@ -119,7 +132,7 @@ func (b *writeBatch) doRequestWithRetries(request *proto.WriteRequest) (response
 
 	err = backoff.RetryNotify(func() error {
 		response, err = b.execute(ctx, request)
-		if !isRetriable(err) {
+		if !IsRetriable(err) {
 			return backoff.Permanent(err)
 		}
 		return err
@@ -146,6 +159,15 @@ func (b *writeBatch) Fail(err error) {
 	for _, deleteRange := range b.deleteRanges {
 		deleteRange.Callback(nil, err)
 	}
+	for _, increment := range b.increments {
+		increment.Callback(nil, err)
+	}
+	for _, appendCall := range b.appends {
+		appendCall.Callback(nil, err)
+	}
+	for _, mergePatch := range b.mergePatches {
+		mergePatch.Callback(nil, err)
+	}
 }
 
 func (b *writeBatch) handle(response *proto.WriteResponse) {
@@ -158,6 +180,15 @@ func (b *writeBatch) handle(response *proto.WriteResponse) {
 	for i, deleteRange := range b.deleteRanges {
 		deleteRange.Callback(response.DeleteRanges[i], nil)
 	}
+	for i, increment := range b.increments {
+		increment.Callback(response.Increments[i], nil)
+	}
+	for i, appendCall := range b.appends {
+		appendCall.Callback(response.Appends[i], nil)
+	}
+	for i, mergePatch := range b.mergePatches {
+		mergePatch.Callback(response.MergePatches[i], nil)
+	}
 }
 
 func (b *writeBatch) toProto() *proto.WriteRequest {
@@ -166,6 +197,9 @@ func (b *writeBatch) toProto() *proto.WriteRequest {
 		Puts:         model.Convert[model.PutCall, *proto.PutRequest](b.puts, model.PutCall.ToProto),
 		Deletes:      model.Convert[model.DeleteCall, *proto.DeleteRequest](b.deletes, model.DeleteCall.ToProto),
 		DeleteRanges: model.Convert[model.DeleteRangeCall, *proto.DeleteRangeRequest](b.deleteRanges, model.DeleteRangeCall.ToProto),
+		Increments:   model.Convert[model.IncrementCall, *proto.IncrementRequest](b.increments, model.IncrementCall.ToProto),
+		Appends:      model.Convert[model.AppendCall, *proto.AppendRequest](b.appends, model.AppendCall.ToProto),
+		MergePatches: model.Convert[model.MergePatchCall, *proto.MergePatchRequest](b.mergePatches, model.MergePatchCall.ToProto),
 	}
 }
 
@@ -177,6 +211,12 @@ func getByteSize(call any) int {
 		return len(c.Key)
 	case model.DeleteRangeCall:
 		return len(c.MinKeyInclusive) + len(c.MaxKeyExclusive)
+	case model.IncrementCall:
+		return len(c.Key)
+	case model.AppendCall:
+		return len(c.Key) + len(c.Value)
+	case model.MergePatchCall:
+		return len(c.Key) + len(c.Patch)
 	default:
 		panic("invalid call")
 	}
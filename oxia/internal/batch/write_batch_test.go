@@ -38,6 +38,9 @@ func TestWriteBatchAdd(t *testing.T) {
 		{model.PutCall{}, false, 1},
 		{model.DeleteCall{}, false, 1},
 		{model.DeleteRangeCall{}, false, 1},
+		{model.IncrementCall{}, false, 1},
+		{model.AppendCall{}, false, 1},
+		{model.MergePatchCall{}, false, 1},
 		{model.GetCall{}, true, 0},
 	} {
 		factory := &writeBatchFactory{
@@ -64,6 +67,16 @@ func TestWriteBatchComplete(t *testing.T) {
 			ModificationsCount: 1,
 		},
 	}
+	incrementResponseOk := &proto.IncrementResponse{
+		Status: proto.Status_OK,
+		Version: &proto.Version{
+			VersionId:          4,
+			CreatedTimestamp:   2,
+			ModifiedTimestamp:  3,
+			ModificationsCount: 0,
+		},
+		Value: 5,
+	}
 	for _, item := range []struct {
 		response                    *proto.WriteResponse
 		err                         error
@@ -73,6 +86,8 @@ func TestWriteBatchComplete(t *testing.T) {
 		expectedDeleteErr           error
 		expectedDeleteRangeResponse *proto.DeleteRangeResponse
 		expectedDeleteRangeErr      error
+		expectedIncrementResponse   *proto.IncrementResponse
+		expectedIncrementErr        error
 	}{
 		{
 			&proto.WriteResponse{
@@ -83,6 +98,7 @@ func TestWriteBatchComplete(t *testing.T) {
 				DeleteRanges: []*proto.DeleteRangeResponse{{
 					Status: proto.Status_OK,
 				}},
+				Increments: []*proto.IncrementResponse{incrementResponseOk},
 			},
 			nil,
 			putResponseOk,
@@ -95,6 +111,8 @@ func TestWriteBatchComplete(t *testing.T) {
 				Status: proto.Status_OK,
 			},
 			nil,
+			incrementResponseOk,
+			nil,
 		},
 		{
 			&proto.WriteResponse{
@@ -107,6 +125,9 @@ func TestWriteBatchComplete(t *testing.T) {
 				DeleteRanges: []*proto.DeleteRangeResponse{{
 					Status: proto.Status_OK,
 				}},
+				Increments: []*proto.IncrementResponse{{
+					Status: proto.Status_VALUE_OUT_OF_BOUNDS,
+				}},
 			},
 			nil,
 			&proto.PutResponse{
@@ -121,6 +142,10 @@ func TestWriteBatchComplete(t *testing.T) {
 				Status: proto.Status_OK,
 			},
 			nil,
+			&proto.IncrementResponse{
+				Status: proto.Status_VALUE_OUT_OF_BOUNDS,
+			},
+			nil,
 		},
 		{
 			nil,
@@ -131,6 +156,8 @@ func TestWriteBatchComplete(t *testing.T) {
 			io.EOF,
 			nil,
 			io.EOF,
+			nil,
+			io.EOF,
 		},
 	} {
 		execute := func(ctx context.Context, request *proto.WriteRequest) (*proto.WriteResponse, error) {
@@ -149,6 +176,12 @@ func TestWriteBatchComplete(t *testing.T) {
 					StartInclusive: "/callC",
 					EndExclusive:   "/d",
 				}},
+				Increments: []*proto.IncrementRequest{{
+					Key:   "/e",
+					Delta: 5,
+				}},
+				Appends:      []*proto.AppendRequest{},
+				MergePatches: []*proto.MergePatchRequest{},
 			}, request)
 			return item.response, item.err
 		}
@@ -161,7 +194,7 @@ func TestWriteBatchComplete(t *testing.T) {
 		batch := factory.newBatch(&shardId)
 
 		var wg sync.WaitGroup
-		wg.Add(3)
+		wg.Add(4)
 
 		var putResponse *proto.PutResponse
 		var putErr error
@@ -169,6 +202,8 @@ func TestWriteBatchComplete(t *testing.T) {
 		var deleteErr error
 		var deleteRangeResponse *proto.DeleteRangeResponse
 		var deleteRangeErr error
+		var incrementResponse *proto.IncrementResponse
+		var incrementErr error
 
 		putCallback := func(response *proto.PutResponse, err error) {
 			putResponse = response
@@ -185,6 +220,11 @@ func TestWriteBatchComplete(t *testing.T) {
 			deleteRangeErr = err
 			wg.Done()
 		}
+		incrementCallback := func(response *proto.IncrementResponse, err error) {
+			incrementResponse = response
+			incrementErr = err
+			wg.Done()
+		}
 
 		batch.Add(model.PutCall{
 			Key:               "/a",
@@ -202,7 +242,12 @@ func TestWriteBatchComplete(t *testing.T) {
 			MaxKeyExclusive: "/d",
 			Callback:        deleteRangeCallback,
 		})
-		assert.Equal(t, 3, batch.Size())
+		batch.Add(model.IncrementCall{
+			Key:      "/e",
+			Delta:    5,
+			Callback: incrementCallback,
+		})
+		assert.Equal(t, 4, batch.Size())
 
 		batch.Complete()
 
@@ -216,6 +261,9 @@ func TestWriteBatchComplete(t *testing.T) {
 
 		assert.Equal(t, item.expectedDeleteRangeResponse, deleteRangeResponse)
 		assert.ErrorIs(t, deleteRangeErr, item.expectedDeleteRangeErr)
+
+		assert.Equal(t, item.expectedIncrementResponse, incrementResponse)
+		assert.ErrorIs(t, incrementErr, item.expectedIncrementErr)
 	}
 }
 
@@ -22,6 +22,7 @@ func toShard(assignment *proto.ShardAssignment) Shard {
 	return Shard{
 		Id:        assignment.Shard,
 		Leader:    assignment.Leader,
+		Followers: assignment.Followers,
 		HashRange: toHashRange(assignment),
 	}
 }
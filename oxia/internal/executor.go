@@ -17,9 +17,14 @@ package internal
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 
+	"github.com/pkg/errors"
+
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/oxia-db/oxia/common/constant"
 	"github.com/oxia-db/oxia/common/rpc"
@@ -27,11 +32,25 @@ import (
 	"github.com/oxia-db/oxia/proto"
 )
 
+// ErrShardNotFound is returned when a request targets a shard that is not
+// present anymore in the shard assignments, eg: after the shard was split.
+// The request should be routed again to the new shard.
+var ErrShardNotFound = errors.New("oxia: shard not found")
+
+// ErrLeaderNotAvailable is returned when a request targets a shard whose leader
+// is not known yet, eg: a new shard that is still electing it. The request
+// can be attempted again.
+var ErrLeaderNotAvailable = status.Error(codes.Unavailable, "oxia: shard leader is not available")
+
 type Executor interface {
 	ExecuteWrite(ctx context.Context, request *proto.WriteRequest) (*proto.WriteResponse, error)
 	ExecuteRead(ctx context.Context, request *proto.ReadRequest) (proto.OxiaClient_ReadClient, error)
 	ExecuteList(ctx context.Context, request *proto.ListRequest) (proto.OxiaClient_ListClient, error)
 	ExecuteRangeScan(ctx context.Context, request *proto.RangeScanRequest) (proto.OxiaClient_RangeScanClient, error)
+	ExecuteRangeStats(ctx context.Context, request *proto.RangeStatsRequest) (*proto.RangeStatsResponse, error)
+	ExecutePrepareTransaction(ctx context.Context, request *proto.PrepareTransactionRequest) (*proto.TransactionResponse, error)
+	ExecuteCommitTransaction(ctx context.Context, request *proto.CommitTransactionRequest) (*proto.TransactionResponse, error)
+	ExecuteAbortTransaction(ctx context.Context, request *proto.AbortTransactionRequest) (*proto.TransactionResponse, error)
 }
 
 type executorImpl struct {
@@ -70,7 +89,7 @@ func (e *executorImpl) ExecuteWrite(ctx context.Context, request *proto.WriteReq
 }
 
 func (e *executorImpl) ExecuteRead(ctx context.Context, request *proto.ReadRequest) (proto.OxiaClient_ReadClient, error) {
-	client, err := e.rpc(request.Shard)
+	client, err := e.readRpc(request.Shard, request.MaxStalenessMs)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +98,7 @@ func (e *executorImpl) ExecuteRead(ctx context.Context, request *proto.ReadReque
 }
 
 func (e *executorImpl) ExecuteList(ctx context.Context, request *proto.ListRequest) (proto.OxiaClient_ListClient, error) {
-	client, err := e.rpc(request.Shard)
+	client, err := e.readRpc(request.Shard, request.MaxStalenessMs)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +107,7 @@ func (e *executorImpl) ExecuteList(ctx context.Context, request *proto.ListReque
 }
 
 func (e *executorImpl) ExecuteRangeScan(ctx context.Context, request *proto.RangeScanRequest) (proto.OxiaClient_RangeScanClient, error) {
-	client, err := e.rpc(request.Shard)
+	client, err := e.readRpc(request.Shard, request.MaxStalenessMs)
 	if err != nil {
 		return nil, err
 	}
@@ -96,10 +115,52 @@ func (e *executorImpl) ExecuteRangeScan(ctx context.Context, request *proto.Rang
 	return client.RangeScan(ctx, request)
 }
 
+func (e *executorImpl) ExecuteRangeStats(ctx context.Context, request *proto.RangeStatsRequest) (*proto.RangeStatsResponse, error) {
+	client, err := e.readRpc(request.Shard, request.MaxStalenessMs)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.RangeStats(ctx, request)
+}
+
+func (e *executorImpl) ExecutePrepareTransaction(ctx context.Context, request *proto.PrepareTransactionRequest) (*proto.TransactionResponse, error) {
+	client, err := e.rpc(&request.Shard)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.PrepareTransaction(ctx, request)
+}
+
+func (e *executorImpl) ExecuteCommitTransaction(ctx context.Context, request *proto.CommitTransactionRequest) (*proto.TransactionResponse, error) {
+	client, err := e.rpc(&request.Shard)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CommitTransaction(ctx, request)
+}
+
+func (e *executorImpl) ExecuteAbortTransaction(ctx context.Context, request *proto.AbortTransactionRequest) (*proto.TransactionResponse, error) {
+	client, err := e.rpc(&request.Shard)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.AbortTransaction(ctx, request)
+}
+
 func (e *executorImpl) rpc(shardId *int64) (proto.OxiaClientClient, error) {
 	var target string
 	if shardId != nil {
 		target = e.ShardManager.Leader(*shardId)
+		if target == "" {
+			if !slices.Contains(e.ShardManager.GetAll(), *shardId) {
+				return nil, ErrShardNotFound
+			}
+			return nil, ErrLeaderNotAvailable
+		}
 	} else {
 		target = e.ServiceAddress
 	}
@@ -111,6 +172,20 @@ func (e *executorImpl) rpc(shardId *int64) (proto.OxiaClientClient, error) {
 	return client, nil
 }
 
+// readRpc returns the client for a read request. The requests that tolerate
+// stale data are sent to any of the replicas of the shard.
+func (e *executorImpl) readRpc(shardId *int64, maxStalenessMs *uint64) (proto.OxiaClientClient, error) {
+	if shardId == nil || maxStalenessMs == nil {
+		return e.rpc(shardId)
+	}
+
+	target := e.ShardManager.Replica(*shardId)
+	if target == "" {
+		return e.rpc(shardId)
+	}
+	return e.ClientPool.GetClientRpc(target)
+}
+
 func (e *executorImpl) writeStream(shardId *int64) (*streamWrapper, error) {
 	e.RLock()
 
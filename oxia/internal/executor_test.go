@@ -0,0 +1,39 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExecutor_RpcWithoutLeader(t *testing.T) {
+	e := &executorImpl{ShardManager: &shardManagerImpl{shards: map[int64]Shard{
+		// A new shard that is still electing its leader
+		1: {Id: 1},
+	}}}
+
+	shardId := int64(1)
+	_, err := e.rpc(&shardId)
+	assert.ErrorIs(t, err, ErrLeaderNotAvailable)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	shardId = 0
+	_, err = e.rpc(&shardId)
+	assert.ErrorIs(t, err, ErrShardNotFound)
+}
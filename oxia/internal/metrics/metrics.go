@@ -92,6 +92,41 @@ func (m *Metrics) DecorateDeleteRange(deleteRange model.DeleteRangeCall) model.D
 	return deleteRange
 }
 
+func (m *Metrics) DecorateIncrement(increment model.IncrementCall) model.IncrementCall {
+	callback := increment.Callback
+	metricContext := m.metricContextFunc("increment")
+	increment.Callback = func(response *proto.IncrementResponse, err error) {
+		callback(response, err)
+		ctx, start, _attrs := metricContext(err)
+		m.opTime.Record(ctx, m.sinceFunc(start), _attrs)
+	}
+	return increment
+}
+
+func (m *Metrics) DecorateAppend(appendCall model.AppendCall) model.AppendCall {
+	callback := appendCall.Callback
+	metricContext := m.metricContextFunc("append")
+	appendCall.Callback = func(response *proto.AppendResponse, err error) {
+		callback(response, err)
+		ctx, start, _attrs := metricContext(err)
+		m.opTime.Record(ctx, m.sinceFunc(start), _attrs)
+		m.opValue.Record(ctx, int64(len(appendCall.Value)), _attrs)
+	}
+	return appendCall
+}
+
+func (m *Metrics) DecorateMergePatch(mergePatch model.MergePatchCall) model.MergePatchCall {
+	callback := mergePatch.Callback
+	metricContext := m.metricContextFunc("merge_patch")
+	mergePatch.Callback = func(response *proto.MergePatchResponse, err error) {
+		callback(response, err)
+		ctx, start, _attrs := metricContext(err)
+		m.opTime.Record(ctx, m.sinceFunc(start), _attrs)
+		m.opValue.Record(ctx, int64(len(mergePatch.Patch)), _attrs)
+	}
+	return mergePatch
+}
+
 func (m *Metrics) DecorateGet(get model.GetCall) model.GetCall {
 	callback := get.Callback
 	metricContext := m.metricContextFunc("get")
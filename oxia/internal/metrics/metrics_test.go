@@ -43,6 +43,18 @@ func TestMetricsDecorate(t *testing.T) {
 		metrics.DecorateDeleteRange(model.DeleteRangeCall{Callback: func(*proto.DeleteRangeResponse, error) {}}).
 			Callback(&proto.DeleteRangeResponse{}, err)
 	}
+	incrementFunc := func(metrics *Metrics, err error) {
+		metrics.DecorateIncrement(model.IncrementCall{Callback: func(*proto.IncrementResponse, error) {}}).
+			Callback(&proto.IncrementResponse{}, err)
+	}
+	appendFunc := func(metrics *Metrics, err error) {
+		metrics.DecorateAppend(model.AppendCall{Callback: func(*proto.AppendResponse, error) {}, Value: []byte{0, 1, 2, 3, 4}}).
+			Callback(&proto.AppendResponse{}, err)
+	}
+	mergePatchFunc := func(metrics *Metrics, err error) {
+		metrics.DecorateMergePatch(model.MergePatchCall{Callback: func(*proto.MergePatchResponse, error) {}, Patch: []byte{0, 1, 2, 3, 4}}).
+			Callback(&proto.MergePatchResponse{}, err)
+	}
 	getFunc := func(metrics *Metrics, err error) {
 		metrics.DecorateGet(model.GetCall{Callback: func(*proto.GetResponse, error) {}}).
 			Callback(&proto.GetResponse{Value: []byte{0, 1, 2, 3, 4}}, err)
@@ -56,6 +68,9 @@ func TestMetricsDecorate(t *testing.T) {
 		{putFunc, "put", true},
 		{deleteFunc, "delete", false},
 		{deleteRangeFunc, "delete_range", false},
+		{incrementFunc, "increment", false},
+		{appendFunc, "append", true},
+		{mergePatchFunc, "merge_patch", true},
 		{getFunc, "get", true},
 	} {
 		for _, condition := range []struct {
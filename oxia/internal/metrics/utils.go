@@ -73,7 +73,14 @@ func writeMetrics(request *proto.WriteRequest) (valueSize int64, requestCount in
 	for _, put := range request.Puts {
 		valueSize += int64(len(put.Value))
 	}
-	requestCount = int64(len(request.Puts) + len(request.Deletes) + len(request.DeleteRanges))
+	for _, appendReq := range request.Appends {
+		valueSize += int64(len(appendReq.Value))
+	}
+	for _, mergePatch := range request.MergePatches {
+		valueSize += int64(len(mergePatch.Patch))
+	}
+	requestCount = int64(len(request.Puts) + len(request.Deletes) + len(request.DeleteRanges) + len(request.Increments) +
+		len(request.Appends) + len(request.MergePatches))
 	return valueSize, requestCount
 }
 
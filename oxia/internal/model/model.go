@@ -19,20 +19,24 @@ import (
 )
 
 type PutCall struct {
-	Key                string
-	Value              []byte
-	ExpectedVersionId  *int64
-	SequenceKeysDeltas []uint64
-	SessionId          *int64
-	ClientIdentity     *string
-	PartitionKey       *string
-	SecondaryIndexes   []*proto.SecondaryIndex
-	Callback           func(*proto.PutResponse, error)
+	Key                  string
+	Value                []byte
+	ExpectedVersionId    *int64
+	ExpectedRecordExists *bool
+	ExpectedValueHash    []byte
+	SequenceKeysDeltas   []uint64
+	SessionId            *int64
+	ClientIdentity       *string
+	PartitionKey         *string
+	SecondaryIndexes     []*proto.SecondaryIndex
+	TtlMs                *uint64
+	Callback             func(*proto.PutResponse, error)
 }
 
 type DeleteCall struct {
 	Key               string
 	ExpectedVersionId *int64
+	ExpectedValueHash []byte
 	Callback          func(*proto.DeleteResponse, error)
 }
 
@@ -42,24 +46,52 @@ type DeleteRangeCall struct {
 	Callback        func(*proto.DeleteRangeResponse, error)
 }
 
+type IncrementCall struct {
+	Key          string
+	Delta        int64
+	MinValue     *int64
+	MaxValue     *int64
+	PartitionKey *string
+	Callback     func(*proto.IncrementResponse, error)
+}
+
+type AppendCall struct {
+	Key          string
+	Value        []byte
+	PartitionKey *string
+	Callback     func(*proto.AppendResponse, error)
+}
+
+type MergePatchCall struct {
+	Key          string
+	Patch        []byte
+	PartitionKey *string
+	Callback     func(*proto.MergePatchResponse, error)
+}
+
 type GetCall struct {
 	Key                string
 	ComparisonType     proto.KeyComparisonType
 	IncludeValue       bool
 	SecondaryIndexName *string
+	MaxStalenessMs     *uint64
+	Linearizable       bool
 	Callback           func(*proto.GetResponse, error)
 }
 
 func (r PutCall) ToProto() *proto.PutRequest {
 	return &proto.PutRequest{
-		Key:               r.Key,
-		Value:             r.Value,
-		ExpectedVersionId: r.ExpectedVersionId,
-		SessionId:         r.SessionId,
-		ClientIdentity:    r.ClientIdentity,
-		PartitionKey:      r.PartitionKey,
-		SequenceKeyDelta:  r.SequenceKeysDeltas,
-		SecondaryIndexes:  r.SecondaryIndexes,
+		Key:                  r.Key,
+		Value:                r.Value,
+		ExpectedVersionId:    r.ExpectedVersionId,
+		ExpectedRecordExists: r.ExpectedRecordExists,
+		ExpectedValueHash:    r.ExpectedValueHash,
+		SessionId:            r.SessionId,
+		ClientIdentity:       r.ClientIdentity,
+		PartitionKey:         r.PartitionKey,
+		SequenceKeyDelta:     r.SequenceKeysDeltas,
+		SecondaryIndexes:     r.SecondaryIndexes,
+		TtlMs:                r.TtlMs,
 	}
 }
 
@@ -67,6 +99,7 @@ func (r DeleteCall) ToProto() *proto.DeleteRequest {
 	return &proto.DeleteRequest{
 		Key:               r.Key,
 		ExpectedVersionId: r.ExpectedVersionId,
+		ExpectedValueHash: r.ExpectedValueHash,
 	}
 }
 
@@ -77,6 +110,32 @@ func (r DeleteRangeCall) ToProto() *proto.DeleteRangeRequest {
 	}
 }
 
+func (r IncrementCall) ToProto() *proto.IncrementRequest {
+	return &proto.IncrementRequest{
+		Key:          r.Key,
+		Delta:        r.Delta,
+		MinValue:     r.MinValue,
+		MaxValue:     r.MaxValue,
+		PartitionKey: r.PartitionKey,
+	}
+}
+
+func (r AppendCall) ToProto() *proto.AppendRequest {
+	return &proto.AppendRequest{
+		Key:          r.Key,
+		Value:        r.Value,
+		PartitionKey: r.PartitionKey,
+	}
+}
+
+func (r MergePatchCall) ToProto() *proto.MergePatchRequest {
+	return &proto.MergePatchRequest{
+		Key:          r.Key,
+		Patch:        r.Patch,
+		PartitionKey: r.PartitionKey,
+	}
+}
+
 func (r GetCall) ToProto() *proto.GetRequest {
 	return &proto.GetRequest{
 		Key:                r.Key,
@@ -0,0 +1,407 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/process"
+	"github.com/oxia-db/oxia/common/rpc"
+	time2 "github.com/oxia-db/oxia/common/time"
+
+	"github.com/oxia-db/oxia/proto"
+)
+
+// ShardAssignments receives the shard assignments of one or more namespaces
+// over a single stream.
+type ShardAssignments interface {
+	io.Closer
+
+	// ShardManager returns the shard manager of the namespace, and starts
+	// receiving the assignments of the namespace if it's not already the case.
+	ShardManager(namespace string) (ShardManager, error)
+
+	// Release stops receiving the assignments of the namespace, once all the
+	// users of its shard manager have released it.
+	Release(namespace string)
+}
+
+type shardAssignmentsImpl struct {
+	sync.Mutex
+	// Serializes the registration of the namespaces
+	registerMutex sync.Mutex
+
+	shardStrategy  ShardStrategy
+	clientPool     rpc.ClientPool
+	serviceAddress string
+	requestTimeout time.Duration
+
+	// The namespaces in the order in which they were registered
+	namespaces []string
+	managers   map[string]*shardManagerImpl
+	// The number of users of each shard manager
+	refs         map[string]int
+	started      bool
+	streamCancel context.CancelFunc
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	logger *slog.Logger
+}
+
+func NewShardAssignments(shardStrategy ShardStrategy, clientPool rpc.ClientPool,
+	serviceAddress string, requestTimeout time.Duration) ShardAssignments {
+	return newShardAssignments(shardStrategy, clientPool, serviceAddress, requestTimeout)
+}
+
+func newShardAssignments(shardStrategy ShardStrategy, clientPool rpc.ClientPool,
+	serviceAddress string, requestTimeout time.Duration) *shardAssignmentsImpl {
+	s := &shardAssignmentsImpl{
+		shardStrategy:  shardStrategy,
+		clientPool:     clientPool,
+		serviceAddress: serviceAddress,
+		requestTimeout: requestTimeout,
+		managers:       make(map[string]*shardManagerImpl),
+		refs:           make(map[string]int),
+		logger: slog.With(
+			slog.String("component", "shardManager"),
+		),
+	}
+
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	return s
+}
+
+func (s *shardAssignmentsImpl) Close() error {
+	s.cancel()
+	return nil
+}
+
+func (s *shardAssignmentsImpl) ShardManager(namespace string) (ShardManager, error) {
+	sm, err := s.shardManager(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+func (s *shardAssignmentsImpl) shardManager(namespace string) (*shardManagerImpl, error) {
+	s.registerMutex.Lock()
+	defer s.registerMutex.Unlock()
+
+	s.Lock()
+	if sm, ok := s.managers[namespace]; ok {
+		s.refs[namespace]++
+		s.Unlock()
+		return sm, nil
+	}
+	first := !s.started
+	s.Unlock()
+
+	sm := newShardManager(s.shardStrategy, namespace)
+	if first {
+		if err := s.start(sm); err != nil {
+			return nil, err
+		}
+		return sm, nil
+	}
+
+	// Validate the namespace and get its current assignments before adding it
+	// to the stream, so that the other namespaces are not affected if it does
+	// not exist
+	shards, err := s.fetch(namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "oxia: failed to retrieve the shard assignments of namespace %s", namespace)
+	}
+	sm.update(shards)
+
+	s.Lock()
+	defer s.Unlock()
+	s.namespaces = append(s.namespaces, namespace)
+	s.managers[namespace] = sm
+	s.refs[namespace] = 1
+	if s.streamCancel != nil {
+		// Restart the stream to receive the updates of the new namespace too
+		s.streamCancel()
+	}
+	return sm, nil
+}
+
+func (s *shardAssignmentsImpl) Release(namespace string) {
+	s.registerMutex.Lock()
+	defer s.registerMutex.Unlock()
+
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.managers[namespace]; !ok {
+		return
+	}
+	s.refs[namespace]--
+	if s.refs[namespace] > 0 {
+		return
+	}
+	s.logger.Debug(
+		"Stop receiving the shard assignments of the namespace",
+		slog.String("namespace", namespace),
+	)
+	s.removeNamespace(namespace)
+}
+
+// removeNamespace stops receiving the assignments of the namespace, and
+// restarts the stream with the remaining namespaces.
+func (s *shardAssignmentsImpl) removeNamespace(namespace string) {
+	s.namespaces = slices.DeleteFunc(s.namespaces, func(ns string) bool { return ns == namespace })
+	delete(s.managers, namespace)
+	delete(s.refs, namespace)
+	if s.streamCancel != nil {
+		s.streamCancel()
+	}
+}
+
+func (s *shardAssignmentsImpl) start(sm *shardManagerImpl) error {
+	s.Lock()
+	s.started = true
+	s.namespaces = append(s.namespaces, sm.namespace)
+	s.managers[sm.namespace] = sm
+	s.refs[sm.namespace] = 1
+	s.Unlock()
+
+	go process.DoWithLabels(
+		s.ctx,
+		map[string]string{
+			"oxia": "receive-shard-updates",
+		},
+		s.receiveWithRecovery,
+	)
+
+	ctx, cancel := context.WithTimeout(s.ctx, s.requestTimeout)
+	defer cancel()
+
+	if err := sm.updatedWg.Wait(ctx); err != nil {
+		return errors.Wrap(err, "oxia: failed to retrieve the initial list of shard assignments")
+	}
+	return nil
+}
+
+func (s *shardAssignmentsImpl) fetch(namespace string) ([]Shard, error) {
+	client, err := s.clientPool.GetClientRpc(s.serviceAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, s.requestTimeout)
+	defer cancel()
+
+	stream, err := client.GetShardAssignments(ctx, &proto.ShardAssignmentsRequest{Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+	response, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return toShards(response, namespace)
+}
+
+func (s *shardAssignmentsImpl) isClosed() bool {
+	return s.ctx.Err() != nil
+}
+
+func (s *shardAssignmentsImpl) receiveWithRecovery() {
+	backOff := time2.NewBackOff(s.ctx)
+	err := backoff.RetryNotify(
+		func() error {
+			err := s.receive(backOff)
+			if s.isClosed() {
+				s.logger.Debug(
+					"Closed",
+					slog.Any("error", err),
+				)
+				return nil
+			}
+
+			if !isErrorRetryable(err) {
+				return backoff.Permanent(err)
+			}
+			return err
+		},
+		backOff,
+		func(err error, duration time.Duration) {
+			if status.Code(err) != codes.Canceled {
+				s.logger.Warn(
+					"Failed receiving shard assignments, retrying later",
+					slog.Any("error", err),
+					slog.Duration("retry-after", duration),
+				)
+			}
+		},
+	)
+	if err != nil {
+		s.logger.Error(
+			"Failed receiving shard assignments",
+			slog.Any("error", err),
+		)
+
+		s.Lock()
+		defer s.Unlock()
+		for _, sm := range s.managers {
+			sm.updatedWg.Fail(err)
+		}
+	}
+}
+
+func (s *shardAssignmentsImpl) receive(backOff backoff.BackOff) error {
+	for {
+		ctx, namespaces := s.newStream()
+		if len(namespaces) == 0 {
+			// All the namespaces were released, wait for a new one
+			<-ctx.Done()
+			if s.isClosed() {
+				return nil
+			}
+			continue
+		}
+
+		err := s.receiveStream(ctx, namespaces, backOff)
+		if ctx.Err() != nil && !s.isClosed() {
+			// The stream was restarted to add or remove a namespace
+			continue
+		}
+		if status.Code(err) == constant.CodeNamespaceNotFound && len(namespaces) > 1 &&
+			s.removeMissingNamespaces(namespaces) {
+			// Only some of the namespaces do not exist anymore, keep receiving
+			// the assignments of the others
+			continue
+		}
+		return err
+	}
+}
+
+// removeMissingNamespaces checks one by one the namespaces of a stream that
+// was rejected, and removes the ones that do not exist anymore.
+func (s *shardAssignmentsImpl) removeMissingNamespaces(namespaces []string) bool {
+	removed := false
+	for _, namespace := range namespaces {
+		if _, err := s.fetch(namespace); status.Code(err) == constant.CodeNamespaceNotFound {
+			s.namespaceNotFound(namespace)
+			removed = true
+		}
+	}
+	return removed
+}
+
+// namespaceNotFound removes a namespace that was deleted, without affecting
+// the other namespaces of the stream. The users of its shard manager will get
+// the errors from the servers.
+func (s *shardAssignmentsImpl) namespaceNotFound(namespace string) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.managers[namespace]; !ok {
+		return
+	}
+
+	s.logger.Warn(
+		"Namespace not found in the shard assignments",
+		slog.String("namespace", namespace),
+	)
+	s.removeNamespace(namespace)
+}
+
+func (s *shardAssignmentsImpl) newStream() (context.Context, []string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.streamCancel != nil {
+		s.streamCancel()
+	}
+	var ctx context.Context
+	ctx, s.streamCancel = context.WithCancel(s.ctx)
+	return ctx, slices.Clone(s.namespaces)
+}
+
+func (s *shardAssignmentsImpl) receiveStream(ctx context.Context, namespaces []string, backOff backoff.BackOff) error {
+	client, err := s.clientPool.GetClientRpc(s.serviceAddress)
+	if err != nil {
+		return err
+	}
+
+	request := proto.ShardAssignmentsRequest{
+		Namespace:            namespaces[0],
+		AdditionalNamespaces: namespaces[1:],
+	}
+
+	stream, err := client.GetShardAssignments(ctx, &request)
+	if err != nil {
+		return err
+	}
+
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		for _, namespace := range namespaces {
+			shards, err := toShards(response, namespace)
+			if err != nil {
+				s.namespaceNotFound(namespace)
+				continue
+			}
+			if sm := s.manager(namespace); sm != nil {
+				sm.update(shards)
+			}
+		}
+		backOff.Reset()
+	}
+}
+
+func (s *shardAssignmentsImpl) manager(namespace string) *shardManagerImpl {
+	s.Lock()
+	defer s.Unlock()
+	return s.managers[namespace]
+}
+
+func toShards(response *proto.ShardAssignments, namespace string) ([]Shard, error) {
+	assignments, ok := response.Namespaces[namespace]
+	if !ok {
+		return nil, constant.ErrNamespaceNotFound
+	}
+
+	shards := make([]Shard, len(assignments.Assignments))
+	for i, assignment := range assignments.Assignments {
+		shards[i] = toShard(assignment)
+	}
+	return shards, nil
+}
+
+func isErrorRetryable(err error) bool {
+	switch status.Code(err) {
+	case constant.CodeNamespaceNotFound, codes.Unauthenticated:
+		return false
+	default:
+		return true
+	}
+}
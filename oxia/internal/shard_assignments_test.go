@@ -0,0 +1,183 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/rpc"
+	"github.com/oxia-db/oxia/proto"
+	"github.com/oxia-db/oxia/server/auth"
+)
+
+// testAssignmentsServer streams the assignments of the namespaces it knows,
+// like the assignment dispatcher of the servers.
+type testAssignmentsServer struct {
+	proto.UnimplementedOxiaClientServer
+	sync.Mutex
+
+	leaders map[string]string
+	changed chan struct{}
+}
+
+func newTestAssignmentsServer(t *testing.T, leaders map[string]string) (*testAssignmentsServer, string) {
+	t.Helper()
+	s := &testAssignmentsServer{
+		leaders: leaders,
+		changed: make(chan struct{}),
+	}
+	server, err := rpc.Default.StartGrpcServer("test", "localhost:0", func(registrar grpc.ServiceRegistrar) {
+		proto.RegisterOxiaClientServer(registrar, s)
+	}, nil, &auth.Options{})
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+	return s, fmt.Sprintf("localhost:%d", server.Port())
+}
+
+func (s *testAssignmentsServer) setLeader(namespace string, leader string) {
+	s.Lock()
+	defer s.Unlock()
+	if leader == "" {
+		delete(s.leaders, namespace)
+	} else {
+		s.leaders[namespace] = leader
+	}
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+func (s *testAssignmentsServer) GetShardAssignments(req *proto.ShardAssignmentsRequest,
+	stream proto.OxiaClient_GetShardAssignmentsServer) error {
+	namespaces := append([]string{req.Namespace}, req.AdditionalNamespaces...)
+
+	s.Lock()
+	for _, namespace := range namespaces {
+		if _, ok := s.leaders[namespace]; !ok {
+			s.Unlock()
+			return constant.ErrNamespaceNotFound
+		}
+	}
+	s.Unlock()
+
+	for {
+		s.Lock()
+		response := &proto.ShardAssignments{Namespaces: map[string]*proto.NamespaceShardsAssignment{}}
+		for _, namespace := range namespaces {
+			if leader, ok := s.leaders[namespace]; ok {
+				response.Namespaces[namespace] = &proto.NamespaceShardsAssignment{
+					Assignments: []*proto.ShardAssignment{{
+						Shard:  0,
+						Leader: leader,
+						ShardBoundaries: &proto.ShardAssignment_Int32HashRange{
+							Int32HashRange: &proto.Int32HashRange{MinHashInclusive: 0, MaxHashInclusive: 0xffffffff},
+						},
+					}},
+					ShardKeyRouter: proto.ShardKeyRouter_XXHASH3,
+				}
+			}
+		}
+		changed := s.changed
+		s.Unlock()
+
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (s *shardAssignmentsImpl) registeredNamespaces() []string {
+	s.Lock()
+	defer s.Unlock()
+	return slices.Clone(s.namespaces)
+}
+
+func TestShardAssignments_NamespaceDeleted(t *testing.T) {
+	server, address := newTestAssignmentsServer(t, map[string]string{"ns-1": "server-1", "ns-2": "server-1"})
+
+	clientPool := rpc.NewClientPool(nil, nil)
+	defer clientPool.Close()
+	sa := newShardAssignments(&testShardStrategy{}, clientPool, address, 30*time.Second)
+	defer sa.Close()
+
+	sm1, err := sa.ShardManager("ns-1")
+	assert.NoError(t, err)
+	sm2, err := sa.ShardManager("ns-2")
+	assert.NoError(t, err)
+	assert.Equal(t, "server-1", sm2.Leader(0))
+
+	_, err = sa.ShardManager("ns-3")
+	assert.ErrorIs(t, err, constant.ErrNamespaceNotFound)
+
+	// The deleted namespace is removed, without affecting the other one
+	server.setLeader("ns-2", "")
+	assert.Eventually(t, func() bool {
+		return slices.Equal(sa.registeredNamespaces(), []string{"ns-1"})
+	}, 10*time.Second, 10*time.Millisecond)
+
+	server.setLeader("ns-1", "server-2")
+	assert.Eventually(t, func() bool {
+		return sm1.Leader(0) == "server-2"
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestShardAssignments_Release(t *testing.T) {
+	server, address := newTestAssignmentsServer(t, map[string]string{"ns-1": "server-1", "ns-2": "server-1"})
+
+	clientPool := rpc.NewClientPool(nil, nil)
+	defer clientPool.Close()
+	sa := newShardAssignments(&testShardStrategy{}, clientPool, address, 30*time.Second)
+	defer sa.Close()
+
+	sm1, err := sa.ShardManager("ns-1")
+	assert.NoError(t, err)
+	_, err = sa.ShardManager("ns-2")
+	assert.NoError(t, err)
+	_, err = sa.ShardManager("ns-2")
+	assert.NoError(t, err)
+
+	// The namespace is kept until all the users of its shard manager release it
+	sa.Release("ns-2")
+	assert.Equal(t, []string{"ns-1", "ns-2"}, sa.registeredNamespaces())
+	sa.Release("ns-2")
+	assert.Equal(t, []string{"ns-1"}, sa.registeredNamespaces())
+
+	// Deleting the released namespace does not affect the stream
+	server.setLeader("ns-2", "")
+	server.setLeader("ns-1", "server-2")
+	assert.Eventually(t, func() bool {
+		return sm1.Leader(0) == "server-2"
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// A released namespace can be registered again
+	server.setLeader("ns-2", "server-3")
+	sm2, err := sa.ShardManager("ns-2")
+	assert.NoError(t, err)
+	assert.Equal(t, "server-3", sm2.Leader(0))
+}
@@ -15,95 +15,94 @@
 package internal
 
 import (
-	"context"
 	"io"
 	"log/slog"
+	"math/rand/v2"
+	"slices"
 	"sync"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
-	"github.com/pkg/errors"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"go.uber.org/multierr"
 
 	"github.com/oxia-db/oxia/common/concurrent"
-	"github.com/oxia-db/oxia/common/constant"
-	"github.com/oxia-db/oxia/common/process"
 	"github.com/oxia-db/oxia/common/rpc"
-	time2 "github.com/oxia-db/oxia/common/time"
-
-	"github.com/oxia-db/oxia/proto"
 )
 
 type ShardManager interface {
 	io.Closer
 	Get(key string) int64
 	GetAll() []int64
+
+	// Leader returns the address of the leader of the shard, or an empty
+	// string if the shard does not exist anymore (eg: it was split).
 	Leader(shardId int64) string
+
+	// Replica returns the address of a random replica of the shard, either
+	// the leader or one of the followers, to spread the reads that tolerate
+	// stale data.
+	Replica(shardId int64) string
+
+	// ShardsChanged returns a channel that is closed when the list of shards
+	// changes (eg: after a split or a merge).
+	ShardsChanged() <-chan struct{}
+
+	// ReplacedBy returns the shards that took over the hash range of a shard
+	// that does not exist anymore (eg: the children of a split shard), or nil
+	// if the shard was not replaced.
+	ReplacedBy(shardId int64) []int64
 }
 
 type shardManagerImpl struct {
 	sync.RWMutex
 	updatedWg concurrent.WaitGroup
 
-	shardStrategy  ShardStrategy
-	clientPool     rpc.ClientPool
-	serviceAddress string
-	namespace      string
-	shards         map[int64]Shard
-	ctx            context.Context
-	cancel         context.CancelFunc
-	logger         *slog.Logger
-	requestTimeout time.Duration
+	shardStrategy ShardStrategy
+	namespace     string
+	shards        map[int64]Shard
+	replaced      map[int64]HashRange
+	shardsChanged chan struct{}
+	logger        *slog.Logger
+
+	// The assignments stream, when it's owned by this shard manager
+	ownedAssignments io.Closer
 }
 
+// NewShardManager creates a shard manager for the namespace, with its own
+// stream of shard assignments.
 func NewShardManager(shardStrategy ShardStrategy, clientPool rpc.ClientPool,
 	serviceAddress string, namespace string, requestTimeout time.Duration) (ShardManager, error) {
-	sm := &shardManagerImpl{
-		namespace:      namespace,
-		shardStrategy:  shardStrategy,
-		clientPool:     clientPool,
-		serviceAddress: serviceAddress,
-		shards:         make(map[int64]Shard),
-		requestTimeout: requestTimeout,
-		logger: slog.With(
-			slog.String("component", "shardManager"),
-		),
+	assignments := newShardAssignments(shardStrategy, clientPool, serviceAddress, requestTimeout)
+	sm, err := assignments.shardManager(namespace)
+	if err != nil {
+		return nil, multierr.Append(err, assignments.Close())
 	}
 
-	sm.updatedWg = concurrent.NewWaitGroup(1)
-	sm.ctx, sm.cancel = context.WithCancel(context.Background())
+	sm.ownedAssignments = assignments
+	return sm, nil
+}
 
-	if err := sm.start(); err != nil {
-		return nil, errors.Wrap(err, "oxia: failed to retrieve the initial list of shard assignments")
+func newShardManager(shardStrategy ShardStrategy, namespace string) *shardManagerImpl {
+	return &shardManagerImpl{
+		updatedWg:     concurrent.NewWaitGroup(1),
+		shardStrategy: shardStrategy,
+		namespace:     namespace,
+		shards:        make(map[int64]Shard),
+		replaced:      make(map[int64]HashRange),
+		shardsChanged: make(chan struct{}),
+		logger: slog.With(
+			slog.String("component", "shardManager"),
+			slog.String("namespace", namespace),
+		),
 	}
-
-	return sm, nil
 }
 
 func (s *shardManagerImpl) Close() error {
-	s.cancel()
+	if s.ownedAssignments != nil {
+		return s.ownedAssignments.Close()
+	}
 	return nil
 }
 
-func (s *shardManagerImpl) start() error {
-	s.Lock()
-
-	go process.DoWithLabels(
-		s.ctx,
-		map[string]string{
-			"oxia": "receive-shard-updates",
-		},
-		s.receiveWithRecovery,
-	)
-
-	ctx, cancel := context.WithTimeout(s.ctx, s.requestTimeout)
-	defer cancel()
-
-	s.Unlock()
-	return s.updatedWg.Wait(ctx)
-}
-
 func (s *shardManagerImpl) Get(key string) int64 {
 	s.RLock()
 	defer s.RUnlock()
@@ -136,90 +135,63 @@ func (s *shardManagerImpl) Leader(shardId int64) string {
 	if shard, ok := s.shards[shardId]; ok {
 		return shard.Leader
 	}
-	panic("shard not found")
+	return ""
 }
 
-func (s *shardManagerImpl) isClosed() bool {
-	return s.ctx.Err() != nil
-}
+func (s *shardManagerImpl) Replica(shardId int64) string {
+	s.RLock()
+	defer s.RUnlock()
 
-func (s *shardManagerImpl) receiveWithRecovery() {
-	backOff := time2.NewBackOff(s.ctx)
-	err := backoff.RetryNotify(
-		func() error {
-			err := s.receive(backOff)
-			if s.isClosed() {
-				s.logger.Debug(
-					"Closed",
-					slog.Any("error", err),
-				)
-				return nil
-			}
+	shard, ok := s.shards[shardId]
+	if !ok {
+		return ""
+	}
+	if shard.Leader == "" || len(shard.Followers) == 0 {
+		return shard.Leader
+	}
 
-			if !isErrorRetryable(err) {
-				return backoff.Permanent(err)
-			}
-			return err
-		},
-		backOff,
-		func(err error, duration time.Duration) {
-			if status.Code(err) != codes.Canceled {
-				s.logger.Warn(
-					"Failed receiving shard assignments, retrying later",
-					slog.Any("error", err),
-					slog.Duration("retry-after", duration),
-				)
-			}
-		},
-	)
-	if err != nil {
-		s.logger.Error(
-			"Failed receiving shard assignments",
-			slog.Any("error", err),
-		)
-		s.updatedWg.Fail(err)
+	idx := rand.IntN(len(shard.Followers) + 1)
+	if idx == len(shard.Followers) {
+		return shard.Leader
 	}
+	return shard.Followers[idx]
 }
 
-func (s *shardManagerImpl) receive(backOff backoff.BackOff) error {
-	client, err := s.clientPool.GetClientRpc(s.serviceAddress)
-	if err != nil {
-		return err
-	}
+func (s *shardManagerImpl) ShardsChanged() <-chan struct{} {
+	s.RLock()
+	defer s.RUnlock()
+	return s.shardsChanged
+}
 
-	request := proto.ShardAssignmentsRequest{Namespace: s.namespace}
+func (s *shardManagerImpl) ReplacedBy(shardId int64) []int64 {
+	s.RLock()
+	defer s.RUnlock()
 
-	stream, err := client.GetShardAssignments(s.ctx, &request)
-	if err != nil {
-		return err
+	hashRange, ok := s.replaced[shardId]
+	if !ok {
+		return nil
 	}
 
-	for {
-		response, err := stream.Recv()
-		if err != nil {
-			return err
+	// The hash range is kept, rather than the shards that replaced it, since
+	// the children of a split are not added at once
+	var shardIDs []int64
+	for id, shard := range s.shards {
+		if overlap(hashRange, shard.HashRange) {
+			shardIDs = append(shardIDs, id)
 		}
-
-		assignments, ok := response.Namespaces[s.namespace]
-		if !ok {
-			return errors.New("namespace not found in shards assignments")
-		}
-
-		shards := make([]Shard, len(assignments.Assignments))
-		for i, assignment := range assignments.Assignments {
-			shards[i] = toShard(assignment)
-		}
-		s.update(shards)
-		backOff.Reset()
 	}
+	slices.Sort(shardIDs)
+	return shardIDs
 }
 
 func (s *shardManagerImpl) update(updates []Shard) {
 	s.Lock()
 	defer s.Unlock()
 
+	changed := false
 	for _, update := range updates {
 		if _, ok := s.shards[update.Id]; !ok {
+			changed = true
 			// delete overlaps
 			for shardId, existing := range s.shards {
 				if overlap(update.HashRange, existing.HashRange) {
@@ -229,24 +201,20 @@ func (s *shardManagerImpl) update(updates []Shard) {
 						slog.Any("Update", update),
 					)
 					delete(s.shards, shardId)
+					s.replaced[shardId] = existing.HashRange
 				}
 			}
 		}
 		s.shards[update.Id] = update
 	}
 
+	if changed {
+		close(s.shardsChanged)
+		s.shardsChanged = make(chan struct{})
+	}
 	s.updatedWg.Done()
 }
 
 func overlap(a HashRange, b HashRange) bool {
 	return a.MinInclusive <= b.MaxInclusive && a.MaxInclusive >= b.MinInclusive
 }
-
-func isErrorRetryable(err error) bool {
-	switch status.Code(err) {
-	case constant.CodeNamespaceNotFound, codes.Unauthenticated:
-		return false
-	default:
-		return true
-	}
-}
@@ -69,3 +69,63 @@ func TestOverlap(t *testing.T) {
 		assert.Equal(t, overlap(item.a, item.b), item.isOverlap)
 	}
 }
+
+func TestReplica(t *testing.T) {
+	sm := &shardManagerImpl{shards: map[int64]Shard{
+		0: {Id: 0, Leader: "l0"},
+		1: {Id: 1, Leader: "l1", Followers: []string{"f1", "f2"}},
+	}}
+
+	assert.Equal(t, "l0", sm.Replica(0))
+	assert.Equal(t, "", sm.Replica(5))
+
+	replicas := map[string]bool{}
+	for i := 0; i < 1000; i++ {
+		replicas[sm.Replica(1)] = true
+	}
+	assert.Equal(t, map[string]bool{"l1": true, "f1": true, "f2": true}, replicas)
+}
+
+func TestShardsChanged(t *testing.T) {
+	sm := newShardManager(&testShardStrategy{}, constant.DefaultNamespace)
+	sm.update([]Shard{{Id: 0, Leader: "l0", HashRange: hashRange(0, 9)}})
+
+	changed := sm.ShardsChanged()
+	sm.update([]Shard{{Id: 0, Leader: "l1", HashRange: hashRange(0, 9)}})
+	select {
+	case <-changed:
+		assert.Fail(t, "a new leader does not change the shards")
+	default:
+	}
+
+	// The shard is split
+	sm.update([]Shard{
+		{Id: 1, Leader: "l1", HashRange: hashRange(0, 4)},
+		{Id: 2, Leader: "l2", HashRange: hashRange(5, 9)},
+	})
+	select {
+	case <-changed:
+	default:
+		assert.Fail(t, "the split changes the shards")
+	}
+	assert.ElementsMatch(t, []int64{1, 2}, sm.GetAll())
+	assert.NotEqual(t, changed, sm.ShardsChanged())
+}
+
+func TestReplacedBy(t *testing.T) {
+	sm := newShardManager(&testShardStrategy{}, constant.DefaultNamespace)
+	sm.update([]Shard{{Id: 0, Leader: "l0", HashRange: hashRange(0, 9)}})
+	assert.Nil(t, sm.ReplacedBy(0))
+
+	// The children of the split are added one at a time
+	sm.update([]Shard{{Id: 1, Leader: "l1", HashRange: hashRange(0, 4)}})
+	assert.Equal(t, []int64{1}, sm.ReplacedBy(0))
+
+	sm.update([]Shard{
+		{Id: 1, Leader: "l1", HashRange: hashRange(0, 4)},
+		{Id: 2, Leader: "l2", HashRange: hashRange(5, 9)},
+	})
+	assert.Equal(t, []int64{1, 2}, sm.ReplacedBy(0))
+	assert.Nil(t, sm.ReplacedBy(1))
+	assert.Nil(t, sm.ReplacedBy(5))
+}
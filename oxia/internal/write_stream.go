@@ -45,10 +45,6 @@ func newStreamWrapper(shard int64, stream proto.OxiaClient_WriteStreamClient) *s
 		"oxia":  "write-stream-handle-response",
 		"shard": fmt.Sprintf("%d", shard),
 	}, sw.handleResponses)
-	go process.DoWithLabels(stream.Context(), map[string]string{
-		"oxia":  "write-stream-handle-stream-closed",
-		"shard": fmt.Sprintf("%d", shard),
-	}, sw.handleStreamClosed)
 	return sw
 }
 
@@ -56,6 +52,11 @@ func (sw *streamWrapper) Send(ctx context.Context, req *proto.WriteRequest) (*pr
 	f := concurrent.NewFuture[*proto.WriteResponse]()
 
 	sw.Lock()
+	if sw.failed.Load() {
+		sw.Unlock()
+		return nil, io.EOF
+	}
+
 	sw.pendingRequests = append(sw.pendingRequests, f)
 	if err := sw.stream.Send(req); err != nil {
 		sw.failed.Store(true)
@@ -68,26 +69,19 @@ func (sw *streamWrapper) Send(ctx context.Context, req *proto.WriteRequest) (*pr
 	return f.Wait(ctx)
 }
 
-func (sw *streamWrapper) handleStreamClosed() {
-	<-sw.stream.Context().Done()
-
-	// Fail all pending requests
-	sw.Lock()
-	defer sw.Unlock()
-
-	for _, f := range sw.pendingRequests {
-		f.Fail(io.EOF)
-	}
-	sw.pendingRequests = nil
-	sw.failed.Store(true)
-}
-
 func (sw *streamWrapper) handleResponses() {
 	for {
 		response, err := sw.stream.Recv()
 		sw.Lock()
 
 		if err != nil {
+			// Fail all pending requests with the error that closed the stream,
+			// so that they can be retried when the error is transient (eg: the
+			// leadership is being transferred)
+			for _, f := range sw.pendingRequests {
+				f.Fail(err)
+			}
+			sw.pendingRequests = nil
 			sw.failed.Store(true)
 			sw.Unlock()
 			return
@@ -20,6 +20,9 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -34,6 +37,7 @@ import (
 )
 
 type notifications struct {
+	options      *notificationsOptions
 	multiplexCh  chan *Notification
 	closeCh      chan any
 	shardManager internal.ShardManager
@@ -45,25 +49,44 @@ type notifications struct {
 
 	ctxMultiplexChanClosed    context.Context
 	cancelMultiplexChanClosed context.CancelFunc
+
+	// Serializes the commits, so that the checkpoints are saved in order
+	commitLock       sync.Mutex
+	committedOffsets map[int64]int64
 }
 
-func newNotifications(ctx context.Context, options clientOptions, clientPool rpc.ClientPool, shardManager internal.ShardManager) (*notifications, error) {
+func newNotifications(ctx context.Context, options clientOptions, notificationsOptions *notificationsOptions,
+	clientPool rpc.ClientPool, shardManager internal.ShardManager) (*notifications, error) {
 	nm := &notifications{
+		options:      notificationsOptions,
 		multiplexCh:  make(chan *Notification, 100),
 		closeCh:      make(chan any),
 		shardManager: shardManager,
 		clientPool:   clientPool,
 	}
 
+	startOffsets, err := loadStartOffsets(ctx, options, notificationsOptions)
+	if err != nil {
+		return nil, err
+	}
+	nm.committedOffsets = startOffsets
+
 	nm.ctx, nm.cancel = context.WithCancel(ctx)
 	nm.ctxMultiplexChanClosed, nm.cancelMultiplexChanClosed = context.WithCancel(context.Background())
 
 	// Create a notification manager for each shard
 	shards := shardManager.GetAll()
+	if notificationsOptions.shards != nil {
+		shards = notificationsOptions.shards
+	}
 	nm.initWaitGroup = concurrent.NewWaitGroup(len(shards))
 
 	for _, shard := range shards {
-		newShardNotificationsManager(shard, nm)
+		var startOffsetExclusive *int64
+		if offset, ok := startOffsets[shard]; ok {
+			startOffsetExclusive = &offset
+		}
+		newShardNotificationsManager(shard, nm, startOffsetExclusive)
 	}
 
 	go process.DoWithLabels(
@@ -94,10 +117,64 @@ func newNotifications(ctx context.Context, options clientOptions, clientPool rpc
 	return nm, nil
 }
 
+// loadStartOffsets merges the offsets saved in the checkpoint store with the
+// explicit ones, which take precedence.
+func loadStartOffsets(ctx context.Context, options clientOptions, notificationsOptions *notificationsOptions) (map[int64]int64, error) {
+	startOffsets := map[int64]int64{}
+	if notificationsOptions.checkpointStore != nil {
+		timeoutCtx, cancel := context.WithTimeout(ctx, options.requestTimeout)
+		defer cancel()
+
+		saved, err := notificationsOptions.checkpointStore.Load(timeoutCtx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the notifications checkpoint: %w", err)
+		}
+		maps.Copy(startOffsets, saved)
+	}
+	maps.Copy(startOffsets, notificationsOptions.startOffsets)
+	return startOffsets, nil
+}
+
 func (nm *notifications) Ch() <-chan *Notification {
 	return nm.multiplexCh
 }
 
+// processedOffset returns the offset of the last change that is fully
+// processed once the notification is.
+func processedOffset(notification *Notification) int64 {
+	if !notification.lastInBatch {
+		// The other notifications of the same change are not processed yet,
+		// so they must be delivered again when resuming
+		return notification.Offset - 1
+	}
+	return notification.Offset
+}
+
+// startPositions returns the offsets from which the notifications of each
+// shard are delivered, once the subscription is initialized.
+func (nm *notifications) startPositions() map[int64]int64 {
+	nm.commitLock.Lock()
+	defer nm.commitLock.Unlock()
+	return maps.Clone(nm.committedOffsets)
+}
+
+func (nm *notifications) Commit(ctx context.Context, notification *Notification) error {
+	offset := processedOffset(notification)
+
+	nm.commitLock.Lock()
+	defer nm.commitLock.Unlock()
+
+	if committed, ok := nm.committedOffsets[notification.Shard]; ok && committed >= offset {
+		return nil
+	}
+	nm.committedOffsets[notification.Shard] = offset
+
+	if nm.options.checkpointStore == nil {
+		return nil
+	}
+	return nm.options.checkpointStore.Save(ctx, maps.Clone(nm.committedOffsets))
+}
+
 func (nm *notifications) Close() error {
 	// Interrupt the go-routines receiving notifications on all the shards
 	nm.cancel()
@@ -116,22 +193,24 @@ func (nm *notifications) Close() error {
 
 // Manages the notifications for a specific shard.
 type shardNotificationsManager struct {
-	shard              int64
-	ctx                context.Context
-	nm                 *notifications
-	backoff            backoff.BackOff
-	lastOffsetReceived int64
-	initialized        bool
-	log                *slog.Logger
+	shard                int64
+	ctx                  context.Context
+	nm                   *notifications
+	backoff              backoff.BackOff
+	startOffsetExclusive *int64
+	lastOffsetReceived   int64
+	initialized          bool
+	log                  *slog.Logger
 }
 
-func newShardNotificationsManager(shard int64, nm *notifications) *shardNotificationsManager {
+func newShardNotificationsManager(shard int64, nm *notifications, startOffsetExclusive *int64) *shardNotificationsManager {
 	snm := &shardNotificationsManager{
-		shard:              shard,
-		ctx:                nm.ctx,
-		nm:                 nm,
-		lastOffsetReceived: -1,
-		backoff:            time2.NewBackOffWithInitialInterval(nm.ctx, 1*time.Second),
+		shard:                shard,
+		ctx:                  nm.ctx,
+		nm:                   nm,
+		startOffsetExclusive: startOffsetExclusive,
+		lastOffsetReceived:   -1,
+		backoff:              time2.NewBackOffWithInitialInterval(nm.ctx, 1*time.Second),
 		log: slog.With(
 			slog.String("component", "oxia-notifications-manager"),
 			slog.Int64("shard", shard),
@@ -180,14 +259,34 @@ func (snm *shardNotificationsManager) multiplexNotificationBatch(nb *proto.Notif
 		// needed to ensure that the notification cursor is created on the
 		// server side.
 		snm.initialized = true
-		snm.nm.initWaitGroup.Done()
 		snm.lastOffsetReceived = nb.Offset
+
+		// All the changes before the start of the subscription count as
+		// processed, so that resuming doesn't skip the ones that follow
+		snm.nm.commitLock.Lock()
+		snm.nm.committedOffsets[snm.shard] = nb.Offset
+		snm.nm.commitLock.Unlock()
+
+		snm.nm.initWaitGroup.Done()
 		return nil
 	}
 
-	for key, n := range nb.Notifications {
+	excludedKeyPrefix := snm.nm.options.excludedKeyPrefix
+	keys := make([]string, 0, len(nb.Notifications))
+	for key := range nb.Notifications {
+		if excludedKeyPrefix == "" || !strings.HasPrefix(key, excludedKeyPrefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	for idx, key := range keys {
+		notification := convertNotification(key, nb.Notifications[key])
+		notification.Shard = snm.shard
+		notification.Offset = nb.Offset
+		notification.lastInBatch = idx == len(keys)-1
+
 		select {
-		case snm.nm.multiplexCh <- convertNotification(key, n):
+		case snm.nm.multiplexCh <- notification:
 
 		// Unblock from channel write when we're closing down
 		case <-snm.ctx.Done():
@@ -240,14 +339,20 @@ func (snm *shardNotificationsManager) getNotifications() error {
 		return err
 	}
 
-	var startOffsetExclusive *int64
+	startOffsetExclusive := snm.startOffsetExclusive
 	if snm.lastOffsetReceived >= 0 {
 		startOffsetExclusive = &snm.lastOffsetReceived
 	}
 
+	options := snm.nm.options
 	notifications, err := client.GetNotifications(snm.ctx, &proto.NotificationsRequest{
 		Shard:                snm.shard,
 		StartOffsetExclusive: startOffsetExclusive,
+		KeyPrefix:            options.keyPrefix,
+		KeyStartInclusive:    options.minKeyInclusive,
+		KeyEndExclusive:      options.maxKeyExclusive,
+		Types:                toProtoNotificationTypes(options.types),
+		IncludeValues:        options.includeValues,
 	})
 	if err != nil {
 		if snm.ctx.Err() != nil {
@@ -258,6 +363,17 @@ func (snm *shardNotificationsManager) getNotifications() error {
 
 	snm.backoff.Reset()
 
+	if !snm.initialized && startOffsetExclusive != nil {
+		// When resuming from an offset, the server does not send the first
+		// "dummy" notification, and all the batches must be delivered
+		snm.log.Debug(
+			"Initialized the notification manager",
+			slog.Int64("start-offset-exclusive", *startOffsetExclusive),
+		)
+		snm.initialized = true
+		snm.nm.initWaitGroup.Done()
+	}
+
 	return snm.multiplexNotifications(notifications)
 }
 
@@ -276,15 +392,36 @@ func convertNotificationType(t proto.NotificationType) NotificationType {
 	}
 }
 
+func toProtoNotificationTypes(types []NotificationType) []proto.NotificationType {
+	var res []proto.NotificationType
+	for _, t := range types {
+		switch t {
+		case KeyCreated:
+			res = append(res, proto.NotificationType_KEY_CREATED)
+		case KeyModified:
+			res = append(res, proto.NotificationType_KEY_MODIFIED)
+		case KeyDeleted:
+			res = append(res, proto.NotificationType_KEY_DELETED)
+		case KeyRangeRangeDeleted:
+			res = append(res, proto.NotificationType_KEY_RANGE_DELETED)
+		default:
+			panic("Invalid notification type")
+		}
+	}
+	return res
+}
+
 func convertNotification(key string, n *proto.Notification) *Notification {
 	versionId := int64(-1)
 	if n.VersionId != nil {
 		versionId = *n.VersionId
 	}
 	return &Notification{
-		Type:        convertNotificationType(n.Type),
-		Key:         key,
-		VersionId:   versionId,
-		KeyRangeEnd: n.GetKeyRangeLast(),
+		Type:          convertNotificationType(n.Type),
+		Key:           key,
+		VersionId:     versionId,
+		KeyRangeEnd:   n.GetKeyRangeLast(),
+		Value:         n.Value,
+		PreviousValue: n.PreviousValue,
 	}
 }
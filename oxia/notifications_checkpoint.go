@@ -0,0 +1,75 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// NotificationsCheckpointStore persists the position of a notifications
+// consumer, so that it can resume where it left off after a restart.
+type NotificationsCheckpointStore interface {
+	// Load returns the last processed offset of each shard, or an empty map
+	// if no position was saved yet
+	Load(ctx context.Context) (map[int64]int64, error)
+
+	// Save persists the last processed offset of each shard
+	Save(ctx context.Context, offsets map[int64]int64) error
+}
+
+type oxiaCheckpointStore struct {
+	client SyncClient
+	key    string
+}
+
+// NewOxiaCheckpointStore returns a checkpoint store that saves the position
+// in an Oxia record.
+// Since every save is a change, the record should not be followed by the
+// notifications it tracks, e.g. by using a client on a different namespace,
+// or by filtering it out with [KeyPrefix] or [KeyRange].
+func NewOxiaCheckpointStore(client SyncClient, key string) NotificationsCheckpointStore {
+	return &oxiaCheckpointStore{
+		client: client,
+		key:    key,
+	}
+}
+
+func (s *oxiaCheckpointStore) Load(ctx context.Context) (map[int64]int64, error) {
+	_, value, _, err := s.client.Get(ctx, s.key)
+	if err != nil {
+		if errors.Is(err, ErrKeyNotFound) {
+			return map[int64]int64{}, nil
+		}
+		return nil, err
+	}
+
+	offsets := map[int64]int64{}
+	if err := json.Unmarshal(value, &offsets); err != nil {
+		return nil, errors.Wrapf(err, "invalid notifications checkpoint in %s", s.key)
+	}
+	return offsets, nil
+}
+
+func (s *oxiaCheckpointStore) Save(ctx context.Context, offsets map[int64]int64) error {
+	value, err := json.Marshal(offsets)
+	if err != nil {
+		return err
+	}
+	_, _, err = s.client.Put(ctx, s.key, value)
+	return err
+}
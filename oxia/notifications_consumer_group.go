@@ -0,0 +1,443 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/common/process"
+	"github.com/oxia-db/oxia/common/rpc"
+	"github.com/oxia-db/oxia/oxia/internal"
+)
+
+// ConsumerGroupsKeyPrefix is the prefix of the records where the consumer
+// groups track their members and their progress. The notifications for these
+// records are not delivered to the members of the consumer groups.
+const ConsumerGroupsKeyPrefix = "/__consumer-groups/"
+
+var ErrInvalidConsumerGroup = errors.New("oxia: invalid consumer group")
+
+// consumerGroup delivers the notifications of the shards assigned to this
+// member of the group. The members are tracked with ephemeral records, and
+// every member computes the same assignment from the sorted lists of members
+// and shards.
+type consumerGroup struct {
+	sync.Mutex
+	commitLock sync.Mutex
+	closeOnce  sync.Once
+	closeErr   error
+
+	name                 string
+	memberId             string
+	client               SyncClient
+	options              clientOptions
+	notificationsOptions *notificationsOptions
+	clientPool           rpc.ClientPool
+	shardManager         internal.ShardManager
+
+	ch            chan *Notification
+	membersWatch  *notifications
+	shardsChanged <-chan struct{}
+	assignment    *consumerGroupAssignment
+	runDone       chan any
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	log    *slog.Logger
+}
+
+// consumerGroupAssignment is the set of shards owned by the member, with the
+// subscription that delivers their notifications.
+//
+// The offsets are saved with the version id of their last read or write. If
+// another member took over a shard in the meantime, the version doesn't match
+// and the shard is considered lost until the next rebalance.
+type consumerGroupAssignment struct {
+	shards        []int64
+	notifications *notifications
+	committed     map[int64]int64
+	versions      map[int64]int64
+	done          chan any
+}
+
+func newConsumerGroup(ctx context.Context, client AsyncClient, options clientOptions, opts *notificationsOptions,
+	clientPool rpc.ClientPool, shardManager internal.ShardManager) (*consumerGroup, error) {
+	switch {
+	case *opts.consumerGroup == "" || strings.Contains(*opts.consumerGroup, "/"):
+		return nil, errors.Wrapf(ErrInvalidConsumerGroup, "invalid name %q", *opts.consumerGroup)
+	case opts.startOffsets != nil || opts.checkpointStore != nil:
+		return nil, errors.Wrap(ErrInvalidConsumerGroup, "the progress of a consumer group can't be set explicitly")
+	}
+
+	cg := &consumerGroup{
+		name:                 *opts.consumerGroup,
+		memberId:             uuid.NewString(),
+		client:               newSyncClient(client),
+		options:              options,
+		notificationsOptions: opts,
+		clientPool:           clientPool,
+		shardManager:         shardManager,
+		ch:                   make(chan *Notification, 100),
+		runDone:              make(chan any),
+	}
+	cg.ctx, cg.cancel = context.WithCancel(ctx)
+	cg.log = slog.With(
+		slog.String("component", "oxia-consumer-group"),
+		slog.String("consumer-group", cg.name),
+		slog.String("member", cg.memberId),
+	)
+
+	// Start watching the members before joining, to not miss the members
+	// that join in the meantime
+	var err error
+	membersPrefix := cg.membersKey() + "/"
+	cg.membersWatch, err = newNotifications(cg.ctx, options, &notificationsOptions{
+		keyPrefix: &membersPrefix,
+		shards:    []int64{shardManager.Get(cg.groupKey())},
+	}, clientPool, shardManager)
+	if err != nil {
+		cg.cancel()
+		return nil, err
+	}
+
+	cg.shardsChanged = shardManager.ShardsChanged()
+	if err = cg.join(); err == nil {
+		err = cg.rebalance()
+	}
+	if err != nil {
+		cg.cancel()
+		_ = cg.membersWatch.Close()
+		return nil, err
+	}
+
+	go process.DoWithLabels(
+		cg.ctx,
+		map[string]string{
+			"oxia":           "consumer-group",
+			"consumer-group": cg.name,
+		},
+		cg.run,
+	)
+	return cg, nil
+}
+
+func (cg *consumerGroup) groupKey() string {
+	return ConsumerGroupsKeyPrefix + cg.name
+}
+
+func (cg *consumerGroup) membersKey() string {
+	return cg.groupKey() + "/members"
+}
+
+func (cg *consumerGroup) offsetKey(shard int64) string {
+	return fmt.Sprintf("%s/offsets/%d", cg.groupKey(), shard)
+}
+
+// join registers the member in the group. All the records of the group are
+// stored in the same shard.
+func (cg *consumerGroup) join() error {
+	ctx, cancel := context.WithTimeout(cg.ctx, cg.options.requestTimeout)
+	defer cancel()
+
+	_, _, err := cg.client.Put(ctx, cg.membersKey()+"/"+cg.memberId, []byte{}, Ephemeral(), PartitionKey(cg.groupKey()))
+	return err
+}
+
+// run rebalances the shards every time the members of the group or the
+// shards of the namespace change.
+func (cg *consumerGroup) run() {
+	defer close(cg.runDone)
+
+	for {
+		select {
+		case _, ok := <-cg.membersWatch.Ch():
+			if !ok {
+				return
+			}
+		case <-cg.shardsChanged:
+			cg.shardsChanged = cg.shardManager.ShardsChanged()
+		}
+
+		for {
+			err := cg.rebalance()
+			if err == nil || cg.ctx.Err() != nil {
+				break
+			}
+
+			cg.log.Warn(
+				"Failed to rebalance the consumer group",
+				slog.Any("error", err),
+			)
+			select {
+			case <-time.After(time.Second):
+			case <-cg.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// assignedShards returns the shards that this member owns, out of the
+// sorted list of shards and members.
+func (cg *consumerGroup) assignedShards(members []string) []int64 {
+	slices.Sort(members)
+	index := slices.Index(members, cg.memberId)
+
+	shards := cg.shardManager.GetAll()
+	slices.Sort(shards)
+
+	assigned := make([]int64, 0)
+	for idx, shard := range shards {
+		if idx%len(members) == index {
+			assigned = append(assigned, shard)
+		}
+	}
+	return assigned
+}
+
+func (cg *consumerGroup) rebalance() error {
+	ctx, cancel := context.WithTimeout(cg.ctx, cg.options.requestTimeout)
+	defer cancel()
+
+	memberKeys, err := cg.client.List(ctx, cg.membersKey()+"/", cg.membersKey()+"//", PartitionKey(cg.groupKey()))
+	if err != nil {
+		return err
+	}
+	members := make([]string, 0, len(memberKeys))
+	for _, key := range memberKeys {
+		members = append(members, strings.TrimPrefix(key, cg.membersKey()+"/"))
+	}
+	if !slices.Contains(members, cg.memberId) {
+		// The record was deleted with an expired session
+		if err := cg.join(); err != nil {
+			return err
+		}
+		members = append(members, cg.memberId)
+	}
+
+	shards := cg.assignedShards(members)
+	if cg.assignment != nil && slices.Equal(cg.assignment.shards, shards) {
+		return nil
+	}
+
+	cg.log.Info(
+		"Rebalancing the consumer group",
+		slog.Any("members", members),
+		slog.Any("shards", shards),
+	)
+	cg.stopAssignment()
+	return cg.startAssignment(ctx, shards)
+}
+
+func (cg *consumerGroup) startAssignment(ctx context.Context, shards []int64) error {
+	assignment := &consumerGroupAssignment{
+		shards:   shards,
+		versions: map[int64]int64{},
+		done:     make(chan any),
+	}
+
+	startOffsets := map[int64]int64{}
+	for _, shard := range shards {
+		_, value, version, err := cg.client.Get(ctx, cg.offsetKey(shard), PartitionKey(cg.groupKey()))
+		switch {
+		case errors.Is(err, ErrKeyNotFound):
+			// The shard starts from its current position
+		case err != nil:
+			return err
+		default:
+			offset, err := strconv.ParseInt(string(value), 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid offset for shard %d", shard)
+			}
+			startOffsets[shard] = offset
+			assignment.versions[shard] = version.VersionId
+		}
+	}
+
+	if len(shards) == 0 {
+		close(assignment.done)
+		cg.setAssignment(assignment)
+		return nil
+	}
+
+	options := *cg.notificationsOptions
+	options.shards = shards
+	options.startOffsets = startOffsets
+	options.excludedKeyPrefix = ConsumerGroupsKeyPrefix
+	nm, err := newNotifications(cg.ctx, cg.options, &options, cg.clientPool, cg.shardManager)
+	if err != nil {
+		return err
+	}
+	assignment.notifications = nm
+	assignment.committed = nm.startPositions()
+
+	// Take the ownership of the offsets, so that the commits of the previous
+	// owners fail from now on. This also records the starting position of the
+	// shards without progress, so that the next owner doesn't skip the
+	// changes that are not processed yet. If a previous owner committed in
+	// the meantime, the rebalance is retried from its last commit.
+	for _, shard := range shards {
+		if err := cg.saveOffset(ctx, assignment, shard, assignment.committed[shard]); err != nil {
+			_ = nm.Close()
+			return err
+		}
+	}
+
+	cg.setAssignment(assignment)
+	go process.DoWithLabels(
+		cg.ctx,
+		map[string]string{
+			"oxia":           "consumer-group-forward",
+			"consumer-group": cg.name,
+		},
+		func() { cg.forward(assignment) },
+	)
+	return nil
+}
+
+func (cg *consumerGroup) setAssignment(assignment *consumerGroupAssignment) {
+	cg.Lock()
+	defer cg.Unlock()
+	cg.assignment = assignment
+}
+
+func (cg *consumerGroup) stopAssignment() {
+	cg.Lock()
+	assignment := cg.assignment
+	cg.assignment = nil
+	cg.Unlock()
+
+	if assignment == nil || assignment.notifications == nil {
+		return
+	}
+	_ = assignment.notifications.Close()
+	<-assignment.done
+}
+
+// forward delivers the notifications of the assigned shards, until they are
+// assigned to another member.
+func (cg *consumerGroup) forward(assignment *consumerGroupAssignment) {
+	defer close(assignment.done)
+
+	nm := assignment.notifications
+	for notification := range nm.Ch() {
+		select {
+		case cg.ch <- notification:
+		case <-nm.ctx.Done():
+			return
+		}
+	}
+}
+
+// saveOffset stores the offset of the shard, provided that no other member
+// saved it since the last read or write of this assignment.
+func (cg *consumerGroup) saveOffset(ctx context.Context, assignment *consumerGroupAssignment, shard int64, offset int64) error {
+	expected := ExpectedRecordNotExists()
+	if versionId, ok := assignment.versions[shard]; ok {
+		expected = ExpectedVersionId(versionId)
+	}
+
+	_, version, err := cg.client.Put(ctx, cg.offsetKey(shard), []byte(strconv.FormatInt(offset, 10)),
+		PartitionKey(cg.groupKey()), expected)
+	if err != nil {
+		return err
+	}
+	assignment.versions[shard] = version.VersionId
+	return nil
+}
+
+func (cg *consumerGroup) Ch() <-chan *Notification {
+	return cg.ch
+}
+
+func (cg *consumerGroup) Commit(ctx context.Context, notification *Notification) error {
+	offset := processedOffset(notification)
+
+	cg.commitLock.Lock()
+	defer cg.commitLock.Unlock()
+
+	cg.Lock()
+	assignment := cg.assignment
+	cg.Unlock()
+
+	// The shards that were moved to, or taken over by, another member are
+	// resumed from the last committed offset
+	if assignment == nil || !slices.Contains(assignment.shards, notification.Shard) {
+		return nil
+	}
+	if _, owned := assignment.versions[notification.Shard]; !owned {
+		return nil
+	}
+	if committed, ok := assignment.committed[notification.Shard]; ok && committed >= offset {
+		return nil
+	}
+	err := cg.saveOffset(ctx, assignment, notification.Shard, offset)
+	if errors.Is(err, ErrUnexpectedVersionId) {
+		// Another member took over the shard, and it resumes the shard
+		// from its last committed offset
+		cg.log.Warn(
+			"The shard was taken over by another member, its progress is not committed anymore",
+			slog.Int64("shard", notification.Shard),
+		)
+		delete(assignment.versions, notification.Shard)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	assignment.committed[notification.Shard] = offset
+	return nil
+}
+
+func (cg *consumerGroup) Close() error {
+	cg.closeOnce.Do(func() {
+		cg.closeErr = cg.close()
+	})
+	return cg.closeErr
+}
+
+func (cg *consumerGroup) close() error {
+	leaving := cg.ctx.Err() == nil
+	cg.cancel()
+	err := cg.membersWatch.Close()
+	<-cg.runDone
+	cg.stopAssignment()
+
+	// Leave the group, so that the other members can take over the shards
+	// without waiting for the session to expire
+	if leaving {
+		ctx, cancel := context.WithTimeout(context.Background(), cg.options.requestTimeout)
+		defer cancel()
+		if deleteErr := cg.client.Delete(ctx, cg.membersKey()+"/"+cg.memberId, PartitionKey(cg.groupKey())); deleteErr != nil &&
+			!errors.Is(deleteErr, ErrKeyNotFound) {
+			err = deleteErr
+		}
+	}
+
+	close(cg.ch)
+	for range cg.ch { //nolint:revive
+	}
+	return err
+}
@@ -0,0 +1,196 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/server"
+)
+
+func assignedShards(nm Notifications) []int64 {
+	cg := nm.(*consumerGroup)
+	cg.Lock()
+	defer cg.Unlock()
+	if cg.assignment == nil {
+		return nil
+	}
+	return cg.assignment.shards
+}
+
+// receiveNotifications returns the notifications received by each member,
+// until the expected count is reached. The second member is optional.
+func receiveNotifications(t *testing.T, count int, member1 Notifications, member2 Notifications) (
+	received1 []*Notification, received2 []*Notification) {
+	t.Helper()
+
+	var ch2 <-chan *Notification
+	if member2 != nil {
+		ch2 = member2.Ch()
+	}
+	for len(received1)+len(received2) < count {
+		select {
+		case n := <-member1.Ch():
+			received1 = append(received1, n)
+		case n := <-ch2:
+			received2 = append(received2, n)
+		case <-time.After(5 * time.Second):
+			assert.FailNow(t, "timed out waiting for notifications")
+		}
+	}
+	return received1, received2
+}
+
+func commitAll(t *testing.T, member Notifications, notifications []*Notification) {
+	t.Helper()
+	for _, n := range notifications {
+		assert.NoError(t, member.Commit(context.Background(), n))
+	}
+}
+
+func TestSyncClientImpl_NotificationsConsumerGroup(t *testing.T) {
+	config := server.NewTestConfig(t.TempDir())
+	config.NumShards = 4
+	standaloneServer, err := server.NewStandalone(config)
+	assert.NoError(t, err)
+
+	client1, err := NewSyncClient(standaloneServer.ServiceAddr(), WithBatchLinger(0))
+	assert.NoError(t, err)
+	client2, err := NewSyncClient(standaloneServer.ServiceAddr(), WithBatchLinger(0))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	put := func(prefix string) {
+		for i := 0; i < 20; i++ {
+			_, _, err := client1.Put(ctx, fmt.Sprintf("/%s-%d", prefix, i), []byte("0"))
+			assert.NoError(t, err)
+		}
+	}
+
+	member1, err := client1.GetNotifications(ConsumerGroup("g"))
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 1, 2, 3}, assignedShards(member1))
+
+	// A single member receives all the notifications, but not the ones for
+	// the group progress
+	put("a")
+	received1, _ := receiveNotifications(t, 20, member1, nil)
+	commitAll(t, member1, received1)
+	select {
+	case n := <-member1.Ch():
+		assert.Failf(t, "unexpected notification", "%+v", n)
+	case <-time.After(100 * time.Millisecond):
+		// Ok, we expect it to time out
+	}
+
+	member2, err := client2.GetNotifications(ConsumerGroup("g"))
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return len(assignedShards(member1)) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, assignedShards(member2), 2)
+	for _, shard := range assignedShards(member1) {
+		assert.NotContains(t, assignedShards(member2), shard)
+	}
+
+	// Every notification is delivered to the member owning its shard. The
+	// second member doesn't commit its progress
+	put("b")
+	received1, received2 := receiveNotifications(t, 20, member1, member2)
+	commitAll(t, member1, received1)
+	keys2 := map[string]bool{}
+	for _, n := range received2 {
+		assert.Contains(t, assignedShards(member2), n.Shard)
+		keys2[n.Key] = true
+	}
+	for _, n := range received1 {
+		assert.Contains(t, assignedShards(member1), n.Shard)
+		assert.NotContains(t, keys2, n.Key)
+	}
+
+	// The shards of the member that left are resumed from its last commit
+	assert.NoError(t, member2.Close())
+	assert.Eventually(t, func() bool {
+		return len(assignedShards(member1)) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+
+	redelivered, _ := receiveNotifications(t, len(received2), member1, nil)
+	for _, n := range redelivered {
+		assert.Contains(t, keys2, n.Key)
+	}
+
+	assert.NoError(t, member1.Close())
+	assert.NoError(t, client1.Close())
+	assert.NoError(t, client2.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestSyncClientImpl_NotificationsConsumerGroupTakenOver(t *testing.T) {
+	standaloneServer, err := server.NewStandalone(server.NewTestConfig(t.TempDir()))
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr(), WithBatchLinger(0))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	member, err := client.GetNotifications(ConsumerGroup("g"))
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, _, err := client.Put(ctx, fmt.Sprintf("/a-%d", i), []byte("0"))
+		assert.NoError(t, err)
+	}
+	received, _ := receiveNotifications(t, 2, member, nil)
+	commitAll(t, member, received[:1])
+
+	// Another member saves the progress of the shard in the meantime
+	cg := member.(*consumerGroup)
+	offsetKey := cg.offsetKey(received[0].Shard)
+	_, _, err = client.Put(ctx, offsetKey, []byte("-1"), PartitionKey(cg.groupKey()))
+	assert.NoError(t, err)
+
+	// The progress of the shard is not committed anymore
+	commitAll(t, member, received[1:])
+	_, value, _, err := client.Get(ctx, offsetKey, PartitionKey(cg.groupKey()))
+	assert.NoError(t, err)
+	assert.Equal(t, "-1", string(value))
+
+	assert.NoError(t, member.Close())
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
+
+func TestSyncClientImpl_NotificationsConsumerGroupInvalid(t *testing.T) {
+	standaloneServer, err := server.NewStandalone(server.NewTestConfig(t.TempDir()))
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr(), WithBatchLinger(0))
+	assert.NoError(t, err)
+
+	_, err = client.GetNotifications(ConsumerGroup(""))
+	assert.ErrorIs(t, err, ErrInvalidConsumerGroup)
+	_, err = client.GetNotifications(ConsumerGroup("a/b"))
+	assert.ErrorIs(t, err, ErrInvalidConsumerGroup)
+	_, err = client.GetNotifications(ConsumerGroup("g"), StartOffsets(map[int64]int64{0: 1}))
+	assert.ErrorIs(t, err, ErrInvalidConsumerGroup)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
@@ -20,6 +20,9 @@ type BaseOption interface {
 	GetOption
 	DeleteOption
 	DeleteRangeOption
+	IncrementOption
+	AppendOption
+	MergePatchOption
 	ListOption
 	RangeScanOption
 	GetSequenceUpdatesOption
@@ -55,6 +58,18 @@ func (o *partitionKeyOpt) applyDeleteRange(opts *deleteRangeOptions) {
 	opts.partitionKey = o.partitionKey
 }
 
+func (o *partitionKeyOpt) applyIncrement(opts *incrementOptions) {
+	opts.partitionKey = o.partitionKey
+}
+
+func (o *partitionKeyOpt) applyAppend(opts *appendOptions) {
+	opts.partitionKey = o.partitionKey
+}
+
+func (o *partitionKeyOpt) applyMergePatch(opts *mergePatchOptions) {
+	opts.partitionKey = o.partitionKey
+}
+
 func (o *partitionKeyOpt) applyGet(opts *getOptions) {
 	opts.partitionKey = o.partitionKey
 }
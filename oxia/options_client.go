@@ -36,6 +36,7 @@ const (
 	DefaultMaxBatchSize        = 128 * 1024
 	DefaultRequestTimeout      = 30 * time.Second
 	DefaultSessionTimeout      = 15 * time.Second
+	DefaultTransactionTimeout  = 30 * time.Second
 	DefaultNamespace           = constant.DefaultNamespace
 )
 
@@ -14,9 +14,12 @@
 
 package oxia
 
+import "crypto/sha256"
+
 type deleteOptions struct {
 	baseOptions
-	expectedVersion *int64
+	expectedVersion   *int64
+	expectedValueHash []byte
 }
 
 // DeleteOption represents an option for the [SyncClient.Delete] operation.
@@ -50,3 +53,24 @@ func (e *expectedVersionId) applyPut(opts *putOptions) {
 func (e *expectedVersionId) applyDelete(opts *deleteOptions) {
 	opts.expectedVersion = &e.versionId
 }
+
+// ExpectedValue Marks that the operation should only be successful
+// if the value of the record stored in the server matches the expected one.
+//
+// Only a digest of the value is sent to the server.
+func ExpectedValue(value []byte) DeleteOption {
+	hash := sha256.Sum256(value)
+	return &expectedValueHash{hash[:]}
+}
+
+type expectedValueHash struct {
+	hash []byte
+}
+
+func (e *expectedValueHash) applyPut(opts *putOptions) {
+	opts.expectedValueHash = e.hash
+}
+
+func (e *expectedValueHash) applyDelete(opts *deleteOptions) {
+	opts.expectedValueHash = e.hash
+}
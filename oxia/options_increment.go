@@ -0,0 +1,69 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import "github.com/pkg/errors"
+
+type incrementOptions struct {
+	baseOptions
+	minValue *int64
+	maxValue *int64
+}
+
+// IncrementOption represents an option for the [SyncClient.Increment] operation.
+type IncrementOption interface {
+	applyIncrement(opts *incrementOptions)
+}
+
+func newIncrementOptions(opts []IncrementOption) (*incrementOptions, error) {
+	incrementOpts := &incrementOptions{}
+	for _, opt := range opts {
+		opt.applyIncrement(incrementOpts)
+	}
+
+	if incrementOpts.minValue != nil && incrementOpts.maxValue != nil &&
+		*incrementOpts.minValue > *incrementOpts.maxValue {
+		return nil, errors.Wrap(ErrInvalidOptions, "MinValue must not be greater than MaxValue")
+	}
+	return incrementOpts, nil
+}
+
+type minValue struct {
+	value int64
+}
+
+func (m *minValue) applyIncrement(opts *incrementOptions) {
+	opts.minValue = &m.value
+}
+
+// MinValue makes the increment fail with [ErrValueOutOfBounds] if the new
+// value of the counter would be lower than the given bound.
+func MinValue(value int64) IncrementOption {
+	return &minValue{value}
+}
+
+type maxValue struct {
+	value int64
+}
+
+func (m *maxValue) applyIncrement(opts *incrementOptions) {
+	opts.maxValue = &m.value
+}
+
+// MaxValue makes the increment fail with [ErrValueOutOfBounds] if the new
+// value of the counter would be higher than the given bound.
+func MaxValue(value int64) IncrementOption {
+	return &maxValue{value}
+}
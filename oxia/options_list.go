@@ -14,10 +14,18 @@
 
 package oxia
 
+import "time"
+
 type listOptions struct {
 	baseOptions
 
 	secondaryIndexName *string
+	maxStaleness       *time.Duration
+	linearizable       bool
+	reverse            bool
+
+	limit             *int
+	continuationToken string
 }
 
 // ListOption represents an option for the [SyncClient.List] operation.
@@ -27,6 +35,15 @@ type ListOption interface {
 	applyGet(opts *getOptions)
 }
 
+// maxStalenessMs returns the staleness that is tolerated by the read
+// operations. The linearizable reads are always served by the leader.
+func (o *listOptions) maxStalenessMs() *uint64 {
+	if o.linearizable {
+		return nil
+	}
+	return toMaxStalenessMs(o.maxStaleness)
+}
+
 func newListOptions(opts []ListOption) *listOptions {
 	listOpts := &listOptions{}
 	for _, opt := range opts {
@@ -56,3 +73,127 @@ func (u *useIndex) applyGet(opts *getOptions) {
 func UseIndex(indexName string) ListOption {
 	return &useIndex{indexName}
 }
+
+type followerReads struct {
+	maxStaleness time.Duration
+}
+
+func (f *followerReads) applyList(opts *listOptions) {
+	opts.maxStaleness = &f.maxStaleness
+}
+
+func (f *followerReads) applyRangeScan(opts *rangeScanOptions) {
+	opts.maxStaleness = &f.maxStaleness
+}
+
+func (f *followerReads) applyGet(opts *getOptions) {
+	opts.maxStaleness = &f.maxStaleness
+}
+
+// FollowerReads allows the read operations to be served by any replica of the
+// shard, instead of only the leader, in order to spread the read load.
+// The followers only serve the requests when their data is not older than
+// `maxStaleness`, otherwise the requests are sent to the leader. The reads
+// might observe stale data and are not guaranteed to be monotonic.
+func FollowerReads(maxStaleness time.Duration) ListOption {
+	return &followerReads{maxStaleness}
+}
+
+type linearizable struct{}
+
+var linearizableFlag = &linearizable{}
+
+func (*linearizable) applyList(opts *listOptions) {
+	opts.linearizable = true
+}
+
+func (*linearizable) applyRangeScan(opts *rangeScanOptions) {
+	opts.linearizable = true
+}
+
+func (*linearizable) applyGet(opts *getOptions) {
+	opts.linearizable = true
+}
+
+// Linearizable makes the read operations observe all the writes that were
+// completed before the operations were started. Before serving the reads,
+// the leader confirms that it's still the leader of the shard with a quorum of
+// the followers, which adds the latency of a replication round.
+// This option takes precedence over [FollowerReads].
+func Linearizable() ListOption {
+	return linearizableFlag
+}
+
+type reverse struct{}
+
+var reverseFlag = &reverse{}
+
+func (*reverse) applyList(opts *listOptions) {
+	opts.reverse = true
+}
+
+func (*reverse) applyRangeScan(opts *rangeScanOptions) {
+	opts.reverse = true
+}
+
+func (*reverse) applyGet(*getOptions) {
+	// The order does not apply to the get operations
+}
+
+// Reverse returns the records of the [SyncClient.List] and
+// [SyncClient.RangeScan] operations in descending order, from the end of the
+// range to its start. Combined with [Limit], it allows to efficiently read the
+// latest records of a range.
+func Reverse() ListOption {
+	return reverseFlag
+}
+
+type limitOpt struct {
+	limit int
+}
+
+func (l *limitOpt) applyList(opts *listOptions) {
+	opts.limit = &l.limit
+}
+
+func (l *limitOpt) applyRangeScan(opts *rangeScanOptions) {
+	opts.limit = &l.limit
+}
+
+func (*limitOpt) applyGet(*getOptions) {
+	// The pagination does not apply to the get operations
+}
+
+// Limit restricts the number of records returned by the [SyncClient.List] and
+// [SyncClient.RangeScan] operations, across all the shards.
+// The results are returned in the order in which the records are stored, along
+// with a continuation token that can be passed with [ContinueFrom] to get the
+// next page, in [ListResult] and [GetResult]. The token is empty once there are
+// no more records in the range.
+func Limit(limit int) ListOption {
+	return &limitOpt{limit}
+}
+
+type continueFrom struct {
+	continuationToken string
+}
+
+func (c *continueFrom) applyList(opts *listOptions) {
+	opts.continuationToken = c.continuationToken
+}
+
+func (c *continueFrom) applyRangeScan(opts *rangeScanOptions) {
+	opts.continuationToken = c.continuationToken
+}
+
+func (*continueFrom) applyGet(*getOptions) {
+	// The pagination does not apply to the get operations
+}
+
+// ContinueFrom resumes a paginated [SyncClient.List] or [SyncClient.RangeScan]
+// operation right after the last record of the previous page, identified by
+// its continuation token. The same range and options must be used for all the
+// pages.
+func ContinueFrom(continuationToken string) ListOption {
+	return &continueFrom{continuationToken}
+}
@@ -0,0 +1,155 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+type notificationsOptions struct {
+	keyPrefix       *string
+	minKeyInclusive *string
+	maxKeyExclusive *string
+	types           []NotificationType
+	includeValues   bool
+
+	startOffsets    map[int64]int64
+	checkpointStore NotificationsCheckpointStore
+	consumerGroup   *string
+
+	// The shards to follow, or all of them if nil
+	shards []int64
+	// The keys whose notifications are discarded by the client
+	excludedKeyPrefix string
+}
+
+// NotificationsOption represents an option for the [SyncClient.GetNotifications] operation.
+type NotificationsOption interface {
+	applyNotifications(opts *notificationsOptions)
+}
+
+func newNotificationsOptions(opts []NotificationsOption) *notificationsOptions {
+	notificationsOpts := &notificationsOptions{}
+	for _, opt := range opts {
+		opt.applyNotifications(notificationsOpts)
+	}
+	return notificationsOpts
+}
+
+type keyPrefix struct {
+	prefix string
+}
+
+func (k *keyPrefix) applyNotifications(opts *notificationsOptions) {
+	opts.keyPrefix = &k.prefix
+}
+
+// KeyPrefix only delivers the notifications for the keys that start with the
+// given prefix. The filter is applied by the servers.
+// The range deletions are always delivered, since they can cover keys with
+// the prefix regardless of their bounds.
+func KeyPrefix(prefix string) NotificationsOption {
+	return &keyPrefix{prefix}
+}
+
+type keyRange struct {
+	minKeyInclusive string
+	maxKeyExclusive string
+}
+
+func (k *keyRange) applyNotifications(opts *notificationsOptions) {
+	opts.minKeyInclusive = &k.minKeyInclusive
+	opts.maxKeyExclusive = &k.maxKeyExclusive
+}
+
+// KeyRange only delivers the notifications for the keys in the range
+// [minKeyInclusive, maxKeyExclusive), along with the range deletions
+// overlapping it. The filter is applied by the servers.
+func KeyRange(minKeyInclusive string, maxKeyExclusive string) NotificationsOption {
+	return &keyRange{minKeyInclusive, maxKeyExclusive}
+}
+
+type notificationTypes struct {
+	types []NotificationType
+}
+
+func (n *notificationTypes) applyNotifications(opts *notificationsOptions) {
+	opts.types = append(opts.types, n.types...)
+}
+
+// NotificationTypes only delivers the notifications of the given types.
+// The filter is applied by the servers.
+func NotificationTypes(types ...NotificationType) NotificationsOption {
+	return &notificationTypes{types}
+}
+
+type includeNotificationValues struct{}
+
+func (*includeNotificationValues) applyNotifications(opts *notificationsOptions) {
+	opts.includeValues = true
+}
+
+// IncludeNotificationValues makes the notifications carry the value written
+// by each change and the value it replaced, if the namespace is configured
+// to capture them.
+func IncludeNotificationValues() NotificationsOption {
+	return &includeNotificationValues{}
+}
+
+type startOffsets struct {
+	offsets map[int64]int64
+}
+
+func (s *startOffsets) applyNotifications(opts *notificationsOptions) {
+	opts.startOffsets = s.offsets
+}
+
+// StartOffsets resumes the notifications of each shard after the given
+// offset, that is the Offset of the last change that was fully processed.
+// The shards without an offset start from their current position.
+func StartOffsets(offsets map[int64]int64) NotificationsOption {
+	return &startOffsets{offsets}
+}
+
+type checkpoints struct {
+	store NotificationsCheckpointStore
+}
+
+func (c *checkpoints) applyNotifications(opts *notificationsOptions) {
+	opts.checkpointStore = c.store
+}
+
+// Checkpoints resumes the notifications from the position saved in the store,
+// and saves the position every time a notification is committed, in order to
+// get an at-least-once delivery across restarts.
+// The offsets given with [StartOffsets] take precedence over the saved ones.
+func Checkpoints(store NotificationsCheckpointStore) NotificationsOption {
+	return &checkpoints{store}
+}
+
+type consumerGroupOpt struct {
+	name string
+}
+
+func (c *consumerGroupOpt) applyNotifications(opts *notificationsOptions) {
+	opts.consumerGroup = &c.name
+}
+
+// ConsumerGroup joins the named consumer group, whose members split the
+// shards among themselves, so that every notification is delivered to a
+// single member. The shards are rebalanced when members join or leave the
+// group, and every member resumes from the offsets committed by the previous
+// owner of its shards, in order to get an at-least-once delivery.
+// The group progress is kept under [ConsumerGroupsKeyPrefix], so it can't be
+// combined with [StartOffsets] or [Checkpoints].
+func ConsumerGroup(name string) NotificationsOption {
+	return &consumerGroupOpt{name}
+}
@@ -14,14 +14,21 @@
 
 package oxia
 
-import "github.com/pkg/errors"
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 type putOptions struct {
 	baseOptions
-	expectedVersion    *int64
-	ephemeral          bool
-	sequenceKeysDeltas []uint64
-	secondaryIndexes   []*secondaryIdxOption
+	expectedVersion      *int64
+	expectedRecordExists bool
+	expectedValueHash    []byte
+	ephemeral            bool
+	ttl                  *time.Duration
+	sequenceKeysDeltas   []uint64
+	secondaryIndexes     []*secondaryIdxOption
 }
 
 // PutOption represents an option for the [SyncClient.Put] operation.
@@ -35,6 +42,10 @@ func newPutOptions(opts []PutOption) (*putOptions, error) {
 		opt.applyPut(putOpts)
 	}
 
+	if putOpts.ttl != nil && *putOpts.ttl < time.Millisecond {
+		return nil, errors.Wrap(ErrInvalidOptions, "TTL must be at least 1 millisecond")
+	}
+
 	if len(putOpts.sequenceKeysDeltas) > 0 {
 		if putOpts.partitionKey == nil {
 			return nil, errors.Wrap(ErrInvalidOptions, "usage of sequential keys requires PartitionKey() to be set")
@@ -44,6 +55,10 @@ func newPutOptions(opts []PutOption) (*putOptions, error) {
 			return nil, errors.Wrap(ErrInvalidOptions, "usage of sequential keys does not allow to specify an ExpectedVersionId")
 		}
 
+		if putOpts.expectedRecordExists || putOpts.expectedValueHash != nil {
+			return nil, errors.Wrap(ErrInvalidOptions, "usage of sequential keys does not allow to specify an expected record")
+		}
+
 		if putOpts.sequenceKeysDeltas[0] == 0 {
 			return nil, errors.Wrap(ErrInvalidOptions, "first delta in sequence keys delta must always be > 0")
 		}
@@ -58,6 +73,20 @@ func ExpectedRecordNotExists() PutOption {
 	return &expectedVersionId{VersionIdNotExists}
 }
 
+// ExpectedRecordExists Marks that the put operation should only be successful
+// if the record already exists.
+func ExpectedRecordExists() PutOption {
+	return expectedRecordExistsFlag
+}
+
+type expectedRecordExists struct{}
+
+var expectedRecordExistsFlag = &expectedRecordExists{}
+
+func (*expectedRecordExists) applyPut(opts *putOptions) {
+	opts.expectedRecordExists = true
+}
+
 type ephemeral struct{}
 
 var ephemeralFlag = &ephemeral{}
@@ -78,6 +107,25 @@ func Ephemeral() PutOption {
 	return ephemeralFlag
 }
 
+type ttl struct {
+	ttl time.Duration
+}
+
+func (t *ttl) applyPut(opts *putOptions) {
+	opts.ttl = &t.ttl
+}
+
+// TTL sets the time-to-live of the record.
+// The record is automatically deleted by the server once the TTL has elapsed,
+// unless it gets modified in the meantime. Each modification of the record
+// replaces its expiration time, and a modification without a TTL makes the
+// record persistent again.
+// Unlike [Ephemeral] records, the records with a TTL are not tied to the
+// client session. The expiration is enforced within a few seconds.
+func TTL(d time.Duration) PutOption {
+	return &ttl{d}
+}
+
 type sequenceKeysDeltas struct {
 	sequenceKeysDeltas []uint64
 }
@@ -14,8 +14,21 @@
 
 package oxia
 
+import (
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/proto"
+)
+
 type rangeScanOptions struct {
 	listOptions
+
+	filter       *proto.RangeScanFilter
+	metadataOnly bool
 }
 
 // RangeScanOption represents an option for the [SyncClient.RangeScan] operation.
@@ -23,10 +36,116 @@ type RangeScanOption interface {
 	applyRangeScan(opts *rangeScanOptions)
 }
 
-func newRangeScanOptions(opts []RangeScanOption) *rangeScanOptions {
+func newRangeScanOptions(opts []RangeScanOption) (*rangeScanOptions, error) {
 	rangeScanOpts := &rangeScanOptions{}
 	for _, opt := range opts {
 		opt.applyRangeScan(rangeScanOpts)
 	}
-	return rangeScanOpts
+
+	if filter := rangeScanOpts.filter; filter != nil {
+		if filter.KeyRegex != nil {
+			if _, err := regexp.Compile(*filter.KeyRegex); err != nil {
+				return nil, errors.Wrapf(ErrInvalidOptions, "invalid key regex: %v", err)
+			}
+		}
+		if filter.KeyGlob != nil {
+			if _, err := path.Match(*filter.KeyGlob, ""); err != nil {
+				return nil, errors.Wrapf(ErrInvalidOptions, "invalid key glob: %v", err)
+			}
+		}
+	}
+
+	return rangeScanOpts, nil
+}
+
+// getFilter returns the filter of the range-scan, creating it if needed.
+func (o *rangeScanOptions) getFilter() *proto.RangeScanFilter {
+	if o.filter == nil {
+		o.filter = &proto.RangeScanFilter{}
+	}
+	return o.filter
+}
+
+type filterOption func(filter *proto.RangeScanFilter)
+
+func (f filterOption) applyRangeScan(opts *rangeScanOptions) {
+	f(opts.getFilter())
+}
+
+// FilterKeyRegex makes the [SyncClient.RangeScan] operation return only the
+// records whose key matches the regular expression, in RE2 syntax.
+// The filters are evaluated by the servers, and a record is returned only if
+// it matches all of them.
+func FilterKeyRegex(regex string) RangeScanOption {
+	return filterOption(func(filter *proto.RangeScanFilter) {
+		filter.KeyRegex = &regex
+	})
+}
+
+// FilterKeyGlob makes the [SyncClient.RangeScan] operation return only the
+// records whose key matches the glob pattern, as in [path.Match]. The `*`
+// wildcard does not match the `/` separator.
+func FilterKeyGlob(pattern string) RangeScanOption {
+	return filterOption(func(filter *proto.RangeScanFilter) {
+		filter.KeyGlob = &pattern
+	})
+}
+
+// FilterValuePrefix makes the [SyncClient.RangeScan] operation return only the
+// records whose value starts with the prefix.
+func FilterValuePrefix(prefix []byte) RangeScanOption {
+	return filterOption(func(filter *proto.RangeScanFilter) {
+		filter.ValuePrefix = prefix
+	})
+}
+
+// FilterVersionIdRange makes the [SyncClient.RangeScan] operation return only
+// the records whose version id is within the range, inclusive.
+func FilterVersionIdRange(minVersionId int64, maxVersionId int64) RangeScanOption {
+	return filterOption(func(filter *proto.RangeScanFilter) {
+		filter.MinVersionId = &minVersionId
+		filter.MaxVersionId = &maxVersionId
+	})
+}
+
+// FilterModifiedTimestampRange makes the [SyncClient.RangeScan] operation
+// return only the records that were last modified within the range, inclusive,
+// with millisecond precision. A zero time leaves that end of the range
+// unbounded.
+func FilterModifiedTimestampRange(minTimestamp time.Time, maxTimestamp time.Time) RangeScanOption {
+	return filterOption(func(filter *proto.RangeScanFilter) {
+		if !minTimestamp.IsZero() {
+			filter.MinModifiedTimestamp = toTimestamp(minTimestamp)
+		}
+		if !maxTimestamp.IsZero() {
+			filter.MaxModifiedTimestamp = toTimestamp(maxTimestamp)
+		}
+	})
+}
+
+func toTimestamp(t time.Time) *uint64 {
+	timestamp := uint64(t.UnixMilli())
+	return &timestamp
+}
+
+// FilterEphemeral makes the [SyncClient.RangeScan] operation return only the
+// ephemeral records.
+func FilterEphemeral() RangeScanOption {
+	return filterOption(func(filter *proto.RangeScanFilter) {
+		filter.EphemeralOnly = true
+	})
+}
+
+type metadataOnly struct{}
+
+var metadataOnlyFlag = &metadataOnly{}
+
+func (*metadataOnly) applyRangeScan(opts *rangeScanOptions) {
+	opts.metadataOnly = true
+}
+
+// MetadataOnly makes the [SyncClient.RangeScan] operation return only the keys
+// and the versions of the records, without their values.
+func MetadataOnly() RangeScanOption {
+	return metadataOnlyFlag
 }
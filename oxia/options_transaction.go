@@ -0,0 +1,54 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import "time"
+
+type transactionOptions struct {
+	timeout time.Duration
+}
+
+// TransactionOption represents an option for the [SyncClient.Begin] operation.
+type TransactionOption interface {
+	applyTransaction(opts *transactionOptions)
+}
+
+func newTransactionOptions(opts []TransactionOption) *transactionOptions {
+	transactionOpts := &transactionOptions{
+		timeout: DefaultTransactionTimeout,
+	}
+	for _, opt := range opts {
+		opt.applyTransaction(transactionOpts)
+	}
+	return transactionOpts
+}
+
+// TransactionTimeout sets the time after which a transaction that was not
+// completed, eg: because the client crashed in the middle of the commit, is
+// resolved by the servers.
+// The records involved in the transaction stay locked until then.
+func TransactionTimeout(timeout time.Duration) TransactionOption {
+	return &transactionTimeout{timeout}
+}
+
+type transactionTimeout struct {
+	timeout time.Duration
+}
+
+func (o *transactionTimeout) applyTransaction(opts *transactionOptions) {
+	if o.timeout > 0 {
+		opts.timeout = o.timeout
+	}
+}
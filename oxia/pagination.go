@@ -0,0 +1,245 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/oxia-db/oxia/common/compare"
+	"github.com/oxia-db/oxia/proto"
+)
+
+// pagePosition identifies a record in the order in which the records are
+// stored in the shards: by key, or by secondary key and then by key when using
+// a secondary index. The continuation tokens are the encoded position of the
+// last record of a page, so that they stay valid even if the shards are split
+// or merged between two pages.
+type pagePosition struct {
+	Key          string  `json:"k"`
+	SecondaryKey *string `json:"s,omitempty"`
+}
+
+func (p *pagePosition) encode() string {
+	value, _ := json.Marshal(p)
+	return base64.RawURLEncoding.EncodeToString(value)
+}
+
+func decodePagePosition(token string) (*pagePosition, error) {
+	value, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidOptions, "invalid continuation token")
+	}
+
+	p := &pagePosition{}
+	if err = json.Unmarshal(value, p); err != nil {
+		return nil, errors.Wrap(ErrInvalidOptions, "invalid continuation token")
+	}
+	return p, nil
+}
+
+func comparePagePositions(a, b *pagePosition) int {
+	if a.SecondaryKey != nil && b.SecondaryKey != nil {
+		if c := bytes.Compare(compare.EncoderHierarchical.Encode(*a.SecondaryKey),
+			compare.EncoderHierarchical.Encode(*b.SecondaryKey)); c != 0 {
+			return c
+		}
+
+		// The index entries with the same secondary key are sorted by
+		// their escaped primary key
+		return strings.Compare(url.PathEscape(a.Key), url.PathEscape(b.Key))
+	}
+
+	return bytes.Compare(compare.EncoderHierarchical.Encode(a.Key), compare.EncoderHierarchical.Encode(b.Key))
+}
+
+type pageFetcher[T any] func(shardId int64, startInclusive string, endExclusive string, startAfterKey *string,
+	limit uint32) ([]T, error)
+
+// fetchPage reads up to `limit` records from each of the shards, starting
+// after the position in the continuation token, and returns the first `limit`
+// records across all the shards, along with the token for the next page.
+// With reverse scans, the pages go from the end of the range to its start.
+func fetchPage[T any](shardIds []int64, minKeyInclusive string, maxKeyExclusive string, opts *listOptions,
+	fetch pageFetcher[T], position func(T) *pagePosition) (page []T, continuationToken string, err error) {
+	limit := *opts.limit
+	if limit <= 0 {
+		return nil, "", errors.Wrap(ErrInvalidOptions, "limit must be greater than 0")
+	}
+
+	startInclusive := minKeyInclusive
+	endExclusive := maxKeyExclusive
+	var startAfterKey *string
+	if opts.continuationToken != "" {
+		start, err := decodePagePosition(opts.continuationToken)
+		if err != nil {
+			return nil, "", err
+		}
+		if (start.SecondaryKey != nil) != (opts.secondaryIndexName != nil) {
+			return nil, "", errors.Wrap(ErrInvalidOptions, "continuation token does not match the secondary index")
+		}
+
+		startAfterKey = &start.Key
+		if start.SecondaryKey != nil {
+			if opts.reverse {
+				endExclusive = *start.SecondaryKey
+			} else {
+				startInclusive = *start.SecondaryKey
+			}
+		}
+	}
+
+	results := make([][]T, len(shardIds))
+	errs := make([]error, len(shardIds))
+	wg := sync.WaitGroup{}
+	for i, shardId := range shardIds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = fetch(shardId, startInclusive, endExclusive, startAfterKey, uint32(limit))
+		}()
+	}
+	wg.Wait()
+
+	if err = multierr.Combine(errs...); err != nil {
+		return nil, "", err
+	}
+
+	hasMore := false
+	for _, records := range results {
+		page = append(page, records...)
+		// The shard might have more records after the ones returned
+		hasMore = hasMore || len(records) == limit
+	}
+
+	slices.SortStableFunc(page, func(a, b T) int {
+		if opts.reverse {
+			return comparePagePositions(position(b), position(a))
+		}
+		return comparePagePositions(position(a), position(b))
+	})
+	if len(page) > limit {
+		page = page[:limit]
+		hasMore = true
+	}
+
+	if hasMore {
+		continuationToken = position(page[len(page)-1]).encode()
+	}
+	return page, continuationToken, nil
+}
+
+// rangeShards returns the shards that might store the records of a range.
+func (c *clientImpl) rangeShards(opts *listOptions) []int64 {
+	if opts.partitionKey != nil {
+		return []int64{c.getShardForKey("", opts)}
+	}
+	return c.shardManager.GetAll()
+}
+
+func (c *clientImpl) listPage(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, opts *listOptions,
+	ch chan<- ListResult) {
+	defer close(ch)
+
+	page, continuationToken, err := fetchPage(c.rangeShards(opts), minKeyInclusive, maxKeyExclusive, opts,
+		func(shardId int64, startInclusive string, endExclusive string, startAfterKey *string, limit uint32) ([]*pagePosition, error) {
+			var positions []*pagePosition
+			err := c.doListFromShard(ctx, &proto.ListRequest{
+				Shard:              &shardId,
+				StartInclusive:     startInclusive,
+				EndExclusive:       endExclusive,
+				SecondaryIndexName: opts.secondaryIndexName,
+				MaxStalenessMs:     opts.maxStalenessMs(),
+				Linearizable:       opts.linearizable,
+				Limit:              &limit,
+				StartAfterKey:      startAfterKey,
+				Reverse:            opts.reverse,
+			}, func(response *proto.ListResponse) {
+				for i, key := range response.Keys {
+					p := &pagePosition{Key: key}
+					if i < len(response.SecondaryIndexKeys) {
+						p.SecondaryKey = &response.SecondaryIndexKeys[i]
+					}
+					positions = append(positions, p)
+				}
+			})
+			return positions, err
+		},
+		func(p *pagePosition) *pagePosition { return p })
+	if err != nil {
+		ch <- ListResult{Err: err}
+		return
+	}
+
+	keys := make([]string, len(page))
+	for i, p := range page {
+		keys[i] = p.Key
+	}
+	ch <- ListResult{Keys: keys, ContinuationToken: continuationToken}
+}
+
+func (c *clientImpl) rangeScanPage(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, opts *rangeScanOptions,
+	ch chan<- GetResult) {
+	defer close(ch)
+
+	page, continuationToken, err := fetchPage(c.rangeShards(&opts.listOptions), minKeyInclusive, maxKeyExclusive, &opts.listOptions,
+		func(shardId int64, startInclusive string, endExclusive string, startAfterKey *string, limit uint32) ([]*proto.GetResponse, error) {
+			var records []*proto.GetResponse
+			err := c.doRangeScanFromShard(ctx, &proto.RangeScanRequest{
+				Shard:              &shardId,
+				StartInclusive:     startInclusive,
+				EndExclusive:       endExclusive,
+				SecondaryIndexName: opts.secondaryIndexName,
+				MaxStalenessMs:     opts.maxStalenessMs(),
+				Linearizable:       opts.linearizable,
+				Limit:              &limit,
+				StartAfterKey:      startAfterKey,
+				Reverse:            opts.reverse,
+				Filter:             opts.filter,
+				MetadataOnly:       opts.metadataOnly,
+			}, func(record *proto.GetResponse) {
+				records = append(records, record)
+			})
+			return records, err
+		},
+		func(record *proto.GetResponse) *pagePosition {
+			p := &pagePosition{Key: record.GetKey()}
+			if opts.secondaryIndexName != nil {
+				p.SecondaryKey = record.SecondaryIndexKey
+			}
+			return p
+		})
+	if err != nil {
+		ch <- GetResult{Err: err}
+		return
+	}
+
+	for i, record := range page {
+		gr := toGetResult(record, "", nil)
+		if i == len(page)-1 {
+			gr.ContinuationToken = continuationToken
+		}
+		ch <- gr
+	}
+}
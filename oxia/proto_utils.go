@@ -14,7 +14,11 @@
 
 package oxia
 
-import "github.com/oxia-db/oxia/proto"
+import (
+	"time"
+
+	"github.com/oxia-db/oxia/proto"
+)
 
 func toPutResult(originalKey string, r *proto.PutResponse) PutResult {
 	if err := toError(r.Status); err != nil {
@@ -43,6 +47,42 @@ func toDeleteRangeResult(r *proto.DeleteRangeResponse) error {
 	return toError(r.Status)
 }
 
+func toIncrementResult(r *proto.IncrementResponse) IncrementResult {
+	if err := toError(r.Status); err != nil {
+		return IncrementResult{
+			Err: err,
+		}
+	}
+	return IncrementResult{
+		Value:   r.Value,
+		Version: toVersion(r.Version),
+	}
+}
+
+func toAppendResult(key string, r *proto.AppendResponse) PutResult {
+	if err := toError(r.Status); err != nil {
+		return PutResult{
+			Err: err,
+		}
+	}
+	return PutResult{
+		Key:     key,
+		Version: toVersion(r.Version),
+	}
+}
+
+func toMergePatchResult(key string, r *proto.MergePatchResponse) PutResult {
+	if err := toError(r.Status); err != nil {
+		return PutResult{
+			Err: err,
+		}
+	}
+	return PutResult{
+		Key:     key,
+		Version: toVersion(r.Version),
+	}
+}
+
 func toGetResult(r *proto.GetResponse, originalKey string, err error) GetResult {
 	if err != nil {
 		return GetResult{Err: err}
@@ -75,6 +115,9 @@ func toVersion(version *proto.Version) Version {
 		ModifiedTimestamp:  version.ModifiedTimestamp,
 		Ephemeral:          version.SessionId != nil,
 	}
+	if version.ExpirationTimestamp != nil {
+		v.ExpirationTimestamp = *version.ExpirationTimestamp
+	}
 	if version.ClientIdentity != nil {
 		v.ClientIdentity = *version.ClientIdentity
 	}
@@ -93,11 +136,44 @@ func toError(status proto.Status) error {
 		return ErrUnexpectedVersionId
 	case proto.Status_KEY_NOT_FOUND:
 		return ErrKeyNotFound
+	case proto.Status_KEY_LOCKED:
+		return ErrKeyLocked
+	case proto.Status_UNEXPECTED_VALUE:
+		return ErrUnexpectedValue
+	case proto.Status_INVALID_NUMBER:
+		return ErrInvalidNumber
+	case proto.Status_VALUE_OUT_OF_BOUNDS:
+		return ErrValueOutOfBounds
+	case proto.Status_INVALID_JSON:
+		return ErrInvalidJSON
 	default:
 		return ErrUnknownStatus
 	}
 }
 
+func toExpectedRecordExists(opts *putOptions) *bool {
+	if !opts.expectedRecordExists {
+		return nil
+	}
+	return &opts.expectedRecordExists
+}
+
+func toTtlMs(opts *putOptions) *uint64 {
+	if opts.ttl == nil {
+		return nil
+	}
+	ttlMs := uint64(opts.ttl.Milliseconds())
+	return &ttlMs
+}
+
+func toMaxStalenessMs(maxStaleness *time.Duration) *uint64 {
+	if maxStaleness == nil {
+		return nil
+	}
+	maxStalenessMs := uint64(maxStaleness.Milliseconds())
+	return &maxStalenessMs
+}
+
 func toSecondaryIndexes(secondaryIndexes []*secondaryIdxOption) (res []*proto.SecondaryIndex) {
 	for _, si := range secondaryIndexes {
 		res = append(res, &proto.SecondaryIndex{
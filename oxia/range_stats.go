@@ -0,0 +1,100 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/oxia-db/oxia/common/compare"
+	"github.com/oxia-db/oxia/oxia/internal/batch"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func (c *clientImpl) RangeStats(ctx context.Context, minKeyInclusive string, maxKeyExclusive string,
+	options ...ListOption) <-chan RangeStatsResult {
+	ch := make(chan RangeStatsResult, 1)
+
+	opts := newListOptions(options)
+	if opts.limit != nil || opts.continuationToken != "" {
+		ch <- RangeStatsResult{Err: errors.Wrap(ErrInvalidOptions, "pagination is not supported for range stats")}
+		close(ch)
+		return ch
+	}
+
+	go func() {
+		defer close(ch)
+
+		shardIds := c.rangeShards(opts)
+		responses := make([]*proto.RangeStatsResponse, len(shardIds))
+		errs := make([]error, len(shardIds))
+		wg := sync.WaitGroup{}
+		for i, shardId := range shardIds {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				responses[i], errs[i] = c.doRangeStatsFromShard(ctx, &proto.RangeStatsRequest{
+					Shard:              &shardId,
+					StartInclusive:     minKeyInclusive,
+					EndExclusive:       maxKeyExclusive,
+					SecondaryIndexName: opts.secondaryIndexName,
+					MaxStalenessMs:     opts.maxStalenessMs(),
+					Linearizable:       opts.linearizable,
+				})
+			}()
+		}
+		wg.Wait()
+
+		if err := multierr.Combine(errs...); err != nil {
+			ch <- RangeStatsResult{Err: err}
+			return
+		}
+		ch <- RangeStatsResult{Stats: mergeRangeStats(responses)}
+	}()
+
+	return ch
+}
+
+func (c *clientImpl) doRangeStatsFromShard(ctx context.Context, request *proto.RangeStatsRequest) (*proto.RangeStatsResponse, error) {
+	res, err := c.executor.ExecuteRangeStats(ctx, request)
+	if err != nil && request.MaxStalenessMs != nil && batch.IsFollowerReadRejected(err) {
+		// The follower cannot serve the request, fall back to the leader
+		request.MaxStalenessMs = nil
+		return c.executor.ExecuteRangeStats(ctx, request)
+	}
+	return res, err
+}
+
+func mergeRangeStats(responses []*proto.RangeStatsResponse) RangeStats {
+	stats := RangeStats{}
+	for _, res := range responses {
+		if res.Count == 0 {
+			continue
+		}
+
+		if stats.Count == 0 || compare.CompareWithSlash([]byte(res.GetMinKey()), []byte(stats.MinKey)) < 0 {
+			stats.MinKey = res.GetMinKey()
+		}
+		if stats.Count == 0 || compare.CompareWithSlash([]byte(res.GetMaxKey()), []byte(stats.MaxKey)) > 0 {
+			stats.MaxKey = res.GetMaxKey()
+		}
+		stats.Count += res.Count
+		stats.TotalValueSize += res.TotalValueSize
+	}
+	return stats
+}
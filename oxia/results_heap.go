@@ -46,3 +46,12 @@ func (h *ResultHeap) Pop() any {
 	*h = old[0 : n-1]
 	return x
 }
+
+// reverseResultHeap pops the results with the highest key first.
+type reverseResultHeap struct {
+	*ResultHeap
+}
+
+func (h reverseResultHeap) Less(i, j int) bool {
+	return h.ResultHeap.Less(j, i)
+}
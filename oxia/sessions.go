@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
@@ -60,31 +61,36 @@ type sessions struct {
 	sessionsByShard map[int64]*clientSession
 	log             *slog.Logger
 	clientOpts      clientOptions
+
+	// The sessions taken over by shards that already had their own session,
+	// which are only kept alive to retain their ephemeral records
+	inheritedSessions []*clientSession
 }
 
 func (s *sessions) executeWithSessionId(shardId int64, callback func(int64, error)) {
 	s.Lock()
-	defer s.Unlock()
 	session, found := s.sessionsByShard[shardId]
+	if !found {
+		// The shard might have replaced a shard whose session is still kept
+		// alive, which must be taken over before the shard gets a new one
+		for _, cs := range s.sessionsByShard {
+			if slices.Contains(s.shardManager.ReplacedBy(cs.shardId), shardId) {
+				s.moveSession(cs)
+			}
+		}
+		session, found = s.sessionsByShard[shardId]
+	}
 	if !found {
 		session = s.startSession(shardId)
 		s.sessionsByShard[shardId] = session
 	}
+	s.Unlock()
+
 	session.executeWithId(callback)
 }
 
 func (s *sessions) startSession(shardId int64) *clientSession {
-	cs := &clientSession{
-		shardId:  shardId,
-		sessions: s,
-		started:  make(chan error),
-		log: slog.With(
-			slog.String("component", "session"),
-			slog.Int64("shard", shardId),
-		),
-	}
-
-	cs.ctx, cs.cancel = context.WithCancel(s.ctx)
+	cs := s.newClientSession(shardId)
 
 	cs.log.Debug("Creating session")
 	go process.DoWithLabels(
@@ -98,41 +104,109 @@ func (s *sessions) startSession(shardId int64) *clientSession {
 	return cs
 }
 
+// moveSession hands over the session of a shard that was split or merged to
+// the shards that replaced it. They retain the session, along with the
+// ephemeral records in their hash range. The session might have a different
+// id on a merged shard, which is resolved by its first heartbeat.
+func (s *sessions) moveSession(cs *clientSession) {
+	cs.Lock()
+	defer cs.Unlock()
+	if !cs.created || !s.remove(cs) {
+		return
+	}
+	cs.cancel()
+
+	for _, shardId := range s.shardManager.ReplacedBy(cs.shardId) {
+		inherited := s.newClientSession(shardId)
+		inherited.sessionId = cs.sessionId
+		inherited.sourceShard = &cs.shardId
+		inherited.Lock()
+		inherited.startKeepAlive()
+		inherited.Unlock()
+
+		if _, found := s.sessionsByShard[shardId]; found {
+			s.inheritedSessions = append(s.inheritedSessions, inherited)
+		} else {
+			s.sessionsByShard[shardId] = inherited
+		}
+	}
+}
+
+// remove forgets the session, returning false if it was already removed.
+func (s *sessions) remove(cs *clientSession) bool {
+	if s.sessionsByShard[cs.shardId] == cs {
+		delete(s.sessionsByShard, cs.shardId)
+		return true
+	}
+	if idx := slices.Index(s.inheritedSessions, cs); idx >= 0 {
+		s.inheritedSessions = slices.Delete(s.inheritedSessions, idx, idx+1)
+		return true
+	}
+	return false
+}
+
+func (s *sessions) newClientSession(shardId int64) *clientSession {
+	cs := &clientSession{
+		shardId:  shardId,
+		sessions: s,
+		started:  make(chan struct{}),
+		log: slog.With(
+			slog.String("component", "session"),
+			slog.Int64("shard", shardId),
+		),
+	}
+
+	cs.ctx, cs.cancel = context.WithCancel(s.ctx)
+	return cs
+}
+
 func (s *sessions) Close() error {
 	var err error
 	for _, cs := range s.sessionsByShard {
 		err = multierr.Append(err, cs.Close())
 	}
+	for _, cs := range s.inheritedSessions {
+		err = multierr.Append(err, cs.Close())
+	}
 
 	return err
 }
 
 type clientSession struct {
 	sync.Mutex
-	started   chan error
+	// Closed once the id of the session is known, or it failed to start
+	started   chan struct{}
+	startErr  error
 	shardId   int64
 	sessionId int64
-	log       *slog.Logger
-	sessions  *sessions
-	ctx       context.Context
-	cancel    context.CancelFunc
+	created   bool
+	// The shard the session was created on, when it was taken over by this
+	// shard and its id here is not resolved yet
+	sourceShard *int64
+	log         *slog.Logger
+	sessions    *sessions
+	ctx         context.Context
+	cancel      context.CancelFunc
 }
 
 func (cs *clientSession) executeWithId(callback func(int64, error)) {
 	select {
-	case err := <-cs.started:
+	case <-cs.started:
+		cs.Lock()
+		sessionId, err := cs.sessionId, cs.startErr
+		cs.Unlock()
 		if err != nil {
-			callback(-1, err)
 			cs.sessions.Lock()
-			defer cs.sessions.Unlock()
-			cs.Lock()
-			defer cs.Unlock()
-			delete(cs.sessions.sessionsByShard, cs.shardId)
-		} else {
 			cs.Lock()
-			callback(cs.sessionId, nil)
+			cs.sessions.remove(cs)
 			cs.Unlock()
+			cs.sessions.Unlock()
+
+			// The callback might retry the operation on a new session
+			callback(-1, err)
+			return
 		}
+		callback(sessionId, nil)
 	case <-cs.ctx.Done():
 		if cs.ctx.Err() != nil && !errors.Is(cs.ctx.Err(), context.Canceled) {
 			callback(-1, cs.ctx.Err())
@@ -154,15 +228,25 @@ func (cs *clientSession) createSessionWithRetries() {
 		})
 	if err != nil && !errors.Is(err, context.Canceled) {
 		cs.Lock()
-		cs.started <- err
-		close(cs.started)
+		cs.setStarted(err)
 		cs.Unlock()
 	}
 }
 
+// setStarted lets the operations waiting for the session proceed, or fail
+// with the given error. It must be called with the session lock held.
+func (cs *clientSession) setStarted(err error) {
+	cs.startErr = err
+	close(cs.started)
+}
+
 func (cs *clientSession) createSession() error {
 	client, err := cs.getRpc()
-	if err != nil {
+	if errors.Is(err, internal.ErrShardNotFound) {
+		// The shard was split or merged, the request will be retried on the
+		// new shard
+		return backoff.Permanent(err)
+	} else if err != nil {
 		return err
 	}
 	ctx, cancel := context.WithTimeout(cs.ctx, cs.sessions.clientOpts.requestTimeout)
@@ -175,16 +259,24 @@ func (cs *clientSession) createSession() error {
 	if err != nil {
 		return err
 	}
-	sessionId := createSessionResponse.SessionId
 	cs.Lock()
 	defer cs.Unlock()
-	cs.sessionId = sessionId
+	cs.sessionId = createSessionResponse.SessionId
+	cs.startKeepAlive()
+	cs.log.Debug("Successfully created session")
+	return nil
+}
+
+// startKeepAlive keeps the session alive until it is closed. The session is
+// started right away, unless it was taken over from another shard and its id
+// must be resolved first. It must be called with the session lock held.
+func (cs *clientSession) startKeepAlive() {
 	cs.log = cs.log.With(
-		slog.Int64("session-id", sessionId),
 		slog.String("client-identity", cs.sessions.clientIdentity),
 	)
-	close(cs.started)
-	cs.log.Debug("Successfully created session")
+	if cs.sourceShard == nil {
+		cs.setCreated()
+	}
 
 	go process.DoWithLabels(
 		cs.ctx,
@@ -197,17 +289,28 @@ func (cs *clientSession) createSession() error {
 			backOff := time2.NewBackOff(cs.sessions.ctx)
 			err := backoff.RetryNotify(func() error {
 				err := cs.keepAlive()
+				if errors.Is(err, internal.ErrShardNotFound) {
+					cs.failTakeOver(err)
+
+					// The shard was split or merged, the session is kept
+					// alive on the new shards
+					cs.sessions.Lock()
+					defer cs.sessions.Unlock()
+					cs.sessions.moveSession(cs)
+					return backoff.Permanent(err)
+				}
 				if status.Code(err) == constant.CodeSessionNotFound {
 					cs.log.Error(
 						"Session is no longer valid",
 						slog.Any("error", err),
 					)
+					cs.failTakeOver(err)
 
 					cs.sessions.Lock()
 					defer cs.sessions.Unlock()
 					cs.Lock()
 					defer cs.Unlock()
-					delete(cs.sessions.sessionsByShard, cs.shardId)
+					cs.sessions.remove(cs)
 					return backoff.Permanent(err)
 				}
 				return err
@@ -219,7 +322,7 @@ func (cs *clientSession) createSession() error {
 				)
 			})
 
-			if err != nil && !errors.Is(err, context.Canceled) {
+			if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, internal.ErrShardNotFound) {
 				cs.log.Error(
 					"Failed to keep alive session",
 					slog.Any("error", err),
@@ -227,20 +330,66 @@ func (cs *clientSession) createSession() error {
 			}
 		},
 	)
+}
 
-	return nil
+// setCreated starts the session once its id is known. It must be called with
+// the session lock held.
+func (cs *clientSession) setCreated() {
+	cs.created = true
+	cs.log = cs.log.With(slog.Int64("session-id", cs.sessionId))
+	cs.setStarted(nil)
+}
+
+// takeOver records the id of a session taken over from another shard, as
+// resolved by this shard.
+func (cs *clientSession) takeOver(sessionId int64) {
+	cs.Lock()
+	defer cs.Unlock()
+	cs.log.Info(
+		"Took over the session of the replaced shard",
+		slog.Int64("replaced-shard", *cs.sourceShard),
+		slog.Int64("replaced-session-id", cs.sessionId),
+	)
+	cs.sessionId = sessionId
+	cs.sourceShard = nil
+	cs.setCreated()
+}
+
+// failTakeOver fails the operations waiting for a session taken over from
+// another shard, if its id could not be resolved.
+func (cs *clientSession) failTakeOver(err error) {
+	cs.Lock()
+	defer cs.Unlock()
+	if cs.sourceShard != nil {
+		cs.sourceShard = nil
+		cs.setStarted(err)
+	}
 }
 
 func (cs *clientSession) getRpc() (proto.OxiaClientClient, error) {
 	leader := cs.sessions.shardManager.Leader(cs.shardId)
+	if leader == "" && !slices.Contains(cs.sessions.shardManager.GetAll(), cs.shardId) {
+		return nil, internal.ErrShardNotFound
+	}
 	return cs.sessions.pool.GetClientRpc(leader)
 }
 
 func (cs *clientSession) Close() error {
 	cs.cancel()
 
+	cs.Lock()
+	sessionId, created := cs.sessionId, cs.created
+	cs.Unlock()
+	if !created {
+		// The id of the session on the shard is not known, it will expire
+		return nil
+	}
+
 	client, err := cs.getRpc()
-	if err != nil {
+	if errors.Is(err, internal.ErrShardNotFound) {
+		// The session went away with the shard
+		return nil
+	} else if err != nil {
 		return err
 	}
 	ctx, cancel := context.WithTimeout(cs.sessions.ctx, cs.sessions.clientOpts.requestTimeout)
@@ -248,7 +397,7 @@ func (cs *clientSession) Close() error {
 
 	if _, err = client.CloseSession(ctx, &proto.CloseSessionRequest{
 		Shard:     cs.shardId,
-		SessionId: cs.sessionId,
+		SessionId: sessionId,
 	}); err != nil {
 		return err
 	}
@@ -256,13 +405,12 @@ func (cs *clientSession) Close() error {
 }
 
 func (cs *clientSession) keepAlive() error {
-	cs.sessions.Lock()
 	cs.Lock()
 	ctx := cs.ctx
 	shardId := cs.shardId
 	sessionId := cs.sessionId
+	sourceShard := cs.sourceShard
 	cs.Unlock()
-	cs.sessions.Unlock()
 
 	tickTime := cs.sessions.clientOpts.sessionKeepAliveTicker
 	if tickTime < 2*time.Second {
@@ -272,20 +420,42 @@ func (cs *clientSession) keepAlive() error {
 	ticker := time.NewTicker(tickTime)
 	defer ticker.Stop()
 
+	shardsChanged := cs.sessions.shardManager.ShardsChanged()
 	client, err := cs.getRpc()
 	if err != nil {
 		return err
 	}
 
+	// The first heartbeat is sent right away, since the new shards of a split
+	// or merge expire the sessions they retain unless they are taken over
+	// within the session timeout
+	heartbeat := make(chan time.Time, 1)
+	heartbeat <- time.Now()
 	for {
 		select {
+		case <-heartbeat:
 		case <-ticker.C:
-			_, err = client.KeepAlive(ctx, &proto.SessionHeartbeat{Shard: shardId, SessionId: sessionId})
-			if err != nil {
-				return err
+		case <-shardsChanged:
+			if !slices.Contains(cs.sessions.shardManager.GetAll(), shardId) {
+				return internal.ErrShardNotFound
 			}
+			shardsChanged = cs.sessions.shardManager.ShardsChanged()
+			continue
 		case <-ctx.Done():
 			return nil
 		}
+
+		res, err := client.KeepAlive(ctx, &proto.SessionHeartbeat{
+			Shard:       shardId,
+			SessionId:   sessionId,
+			SourceShard: sourceShard,
+		})
+		if err != nil {
+			return err
+		}
+		if sourceShard != nil {
+			sessionId, sourceShard = res.SessionId, nil
+			cs.takeOver(sessionId)
+		}
 	}
 }
@@ -54,6 +54,14 @@ func newSyncClient(asyncClient AsyncClient) SyncClient {
 	}
 }
 
+func (c *syncClientImpl) Namespace(namespace string) (SyncClient, error) {
+	asyncClient, err := c.asyncClient.Namespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return newSyncClient(asyncClient), nil
+}
+
 func (c *syncClientImpl) getCacheManager() (*cacheManager, error) {
 	c.Lock()
 	defer c.Unlock()
@@ -105,6 +113,33 @@ func (c *syncClientImpl) DeleteRange(ctx context.Context, minKeyInclusive string
 	}
 }
 
+func (c *syncClientImpl) Increment(ctx context.Context, key string, delta int64, options ...IncrementOption) (int64, Version, error) {
+	select {
+	case r := <-c.asyncClient.Increment(key, delta, options...):
+		return r.Value, r.Version, r.Err
+	case <-ctx.Done():
+		return 0, Version{}, ctx.Err()
+	}
+}
+
+func (c *syncClientImpl) Append(ctx context.Context, key string, value []byte, options ...AppendOption) (Version, error) {
+	select {
+	case r := <-c.asyncClient.Append(key, value, options...):
+		return r.Version, r.Err
+	case <-ctx.Done():
+		return Version{}, ctx.Err()
+	}
+}
+
+func (c *syncClientImpl) MergePatch(ctx context.Context, key string, patch []byte, options ...MergePatchOption) (Version, error) {
+	select {
+	case r := <-c.asyncClient.MergePatch(key, patch, options...):
+		return r.Version, r.Err
+	case <-ctx.Done():
+		return Version{}, ctx.Err()
+	}
+}
+
 func (c *syncClientImpl) Get(ctx context.Context, key string, options ...GetOption) (string, []byte, Version, error) {
 	select {
 	case r := <-c.asyncClient.Get(key, options...):
@@ -133,10 +168,23 @@ func (c *syncClientImpl) RangeScan(ctx context.Context, minKeyInclusive string,
 	return c.asyncClient.RangeScan(ctx, minKeyInclusive, maxKeyExclusive, options...)
 }
 
+func (c *syncClientImpl) RangeStats(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, options ...ListOption) (RangeStats, error) {
+	r := <-c.asyncClient.RangeStats(ctx, minKeyInclusive, maxKeyExclusive, options...)
+	return r.Stats, r.Err
+}
+
 func (c *syncClientImpl) GetSequenceUpdates(ctx context.Context, prefixKey string, options ...GetSequenceUpdatesOption) (<-chan string, error) {
 	return c.asyncClient.GetSequenceUpdates(ctx, prefixKey, options...)
 }
 
-func (c *syncClientImpl) GetNotifications() (Notifications, error) {
-	return c.asyncClient.GetNotifications()
+func (c *syncClientImpl) GetNotifications(options ...NotificationsOption) (Notifications, error) {
+	return c.asyncClient.GetNotifications(options...)
+}
+
+func (c *syncClientImpl) Begin(options ...TransactionOption) Transaction {
+	return c.asyncClient.Begin(options...)
+}
+
+func (c *syncClientImpl) NewAtomicBatch() AtomicBatch {
+	return c.asyncClient.NewAtomicBatch()
 }
@@ -46,6 +46,22 @@ func (c *neverCompleteAsyncClient) DeleteRange(minKeyInclusive string, maxKeyExc
 	return make(chan error)
 }
 
+func (c *neverCompleteAsyncClient) Increment(key string, delta int64, options ...IncrementOption) <-chan IncrementResult {
+	return make(chan IncrementResult)
+}
+
+func (c *neverCompleteAsyncClient) Append(key string, value []byte, options ...AppendOption) <-chan PutResult {
+	return make(chan PutResult)
+}
+
+func (c *neverCompleteAsyncClient) MergePatch(key string, patch []byte, options ...MergePatchOption) <-chan PutResult {
+	return make(chan PutResult)
+}
+
+func (c *neverCompleteAsyncClient) Namespace(namespace string) (AsyncClient, error) {
+	return c, nil
+}
+
 func (c *neverCompleteAsyncClient) Get(key string, options ...GetOption) <-chan GetResult {
 	return make(chan GetResult)
 }
@@ -58,7 +74,11 @@ func (c *neverCompleteAsyncClient) RangeScan(ctx context.Context, minKeyInclusiv
 	panic("not implemented")
 }
 
-func (c *neverCompleteAsyncClient) GetNotifications() (Notifications, error) {
+func (c *neverCompleteAsyncClient) RangeStats(ctx context.Context, minKeyInclusive string, maxKeyExclusive string, options ...ListOption) <-chan RangeStatsResult {
+	panic("not implemented")
+}
+
+func (c *neverCompleteAsyncClient) GetNotifications(...NotificationsOption) (Notifications, error) {
 	panic("not implemented")
 }
 
@@ -66,6 +86,14 @@ func (c *neverCompleteAsyncClient) GetSequenceUpdates(ctx context.Context, prefi
 	panic("not implemented")
 }
 
+func (c *neverCompleteAsyncClient) Begin(options ...TransactionOption) Transaction {
+	panic("not implemented")
+}
+
+func (c *neverCompleteAsyncClient) NewAtomicBatch() AtomicBatch {
+	panic("not implemented")
+}
+
 func TestCancelContext(t *testing.T) {
 	_asyncClient := &neverCompleteAsyncClient{}
 	syncClient := newSyncClient(_asyncClient)
@@ -80,6 +108,18 @@ func TestCancelContext(t *testing.T) {
 	assertCancellable(t, func(ctx context.Context) error {
 		return syncClient.DeleteRange(ctx, "/a", "/b")
 	})
+	assertCancellable(t, func(ctx context.Context) error {
+		_, _, err := syncClient.Increment(ctx, "/a", 1)
+		return err
+	})
+	assertCancellable(t, func(ctx context.Context) error {
+		_, err := syncClient.Append(ctx, "/a", []byte{})
+		return err
+	})
+	assertCancellable(t, func(ctx context.Context) error {
+		_, err := syncClient.MergePatch(ctx, "/a", []byte("{}"))
+		return err
+	})
 	assertCancellable(t, func(ctx context.Context) error {
 		_, _, _, err := syncClient.Get(ctx, "/a")
 		return err
@@ -0,0 +1,262 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/common/concurrent"
+	"github.com/oxia-db/oxia/common/hash"
+	"github.com/oxia-db/oxia/proto"
+)
+
+type transaction struct {
+	sync.Mutex
+
+	client   *clientImpl
+	options  *transactionOptions
+	requests map[int64]*proto.PrepareTransactionRequest
+	// The hash of a record of each shard, which identifies the shard even
+	// after it is split or merged
+	hashes    map[int64]uint32
+	completed bool
+}
+
+func (c *clientImpl) Begin(options ...TransactionOption) Transaction {
+	return &transaction{
+		client:   c,
+		options:  newTransactionOptions(options),
+		requests: make(map[int64]*proto.PrepareTransactionRequest),
+		hashes:   make(map[int64]uint32),
+	}
+}
+
+func (t *transaction) Put(key string, value []byte, options ...PutOption) error {
+	opts, err := newPutOptions(options)
+	if err != nil {
+		return err
+	}
+	if opts.ephemeral || len(opts.sequenceKeysDeltas) > 0 {
+		return errors.Wrap(ErrInvalidOptions, "ephemeral records and sequential keys are not supported in transactions")
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	if t.completed {
+		return ErrTransactionCompleted
+	}
+
+	request := t.request(key, opts)
+	request.Puts = append(request.Puts, &proto.PutRequest{
+		Key:                  key,
+		Value:                value,
+		ExpectedVersionId:    opts.expectedVersion,
+		ExpectedRecordExists: toExpectedRecordExists(opts),
+		ExpectedValueHash:    opts.expectedValueHash,
+		PartitionKey:         opts.partitionKey,
+		SecondaryIndexes:     toSecondaryIndexes(opts.secondaryIndexes),
+		TtlMs:                toTtlMs(opts),
+	})
+	return nil
+}
+
+func (t *transaction) Delete(key string, options ...DeleteOption) error {
+	opts := newDeleteOptions(options)
+
+	t.Lock()
+	defer t.Unlock()
+	if t.completed {
+		return ErrTransactionCompleted
+	}
+
+	request := t.request(key, opts)
+	request.Deletes = append(request.Deletes, &proto.DeleteRequest{
+		Key:               key,
+		ExpectedVersionId: opts.expectedVersion,
+		ExpectedValueHash: opts.expectedValueHash,
+	})
+	return nil
+}
+
+func (t *transaction) request(key string, opts baseOptionsIf) *proto.PrepareTransactionRequest {
+	shardId := t.client.getShardForKey(key, opts)
+	request, ok := t.requests[shardId]
+	if !ok {
+		request = &proto.PrepareTransactionRequest{Shard: shardId}
+		t.requests[shardId] = request
+
+		routingKey := key
+		if opts.PartitionKey() != nil {
+			routingKey = *opts.PartitionKey()
+		}
+		t.hashes[shardId] = hash.Xxh332(routingKey)
+	}
+	return request
+}
+
+// Commit performs the two-phase commit of the transaction.
+//
+// The operations are first prepared on all the shards, then the transaction
+// is committed on the primary shard, which is the one with the lowest id. Once
+// the primary shard has committed, the transaction is considered committed and
+// the outcome cannot change anymore. If the client is not able to commit on the
+// other shards, they will resolve the transaction with the primary shard after
+// it expires.
+func (t *transaction) Commit(ctx context.Context) error {
+	t.Lock()
+	defer t.Unlock()
+	if t.completed {
+		return ErrTransactionCompleted
+	}
+	t.completed = true
+
+	if len(t.requests) == 0 {
+		return nil
+	}
+
+	transactionId := uuid.NewString()
+	shardIds := slices.Sorted(maps.Keys(t.requests))
+	primaryShard := shardIds[0]
+	primaryHash := t.hashes[primaryShard]
+	for _, request := range t.requests {
+		request.TransactionId = transactionId
+		request.PrimaryShard = primaryShard
+		request.PrimaryHash = &primaryHash
+		request.TimeoutMs = uint32(t.options.timeout.Milliseconds())
+	}
+
+	if err := t.prepare(ctx); err != nil {
+		t.abort(transactionId, shardIds)
+		return err
+	}
+
+	res, err := executeWithRetries(ctx, t.client, primaryShard, func(ctx context.Context) (*proto.TransactionResponse, error) {
+		return t.client.executor.ExecuteCommitTransaction(ctx, &proto.CommitTransactionRequest{
+			Shard:         primaryShard,
+			TransactionId: transactionId,
+		})
+	})
+	if err != nil {
+		// The outcome is not known. The transaction will be resolved by the
+		// servers once it expires
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+	if res.Status != proto.TransactionStatus_COMMITTED {
+		t.abort(transactionId, shardIds[1:])
+		return ErrTransactionAborted
+	}
+
+	t.commitParticipants(ctx, transactionId, shardIds[1:])
+	return nil
+}
+
+func (t *transaction) prepare(ctx context.Context) error {
+	wg := concurrent.NewWaitGroup(len(t.requests))
+	for shardId, request := range t.requests {
+		go func() {
+			res, err := executeWithRetries(ctx, t.client, shardId, func(ctx context.Context) (*proto.TransactionResponse, error) {
+				return t.client.executor.ExecutePrepareTransaction(ctx, request)
+			})
+			switch {
+			case err != nil:
+				wg.Fail(err)
+			case res.Status != proto.TransactionStatus_PENDING:
+				wg.Fail(toTransactionError(res))
+			default:
+				wg.Done()
+			}
+		}()
+	}
+	return wg.Wait(ctx)
+}
+
+func (t *transaction) commitParticipants(ctx context.Context, transactionId string, shardIds []int64) {
+	wg := concurrent.NewWaitGroup(len(shardIds))
+	for _, shardId := range shardIds {
+		go func() {
+			_, err := executeWithRetries(ctx, t.client, shardId, func(ctx context.Context) (*proto.TransactionResponse, error) {
+				return t.client.executor.ExecuteCommitTransaction(ctx, &proto.CommitTransactionRequest{
+					Shard:         shardId,
+					TransactionId: transactionId,
+				})
+			})
+			if err != nil {
+				// The transaction is already committed on the primary shard, it
+				// will be committed on this shard as well once it expires
+				slog.Warn(
+					"Failed to commit transaction on participant shard",
+					slog.String("namespace", t.client.options.namespace),
+					slog.Int64("shard", shardId),
+					slog.String("transaction-id", transactionId),
+					slog.Any("error", err),
+				)
+			}
+			wg.Done()
+		}()
+	}
+	_ = wg.Wait(ctx)
+}
+
+// abort is a best-effort attempt to release the records locked by the
+// transaction. If it fails, the transaction will be aborted by the servers
+// once it expires.
+func (t *transaction) abort(transactionId string, shardIds []int64) {
+	ctx, cancel := context.WithTimeout(t.client.ctx, t.client.options.requestTimeout)
+	defer cancel()
+
+	wg := concurrent.NewWaitGroup(len(shardIds))
+	for _, shardId := range shardIds {
+		go func() {
+			_, err := t.client.executor.ExecuteAbortTransaction(ctx, &proto.AbortTransactionRequest{
+				Shard:         shardId,
+				TransactionId: transactionId,
+			})
+			if err != nil {
+				slog.Debug(
+					"Failed to abort transaction",
+					slog.String("namespace", t.client.options.namespace),
+					slog.Int64("shard", shardId),
+					slog.String("transaction-id", transactionId),
+					slog.Any("error", err),
+				)
+			}
+			wg.Done()
+		}()
+	}
+	_ = wg.Wait(ctx)
+}
+
+// toTransactionError returns the error of the first operation that prevented
+// the transaction from being prepared.
+func toTransactionError(res *proto.TransactionResponse) error {
+	for _, put := range res.Puts {
+		if err := toError(put.Status); err != nil {
+			return err
+		}
+	}
+	for _, del := range res.Deletes {
+		if err := toError(del.Status); err != nil {
+			return err
+		}
+	}
+	return ErrTransactionAborted
+}
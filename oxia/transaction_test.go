@@ -0,0 +1,90 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oxia
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxia-db/oxia/server"
+)
+
+func TestSyncClientImpl_Transaction(t *testing.T) {
+	config := server.NewTestConfig(t.TempDir())
+	// Test with multiple shards to ensure the transaction spans across shards
+	config.NumShards = 10
+	standaloneServer, err := server.NewStandalone(config)
+	assert.NoError(t, err)
+
+	client, err := NewSyncClient(standaloneServer.ServiceAddr())
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, version, err := client.Put(ctx, "/to-delete", []byte("x"))
+	assert.NoError(t, err)
+
+	txn := client.Begin()
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, txn.Put(fmt.Sprintf("/key-%d", i), []byte(fmt.Sprintf("%d", i)), ExpectedRecordNotExists()))
+	}
+	assert.NoError(t, txn.Delete("/to-delete", ExpectedVersionId(version.VersionId)))
+	assert.NoError(t, txn.Commit(ctx))
+
+	for i := 0; i < 10; i++ {
+		_, value, _, err := client.Get(ctx, fmt.Sprintf("/key-%d", i))
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%d", i), string(value))
+	}
+	_, _, _, err = client.Get(ctx, "/to-delete")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+
+	// A completed transaction cannot be reused
+	assert.ErrorIs(t, txn.Put("/key-0", []byte("x")), ErrTransactionCompleted)
+	assert.ErrorIs(t, txn.Commit(ctx), ErrTransactionCompleted)
+
+	// If any condition fails, none of the operations is applied
+	txn = client.Begin()
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, txn.Put(fmt.Sprintf("/key-%d", i), []byte("updated"), ExpectedRecordNotExists()))
+	}
+	assert.NoError(t, txn.Put("/new-key", []byte("x")))
+	assert.ErrorIs(t, txn.Commit(ctx), ErrUnexpectedVersionId)
+
+	_, _, _, err = client.Get(ctx, "/new-key")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	for i := 0; i < 10; i++ {
+		_, value, _, err := client.Get(ctx, fmt.Sprintf("/key-%d", i))
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%d", i), string(value))
+	}
+
+	// The records are unlocked after the abort
+	_, _, err = client.Put(ctx, "/key-0", []byte("y"))
+	assert.NoError(t, err)
+
+	// Ephemeral records are not supported
+	txn = client.Begin()
+	assert.ErrorIs(t, txn.Put("/ephemeral", []byte("x"), Ephemeral()), ErrInvalidOptions)
+
+	// An empty transaction is a no-op
+	assert.NoError(t, client.Begin().Commit(ctx))
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, standaloneServer.Close())
+}
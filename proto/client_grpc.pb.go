@@ -65,6 +65,13 @@ type OxiaClientClient interface {
 	// unless a particular partition key was specified.
 	RangeScan(ctx context.Context, in *RangeScanRequest, opts ...grpc.CallOption) (OxiaClient_RangeScanClient, error)
 	// *
+	// Computes the aggregated statistics of the records between a range of
+	// keys, such as their count and their total size.
+	//
+	// Clients should send an equivalent request to all respective shards,
+	// unless a particular partition key was specified, and merge the responses.
+	RangeStats(ctx context.Context, in *RangeStatsRequest, opts ...grpc.CallOption) (*RangeStatsResponse, error)
+	// *
 	// Requests all the records between a range of keys.
 	GetSequenceUpdates(ctx context.Context, in *GetSequenceUpdatesRequest, opts ...grpc.CallOption) (OxiaClient_GetSequenceUpdatesClient, error)
 	GetNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (OxiaClient_GetNotificationsClient, error)
@@ -75,6 +82,19 @@ type OxiaClientClient interface {
 	KeepAlive(ctx context.Context, in *SessionHeartbeat, opts ...grpc.CallOption) (*KeepAliveResponse, error)
 	// Closes a session and removes all ephemeral values associated with it.
 	CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error)
+	// Stages the operations of a multi-shard transaction on a shard. The
+	// records touched by the transaction are locked until it is either
+	// committed or aborted. The locks only reject the writes: the reads keep
+	// returning the last committed value of the records.
+	PrepareTransaction(ctx context.Context, in *PrepareTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	// Applies the operations staged on a shard for a transaction.
+	//
+	// Clients must commit the primary shard first, since its outcome decides
+	// the outcome of the whole transaction.
+	CommitTransaction(ctx context.Context, in *CommitTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	// Discards the operations staged on a shard for a transaction. If the
+	// transaction was already committed, the committed status is returned.
+	AbortTransaction(ctx context.Context, in *AbortTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 }
 
 type oxiaClientClient struct {
@@ -253,6 +273,15 @@ func (x *oxiaClientRangeScanClient) Recv() (*RangeScanResponse, error) {
 	return m, nil
 }
 
+func (c *oxiaClientClient) RangeStats(ctx context.Context, in *RangeStatsRequest, opts ...grpc.CallOption) (*RangeStatsResponse, error) {
+	out := new(RangeStatsResponse)
+	err := c.cc.Invoke(ctx, "/io.oxia.proto.v1.OxiaClient/RangeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *oxiaClientClient) GetSequenceUpdates(ctx context.Context, in *GetSequenceUpdatesRequest, opts ...grpc.CallOption) (OxiaClient_GetSequenceUpdatesClient, error) {
 	stream, err := c.cc.NewStream(ctx, &OxiaClient_ServiceDesc.Streams[5], "/io.oxia.proto.v1.OxiaClient/GetSequenceUpdates", opts...)
 	if err != nil {
@@ -344,6 +373,33 @@ func (c *oxiaClientClient) CloseSession(ctx context.Context, in *CloseSessionReq
 	return out, nil
 }
 
+func (c *oxiaClientClient) PrepareTransaction(ctx context.Context, in *PrepareTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, "/io.oxia.proto.v1.OxiaClient/PrepareTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oxiaClientClient) CommitTransaction(ctx context.Context, in *CommitTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, "/io.oxia.proto.v1.OxiaClient/CommitTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oxiaClientClient) AbortTransaction(ctx context.Context, in *AbortTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, "/io.oxia.proto.v1.OxiaClient/AbortTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OxiaClientServer is the server API for OxiaClient service.
 // All implementations must embed UnimplementedOxiaClientServer
 // for forward compatibility
@@ -391,6 +447,13 @@ type OxiaClientServer interface {
 	// unless a particular partition key was specified.
 	RangeScan(*RangeScanRequest, OxiaClient_RangeScanServer) error
 	// *
+	// Computes the aggregated statistics of the records between a range of
+	// keys, such as their count and their total size.
+	//
+	// Clients should send an equivalent request to all respective shards,
+	// unless a particular partition key was specified, and merge the responses.
+	RangeStats(context.Context, *RangeStatsRequest) (*RangeStatsResponse, error)
+	// *
 	// Requests all the records between a range of keys.
 	GetSequenceUpdates(*GetSequenceUpdatesRequest, OxiaClient_GetSequenceUpdatesServer) error
 	GetNotifications(*NotificationsRequest, OxiaClient_GetNotificationsServer) error
@@ -401,6 +464,19 @@ type OxiaClientServer interface {
 	KeepAlive(context.Context, *SessionHeartbeat) (*KeepAliveResponse, error)
 	// Closes a session and removes all ephemeral values associated with it.
 	CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error)
+	// Stages the operations of a multi-shard transaction on a shard. The
+	// records touched by the transaction are locked until it is either
+	// committed or aborted. The locks only reject the writes: the reads keep
+	// returning the last committed value of the records.
+	PrepareTransaction(context.Context, *PrepareTransactionRequest) (*TransactionResponse, error)
+	// Applies the operations staged on a shard for a transaction.
+	//
+	// Clients must commit the primary shard first, since its outcome decides
+	// the outcome of the whole transaction.
+	CommitTransaction(context.Context, *CommitTransactionRequest) (*TransactionResponse, error)
+	// Discards the operations staged on a shard for a transaction. If the
+	// transaction was already committed, the committed status is returned.
+	AbortTransaction(context.Context, *AbortTransactionRequest) (*TransactionResponse, error)
 	mustEmbedUnimplementedOxiaClientServer()
 }
 
@@ -426,6 +502,9 @@ func (UnimplementedOxiaClientServer) List(*ListRequest, OxiaClient_ListServer) e
 func (UnimplementedOxiaClientServer) RangeScan(*RangeScanRequest, OxiaClient_RangeScanServer) error {
 	return status.Errorf(codes.Unimplemented, "method RangeScan not implemented")
 }
+func (UnimplementedOxiaClientServer) RangeStats(context.Context, *RangeStatsRequest) (*RangeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeStats not implemented")
+}
 func (UnimplementedOxiaClientServer) GetSequenceUpdates(*GetSequenceUpdatesRequest, OxiaClient_GetSequenceUpdatesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetSequenceUpdates not implemented")
 }
@@ -441,6 +520,15 @@ func (UnimplementedOxiaClientServer) KeepAlive(context.Context, *SessionHeartbea
 func (UnimplementedOxiaClientServer) CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseSession not implemented")
 }
+func (UnimplementedOxiaClientServer) PrepareTransaction(context.Context, *PrepareTransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareTransaction not implemented")
+}
+func (UnimplementedOxiaClientServer) CommitTransaction(context.Context, *CommitTransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitTransaction not implemented")
+}
+func (UnimplementedOxiaClientServer) AbortTransaction(context.Context, *AbortTransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortTransaction not implemented")
+}
 func (UnimplementedOxiaClientServer) mustEmbedUnimplementedOxiaClientServer() {}
 
 // UnsafeOxiaClientServer may be embedded to opt out of forward compatibility for this service.
@@ -582,6 +670,24 @@ func (x *oxiaClientRangeScanServer) Send(m *RangeScanResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _OxiaClient_RangeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OxiaClientServer).RangeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.oxia.proto.v1.OxiaClient/RangeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OxiaClientServer).RangeStats(ctx, req.(*RangeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OxiaClient_GetSequenceUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetSequenceUpdatesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -678,6 +784,60 @@ func _OxiaClient_CloseSession_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OxiaClient_PrepareTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OxiaClientServer).PrepareTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.oxia.proto.v1.OxiaClient/PrepareTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OxiaClientServer).PrepareTransaction(ctx, req.(*PrepareTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OxiaClient_CommitTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OxiaClientServer).CommitTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.oxia.proto.v1.OxiaClient/CommitTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OxiaClientServer).CommitTransaction(ctx, req.(*CommitTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OxiaClient_AbortTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OxiaClientServer).AbortTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.oxia.proto.v1.OxiaClient/AbortTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OxiaClientServer).AbortTransaction(ctx, req.(*AbortTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OxiaClient_ServiceDesc is the grpc.ServiceDesc for OxiaClient service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -689,6 +849,10 @@ var OxiaClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Write",
 			Handler:    _OxiaClient_Write_Handler,
 		},
+		{
+			MethodName: "RangeStats",
+			Handler:    _OxiaClient_RangeStats_Handler,
+		},
 		{
 			MethodName: "CreateSession",
 			Handler:    _OxiaClient_CreateSession_Handler,
@@ -701,6 +865,18 @@ var OxiaClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseSession",
 			Handler:    _OxiaClient_CloseSession_Handler,
 		},
+		{
+			MethodName: "PrepareTransaction",
+			Handler:    _OxiaClient_PrepareTransaction_Handler,
+		},
+		{
+			MethodName: "CommitTransaction",
+			Handler:    _OxiaClient_CommitTransaction_Handler,
+		},
+		{
+			MethodName: "AbortTransaction",
+			Handler:    _OxiaClient_AbortTransaction_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
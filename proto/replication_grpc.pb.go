@@ -28,6 +28,11 @@ type OxiaCoordinationClient interface {
 	AddFollower(ctx context.Context, in *AddFollowerRequest, opts ...grpc.CallOption) (*AddFollowerResponse, error)
 	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
 	DeleteShard(ctx context.Context, in *DeleteShardRequest, opts ...grpc.CallOption) (*DeleteShardResponse, error)
+	SplitShard(ctx context.Context, in *SplitShardRequest, opts ...grpc.CallOption) (*SplitShardResponse, error)
+	MergeShard(ctx context.Context, in *MergeShardRequest, opts ...grpc.CallOption) (*MergeShardResponse, error)
+	TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*TransferLeadershipResponse, error)
+	ListShardSessions(ctx context.Context, in *ListShardSessionsRequest, opts ...grpc.CallOption) (*ListShardSessionsResponse, error)
+	RevokeShardSession(ctx context.Context, in *RevokeShardSessionRequest, opts ...grpc.CallOption) (*RevokeShardSessionResponse, error)
 }
 
 type oxiaCoordinationClient struct {
@@ -117,6 +122,51 @@ func (c *oxiaCoordinationClient) DeleteShard(ctx context.Context, in *DeleteShar
 	return out, nil
 }
 
+func (c *oxiaCoordinationClient) SplitShard(ctx context.Context, in *SplitShardRequest, opts ...grpc.CallOption) (*SplitShardResponse, error) {
+	out := new(SplitShardResponse)
+	err := c.cc.Invoke(ctx, "/replication.OxiaCoordination/SplitShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oxiaCoordinationClient) MergeShard(ctx context.Context, in *MergeShardRequest, opts ...grpc.CallOption) (*MergeShardResponse, error) {
+	out := new(MergeShardResponse)
+	err := c.cc.Invoke(ctx, "/replication.OxiaCoordination/MergeShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oxiaCoordinationClient) TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*TransferLeadershipResponse, error) {
+	out := new(TransferLeadershipResponse)
+	err := c.cc.Invoke(ctx, "/replication.OxiaCoordination/TransferLeadership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oxiaCoordinationClient) ListShardSessions(ctx context.Context, in *ListShardSessionsRequest, opts ...grpc.CallOption) (*ListShardSessionsResponse, error) {
+	out := new(ListShardSessionsResponse)
+	err := c.cc.Invoke(ctx, "/replication.OxiaCoordination/ListShardSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oxiaCoordinationClient) RevokeShardSession(ctx context.Context, in *RevokeShardSessionRequest, opts ...grpc.CallOption) (*RevokeShardSessionResponse, error) {
+	out := new(RevokeShardSessionResponse)
+	err := c.cc.Invoke(ctx, "/replication.OxiaCoordination/RevokeShardSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OxiaCoordinationServer is the server API for OxiaCoordination service.
 // All implementations must embed UnimplementedOxiaCoordinationServer
 // for forward compatibility
@@ -127,6 +177,11 @@ type OxiaCoordinationServer interface {
 	AddFollower(context.Context, *AddFollowerRequest) (*AddFollowerResponse, error)
 	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
 	DeleteShard(context.Context, *DeleteShardRequest) (*DeleteShardResponse, error)
+	SplitShard(context.Context, *SplitShardRequest) (*SplitShardResponse, error)
+	MergeShard(context.Context, *MergeShardRequest) (*MergeShardResponse, error)
+	TransferLeadership(context.Context, *TransferLeadershipRequest) (*TransferLeadershipResponse, error)
+	ListShardSessions(context.Context, *ListShardSessionsRequest) (*ListShardSessionsResponse, error)
+	RevokeShardSession(context.Context, *RevokeShardSessionRequest) (*RevokeShardSessionResponse, error)
 	mustEmbedUnimplementedOxiaCoordinationServer()
 }
 
@@ -152,6 +207,21 @@ func (UnimplementedOxiaCoordinationServer) GetStatus(context.Context, *GetStatus
 func (UnimplementedOxiaCoordinationServer) DeleteShard(context.Context, *DeleteShardRequest) (*DeleteShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteShard not implemented")
 }
+func (UnimplementedOxiaCoordinationServer) SplitShard(context.Context, *SplitShardRequest) (*SplitShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitShard not implemented")
+}
+func (UnimplementedOxiaCoordinationServer) MergeShard(context.Context, *MergeShardRequest) (*MergeShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeShard not implemented")
+}
+func (UnimplementedOxiaCoordinationServer) TransferLeadership(context.Context, *TransferLeadershipRequest) (*TransferLeadershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLeadership not implemented")
+}
+func (UnimplementedOxiaCoordinationServer) ListShardSessions(context.Context, *ListShardSessionsRequest) (*ListShardSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShardSessions not implemented")
+}
+func (UnimplementedOxiaCoordinationServer) RevokeShardSession(context.Context, *RevokeShardSessionRequest) (*RevokeShardSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShardSession not implemented")
+}
 func (UnimplementedOxiaCoordinationServer) mustEmbedUnimplementedOxiaCoordinationServer() {}
 
 // UnsafeOxiaCoordinationServer may be embedded to opt out of forward compatibility for this service.
@@ -281,6 +351,96 @@ func _OxiaCoordination_DeleteShard_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OxiaCoordination_SplitShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OxiaCoordinationServer).SplitShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/replication.OxiaCoordination/SplitShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OxiaCoordinationServer).SplitShard(ctx, req.(*SplitShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OxiaCoordination_MergeShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OxiaCoordinationServer).MergeShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/replication.OxiaCoordination/MergeShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OxiaCoordinationServer).MergeShard(ctx, req.(*MergeShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OxiaCoordination_TransferLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferLeadershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OxiaCoordinationServer).TransferLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/replication.OxiaCoordination/TransferLeadership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OxiaCoordinationServer).TransferLeadership(ctx, req.(*TransferLeadershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OxiaCoordination_ListShardSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShardSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OxiaCoordinationServer).ListShardSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/replication.OxiaCoordination/ListShardSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OxiaCoordinationServer).ListShardSessions(ctx, req.(*ListShardSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OxiaCoordination_RevokeShardSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShardSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OxiaCoordinationServer).RevokeShardSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/replication.OxiaCoordination/RevokeShardSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OxiaCoordinationServer).RevokeShardSession(ctx, req.(*RevokeShardSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OxiaCoordination_ServiceDesc is the grpc.ServiceDesc for OxiaCoordination service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -308,6 +468,26 @@ var OxiaCoordination_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteShard",
 			Handler:    _OxiaCoordination_DeleteShard_Handler,
 		},
+		{
+			MethodName: "SplitShard",
+			Handler:    _OxiaCoordination_SplitShard_Handler,
+		},
+		{
+			MethodName: "MergeShard",
+			Handler:    _OxiaCoordination_MergeShard_Handler,
+		},
+		{
+			MethodName: "TransferLeadership",
+			Handler:    _OxiaCoordination_TransferLeadership_Handler,
+		},
+		{
+			MethodName: "ListShardSessions",
+			Handler:    _OxiaCoordination_ListShardSessions_Handler,
+		},
+		{
+			MethodName: "RevokeShardSession",
+			Handler:    _OxiaCoordination_RevokeShardSession_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
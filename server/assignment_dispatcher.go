@@ -47,6 +47,13 @@ type ShardAssignmentsDispatcher interface {
 	Initialized() bool
 	PushShardAssignments(stream proto.OxiaCoordination_PushShardAssignmentsServer) error
 	RegisterForUpdates(req *proto.ShardAssignmentsRequest, client Client) error
+
+	// Leader returns the address of the current leader of the shard
+	Leader(namespace string, shardId int64) (string, error)
+
+	// ShardForHash returns the shard that has the hash in its range, along
+	// with the address of its current leader
+	ShardForHash(namespace string, hash uint32) (shardId int64, leader string, err error)
 }
 
 type shardAssignmentDispatcher struct {
@@ -77,13 +84,16 @@ func (s *shardAssignmentDispatcher) RegisterForUpdates(req *proto.ShardAssignmen
 	if namespace == "" {
 		namespace = constant.DefaultNamespace
 	}
+	namespaces := append([]string{namespace}, req.AdditionalNamespaces...)
 
-	if _, ok := s.assignments.Namespaces[namespace]; !ok {
-		s.Unlock()
-		return constant.ErrNamespaceNotFound
+	for _, ns := range namespaces {
+		if _, ok := s.assignments.Namespaces[ns]; !ok {
+			s.Unlock()
+			return constant.ErrNamespaceNotFound
+		}
 	}
 
-	initialAssignments := filterByNamespace(s.assignments, namespace)
+	initialAssignments := filterByNamespaces(s.assignments, namespaces)
 
 	clientCh := make(chan *proto.ShardAssignments)
 	clientId := s.nextClientId
@@ -113,7 +123,7 @@ func (s *shardAssignmentDispatcher) RegisterForUpdates(req *proto.ShardAssignmen
 				return constant.ErrCancelled
 			}
 
-			assignments = filterByNamespace(assignments, namespace)
+			assignments = filterByNamespaces(assignments, namespaces)
 			err := clientStream.Send(assignmentsInterceptorFunc(assignments))
 			if err != nil {
 				if status.Code(err) != codes.Canceled {
@@ -144,13 +154,60 @@ func (s *shardAssignmentDispatcher) RegisterForUpdates(req *proto.ShardAssignmen
 	}
 }
 
-func filterByNamespace(assignments *proto.ShardAssignments, namespace string) *proto.ShardAssignments {
+func (s *shardAssignmentDispatcher) Leader(namespace string, shardId int64) (string, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.assignments == nil {
+		return "", constant.ErrNotInitialized
+	}
+
+	nsa, ok := s.assignments.Namespaces[namespace]
+	if !ok {
+		return "", constant.ErrNamespaceNotFound
+	}
+
+	for _, assignment := range nsa.Assignments {
+		if assignment.Shard == shardId && assignment.Leader != "" {
+			return assignment.Leader, nil
+		}
+	}
+	return "", status.Errorf(constant.CodeNodeIsNotLeader, "no leader available for shard %d", shardId)
+}
+
+func (s *shardAssignmentDispatcher) ShardForHash(namespace string, hash uint32) (shardId int64, leader string, err error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.assignments == nil {
+		return 0, "", constant.ErrNotInitialized
+	}
+
+	nsa, ok := s.assignments.Namespaces[namespace]
+	if !ok {
+		return 0, "", constant.ErrNamespaceNotFound
+	}
+
+	for _, assignment := range nsa.Assignments {
+		hashRange := assignment.GetInt32HashRange()
+		if hashRange == nil || hash < hashRange.MinHashInclusive || hash > hashRange.MaxHashInclusive {
+			continue
+		}
+		if assignment.Leader == "" {
+			return 0, "", status.Errorf(constant.CodeNodeIsNotLeader, "no leader available for shard %d", assignment.Shard)
+		}
+		return assignment.Shard, assignment.Leader, nil
+	}
+	return 0, "", status.Errorf(constant.CodeNodeIsNotLeader, "no shard available for hash %d", hash)
+}
+
+func filterByNamespaces(assignments *proto.ShardAssignments, namespaces []string) *proto.ShardAssignments {
 	filtered := &proto.ShardAssignments{
 		Namespaces: map[string]*proto.NamespaceShardsAssignment{},
 	}
 
-	for ns, nsa := range assignments.Namespaces {
-		if ns == namespace {
+	for _, ns := range namespaces {
+		if nsa, ok := assignments.Namespaces[ns]; ok {
 			filtered.Namespaces[ns] = nsa
 		}
 	}
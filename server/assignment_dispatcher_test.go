@@ -81,6 +81,44 @@ func TestShardAssignmentDispatcher_Initialized(t *testing.T) {
 	assert.NoError(t, dispatcher.Close())
 }
 
+func TestShardAssignmentDispatcher_ShardForHash(t *testing.T) {
+	dispatcher := NewShardAssignmentDispatcher(rpc.NewClosableHealthServer(t.Context()))
+	_, _, err := dispatcher.ShardForHash(constant.DefaultNamespace, 0)
+	assert.ErrorIs(t, err, constant.ErrNotInitialized)
+
+	// The shard 0 was split into the shards 2 and 3
+	assert.NoError(t, dispatcher.(*shardAssignmentDispatcher).updateShardAssignment(&proto.ShardAssignments{
+		Namespaces: map[string]*proto.NamespaceShardsAssignment{
+			constant.DefaultNamespace: {
+				Assignments: []*proto.ShardAssignment{
+					newShardAssignment(1, "server1", 200, math.MaxUint32),
+					newShardAssignment(2, "server2", 0, 99),
+					newShardAssignment(3, "", 100, 199),
+				},
+				ShardKeyRouter: proto.ShardKeyRouter_XXHASH3,
+			},
+		},
+	}))
+
+	shardId, leader, err := dispatcher.ShardForHash(constant.DefaultNamespace, 50)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, shardId)
+	assert.Equal(t, "server2", leader)
+
+	shardId, leader, err = dispatcher.ShardForHash(constant.DefaultNamespace, math.MaxUint32)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, shardId)
+	assert.Equal(t, "server1", leader)
+
+	_, _, err = dispatcher.ShardForHash(constant.DefaultNamespace, 150)
+	assert.Equal(t, constant.CodeNodeIsNotLeader, status.Code(err))
+
+	_, _, err = dispatcher.ShardForHash("other-namespace", 50)
+	assert.ErrorIs(t, err, constant.ErrNamespaceNotFound)
+
+	assert.NoError(t, dispatcher.Close())
+}
+
 func TestShardAssignmentDispatcher_ReadinessProbe(t *testing.T) {
 	healthServer := rpc.NewClosableHealthServer(t.Context())
 	dispatcher := NewShardAssignmentDispatcher(healthServer)
@@ -311,6 +349,50 @@ func TestShardAssignmentDispatcher_MultipleNamespaces(t *testing.T) {
 	mockClient.cancel()
 	wg.Wait()
 
+	// Additional namespaces are sent on the same stream
+	mockClient = newMockShardAssignmentClientStream()
+	wg = sync.WaitGroup{}
+	wg.Go(func() {
+		req := &proto.ShardAssignmentsRequest{Namespace: "test-ns-1", AdditionalNamespaces: []string{"test-ns-2"}}
+		err := dispatcher.RegisterForUpdates(req, mockClient)
+		assert.NoError(t, err)
+	})
+
+	mockClient.cancel()
+	wg.Wait()
+
+	response = mockClient.GetResponse()
+	assert.True(t, pb.Equal(&proto.ShardAssignments{
+		Namespaces: map[string]*proto.NamespaceShardsAssignment{
+			"test-ns-1": {
+				Assignments: []*proto.ShardAssignment{
+					newShardAssignment(2, "server1", 0, 100),
+					newShardAssignment(3, "server2", 100, math.MaxUint32),
+				},
+				ShardKeyRouter: proto.ShardKeyRouter_XXHASH3,
+			},
+			"test-ns-2": {
+				Assignments: []*proto.ShardAssignment{
+					newShardAssignment(4, "server3", 0, 100),
+					newShardAssignment(5, "server4", 100, math.MaxUint32),
+				},
+				ShardKeyRouter: proto.ShardKeyRouter_XXHASH3,
+			},
+		},
+	}, response))
+
+	// All the additional namespaces must be valid
+	mockClient = newMockShardAssignmentClientStream()
+	wg = sync.WaitGroup{}
+	wg.Go(func() {
+		req := &proto.ShardAssignmentsRequest{Namespace: "test-ns-1", AdditionalNamespaces: []string{"non-valid-namespace"}}
+		err := dispatcher.RegisterForUpdates(req, mockClient)
+		assert.ErrorIs(t, err, constant.ErrNamespaceNotFound)
+	})
+
+	mockClient.cancel()
+	wg.Wait()
+
 	assert.NoError(t, dispatcher.Close())
 }
 
@@ -43,6 +43,7 @@ import (
 // FollowerController handles all the operations of a given shard's follower.
 type FollowerController interface {
 	io.Closer
+	ShardReader
 
 	// NewTerm
 	//
@@ -88,7 +89,11 @@ type followerController struct {
 	term      int64
 
 	// The highest commit offset advertised by the leader
-	advertisedCommitOffset atomic.Int64
+	advertisedCommit atomic.Pointer[advertisedCommit]
+
+	// The time at which the data in the database was known to be up-to-date
+	// with the leader, used to bound the staleness of the reads
+	upToDateTimestamp atomic.Int64
 
 	// The commit offset already applied in the database
 	commitOffset atomic.Int64
@@ -102,6 +107,10 @@ type followerController struct {
 	db          kv.DB
 	termOptions kv.TermOptions
 
+	// The shards being merged into this shard, until all of them are caught
+	// up and their data is combined
+	mergeSources map[int64]*mergeSource
+
 	ctx              context.Context
 	cancel           context.CancelFunc
 	syncCond         concurrent.ConditionContext
@@ -114,6 +123,30 @@ type followerController struct {
 	writeLatencyHisto metric.LatencyHistogram
 }
 
+// mergeSource is the data of a shard being merged into this shard. The data
+// of the first shard replaces the database, while the data of the following
+// ones is staged apart from it.
+type mergeSource struct {
+	loader   kv.StagingSnapshotLoader
+	kv       kv.KV
+	db       kv.DB
+	caughtUp bool
+}
+
+func (ms *mergeSource) Close() error {
+	if ms.db == nil {
+		return nil
+	}
+	return multierr.Combine(ms.db.Close(), ms.loader.Close())
+}
+
+type advertisedCommit struct {
+	offset int64
+
+	// The time at which the commit offset was received from the leader
+	timestamp int64
+}
+
 func NewFollowerController(config Config, namespace string, shardId int64, wf wal.Factory, kvFactory kv.Factory) (FollowerController, error) {
 	fc := &followerController{
 		config:           config,
@@ -151,6 +184,7 @@ func NewFollowerController(config Config, namespace string, shardId int64, wf wa
 	}
 
 	fc.db.EnableNotifications(fc.termOptions.NotificationsEnabled)
+	fc.db.CaptureNotificationValues(fc.termOptions.NotificationsIncludeValue, fc.termOptions.NotificationsIncludePreviousValue)
 
 	commitOffset, err := fc.db.ReadCommitOffset()
 	if err != nil {
@@ -219,6 +253,18 @@ func (fc *followerController) close() error {
 		err = multierr.Append(err, fc.db.Close())
 		fc.db = nil
 	}
+
+	return multierr.Append(err, fc.discardMergeSources())
+}
+
+// discardMergeSources discards the data of the shards being merged into this
+// shard that was not combined yet.
+func (fc *followerController) discardMergeSources() error {
+	var err error
+	for _, source := range fc.mergeSources {
+		err = multierr.Append(err, source.Close())
+	}
+	fc.mergeSources = nil
 	return err
 }
 
@@ -290,6 +336,7 @@ func (fc *followerController) NewTerm(req *proto.NewTermRequest) (*proto.NewTerm
 	}
 
 	fc.db.EnableNotifications(fc.termOptions.NotificationsEnabled)
+	fc.db.CaptureNotificationValues(fc.termOptions.NotificationsIncludeValue, fc.termOptions.NotificationsIncludePreviousValue)
 
 	fc.term = req.Term
 	fc.setLogger()
@@ -441,7 +488,10 @@ func (fc *followerController) append(req *proto.Append, stream proto.OxiaLogRepl
 		return err
 	}
 
-	fc.advertisedCommitOffset.Store(req.CommitOffset)
+	fc.advertisedCommit.Store(&advertisedCommit{
+		offset:    req.CommitOffset,
+		timestamp: time.SystemClock.Now().UnixMilli(),
+	})
 	fc.lastAppendedOffset = req.Entry.Offset
 
 	// Trigger the sync
@@ -489,18 +539,24 @@ func (fc *followerController) applyAllCommittedEntries() {
 		}
 		fc.Unlock()
 
-		maxInclusive := fc.advertisedCommitOffset.Load()
-		if err := fc.processCommittedEntries(maxInclusive); err != nil {
+		advertised := fc.advertisedCommit.Load()
+		if advertised == nil {
+			continue
+		}
+		if err := fc.processCommittedEntries(advertised.offset); err != nil {
 			fc.closeStream(err)
 			close(fc.applyEntriesDone)
 			return
 		}
+		if fc.commitOffset.Load() >= advertised.offset {
+			fc.upToDateTimestamp.Store(advertised.timestamp)
+		}
 	}
 }
 
-func (fc *followerController) processCommitRequest(entry *proto.LogEntry, logEntryValue *proto.LogEntryValue) error {
+func (fc *followerController) processCommitRequest(db kv.DB, entry *proto.LogEntry, logEntryValue *proto.LogEntryValue) error {
 	for _, br := range logEntryValue.GetRequests().Writes {
-		_, err := fc.db.ProcessWrite(br, entry.Offset, entry.Timestamp, WrapperUpdateOperationCallback)
+		_, err := db.ProcessWrite(br, entry.Offset, entry.Timestamp, WrapperUpdateOperationCallback)
 		if err != nil {
 			fc.log.Error(
 				"Error applying committed entry",
@@ -546,7 +602,7 @@ func (fc *followerController) processCommittedEntriesLoop(reader wal.Reader, max
 			)
 			return err
 		}
-		if err := fc.processCommitRequest(entry, logEntryValue); err != nil {
+		if err := fc.processCommitRequest(fc.db, entry, logEntryValue); err != nil {
 			return err
 		}
 
@@ -616,29 +672,35 @@ func (fc *followerController) SendSnapshot(stream proto.OxiaLogReplication_SendS
 	return closeStreamWg.Wait(fc.ctx)
 }
 
-func (fc *followerController) readSnapshotStream(stream proto.OxiaLogReplication_SendSnapshotServer, loader kv.SnapshotLoader) (int64, error) {
-	var totalSize int64
+// recvSnapshotChunk returns the next chunk of the snapshot, or nil when the
+// snapshot stream is complete.
+func (fc *followerController) recvSnapshotChunk(stream proto.OxiaLogReplication_SendSnapshotServer) (*proto.SnapshotChunk, error) {
+	snapChunk, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
 
-	for {
-		snapChunk, err := stream.Recv()
-		switch {
-		case err != nil:
-			if errors.Is(err, io.EOF) {
-				return totalSize, nil
-			}
+		fc.closeStreamNoMutex(err)
+		return nil, err
+	}
+	return snapChunk, nil
+}
 
-			fc.closeStreamNoMutex(err)
-			return totalSize, err
-		case snapChunk == nil:
-			return totalSize, nil
-		case fc.term != wal.InvalidTerm && snapChunk.Term != fc.term:
+func (fc *followerController) readSnapshotStream(stream proto.OxiaLogReplication_SendSnapshotServer, firstChunk *proto.SnapshotChunk,
+	loader kv.SnapshotLoader) (totalSize int64, hashRange *proto.Int32HashRange, err error) {
+	for snapChunk := firstChunk; snapChunk != nil; {
+		if fc.term != wal.InvalidTerm && snapChunk.Term != fc.term {
 			// The follower could be left with term=-1 by a previous failed
 			// attempt at sending the snapshot. It's ok to proceed in that case.
 			fc.closeStreamNoMutex(constant.ErrInvalidTerm)
-			return totalSize, constant.ErrInvalidTerm
+			return totalSize, nil, constant.ErrInvalidTerm
 		}
 
 		fc.term = snapChunk.Term
+		if snapChunk.Int32HashRange != nil {
+			hashRange = snapChunk.Int32HashRange
+		}
 
 		fc.log.Debug(
 			"Applying snapshot chunk",
@@ -648,20 +710,47 @@ func (fc *followerController) readSnapshotStream(stream proto.OxiaLogReplication
 			slog.Int64("term", fc.term),
 		)
 		if err = loader.AddChunk(snapChunk.Name, snapChunk.ChunkIndex, snapChunk.ChunkCount, snapChunk.Content); err != nil {
-			fc.closeStream(err)
-			return totalSize, err
+			fc.closeStreamNoMutex(err)
+			return totalSize, nil, err
 		}
 
 		totalSize += int64(len(snapChunk.Content))
+
+		if snapChunk, err = fc.recvSnapshotChunk(stream); err != nil {
+			return totalSize, nil, err
+		}
 	}
+
+	return totalSize, hashRange, nil
 }
 
 func (fc *followerController) handleSnapshot(stream proto.OxiaLogReplication_SendSnapshotServer) {
 	fc.Lock()
 	defer fc.Unlock()
 
-	// Wipe out both WAL and DB contents
-	err := fc.wal.Clear()
+	firstChunk, err := fc.recvSnapshotChunk(stream)
+	if err != nil {
+		return
+	}
+
+	if firstChunk != nil && fc.term != wal.InvalidTerm && firstChunk.Term != fc.term {
+		// Reject the snapshot of a stale leader before wiping out the data
+		fc.closeStreamNoMutex(constant.ErrInvalidTerm)
+		return
+	}
+
+	if firstChunk != nil && firstChunk.CatchUp {
+		fc.handleCatchUp(stream, firstChunk)
+		return
+	}
+	if firstChunk != nil && firstChunk.Merge {
+		fc.handleMergeSnapshot(stream, firstChunk)
+		return
+	}
+
+	// Wipe out both WAL and DB contents, along with the data staged by a
+	// previous merge attempt
+	err = multierr.Combine(fc.wal.Clear(), fc.discardMergeSources())
 	if err != nil {
 		fc.closeStreamNoMutex(err)
 		return
@@ -685,7 +774,7 @@ func (fc *followerController) handleSnapshot(stream proto.OxiaLogReplication_Sen
 
 	defer loader.Close()
 
-	totalSize, err := fc.readSnapshotStream(stream, loader)
+	totalSize, hashRange, err := fc.readSnapshotStream(stream, firstChunk, loader)
 	if err != nil {
 		return
 	}
@@ -699,11 +788,23 @@ func (fc *followerController) handleSnapshot(stream proto.OxiaLogReplication_Sen
 		return
 	}
 
+	newDb.EnableNotifications(fc.termOptions.NotificationsEnabled)
+	newDb.CaptureNotificationValues(fc.termOptions.NotificationsIncludeValue, fc.termOptions.NotificationsIncludePreviousValue)
+
 	// The new term must be persisted, to avoid rolling it back
 	if err = newDb.UpdateTerm(fc.term, fc.termOptions); err != nil {
 		fc.closeStreamNoMutex(errors.Wrap(err, "Failed to update term in db"))
 	}
 
+	// When the snapshot comes from a shard split, we only retain the records
+	// that belong to the hash range of this shard
+	if hashRange != nil {
+		if err = newDb.DeleteOutsideHashRange(hashRange.MinHashInclusive, hashRange.MaxHashInclusive, WrapperUpdateOperationCallback); err != nil {
+			fc.closeStreamNoMutex(errors.Wrap(err, "Failed to trim the snapshot to the shard hash range"))
+			return
+		}
+	}
+
 	commitOffset, err := newDb.ReadCommitOffset()
 	if err != nil {
 		fc.closeStreamNoMutex(errors.Wrap(err, "Failed to read committed offset in the new snapshot"))
@@ -719,6 +820,7 @@ func (fc *followerController) handleSnapshot(stream proto.OxiaLogReplication_Sen
 
 	fc.db = newDb
 	fc.commitOffset.Store(commitOffset)
+	fc.upToDateTimestamp.Store(0)
 	fc.lastAppendedOffset = commitOffset
 	fc.closeStreamNoMutex(nil)
 
@@ -730,6 +832,295 @@ func (fc *followerController) handleSnapshot(stream proto.OxiaLogReplication_Sen
 	)
 }
 
+// handleMergeSnapshot stages the snapshot of a shard being merged into this
+// shard, apart from the database where the snapshot of the first one is
+// installed. The staged records are merged into the database once the log
+// entries that follow the snapshots of all the shards are applied.
+func (fc *followerController) handleMergeSnapshot(stream proto.OxiaLogReplication_SendSnapshotServer, firstChunk *proto.SnapshotChunk) {
+	sourceShard := firstChunk.SourceShard
+	if source, found := fc.mergeSources[sourceShard]; found {
+		if err := source.Close(); err != nil {
+			fc.closeStreamNoMutex(err)
+			return
+		}
+		delete(fc.mergeSources, sourceShard)
+	}
+
+	loader, err := fc.kvFactory.NewStagingSnapshotLoader(fc.namespace, fc.shardId, fmt.Sprint("merge-shard-", sourceShard))
+	if err != nil {
+		fc.closeStreamNoMutex(err)
+		return
+	}
+
+	totalSize, _, err := fc.readSnapshotStream(stream, firstChunk, loader)
+	if err != nil {
+		_ = loader.Close()
+		return
+	}
+
+	// We have received all the files for the database
+	loader.Complete()
+
+	source := &mergeSource{loader: loader}
+	if source.kv, err = loader.OpenKV(compare.EncoderHierarchical); err != nil {
+		_ = loader.Close()
+		fc.closeStreamNoMutex(errors.Wrap(err, "failed to open database after loading snapshot"))
+		return
+	}
+	if source.db, err = kv.NewDBWithKV(fc.namespace, fc.shardId, source.kv, compare.EncoderHierarchical,
+		fc.config.NotificationsRetentionTime, time.SystemClock); err != nil {
+		_ = multierr.Combine(source.kv.Close(), loader.Close())
+		fc.closeStreamNoMutex(errors.Wrap(err, "failed to open database after loading snapshot"))
+		return
+	}
+	source.db.EnableNotifications(false)
+
+	commitOffset, err := source.db.ReadCommitOffset()
+	if err == nil {
+		err = stream.SendAndClose(&proto.SnapshotResponse{
+			AckOffset: commitOffset,
+		})
+	}
+	if err != nil {
+		_ = source.Close()
+		fc.closeStreamNoMutex(errors.Wrap(err, "Failed to send response after processing snapshot"))
+		return
+	}
+
+	if fc.mergeSources == nil {
+		fc.mergeSources = map[int64]*mergeSource{}
+	}
+	fc.mergeSources[sourceShard] = source
+	fc.closeStreamNoMutex(nil)
+
+	fc.log.Info(
+		"Successfully staged snapshot of merged shard",
+		slog.Int64("term", fc.term),
+		slog.Int64("source-shard", sourceShard),
+		slog.Int64("snapshot-size", totalSize),
+		slog.Int64("commit-offset", commitOffset),
+	)
+}
+
+// handleCatchUp applies the log entries that follow the snapshot previously
+// installed, or staged when the shard is being merged into this shard.
+func (fc *followerController) handleCatchUp(stream proto.OxiaLogReplication_SendSnapshotServer, firstChunk *proto.SnapshotChunk) {
+	db, commitOffset := fc.db, &fc.commitOffset
+	if firstChunk.Merge {
+		source, found := fc.mergeSources[firstChunk.SourceShard]
+		if !found {
+			fc.closeStreamNoMutex(errors.Errorf("no staged snapshot of shard %d to apply the log entries to", firstChunk.SourceShard))
+			return
+		}
+
+		stagedOffset, err := source.db.ReadCommitOffset()
+		if err != nil {
+			fc.closeStreamNoMutex(err)
+			return
+		}
+		db, commitOffset = source.db, &atomic.Int64{}
+		commitOffset.Store(stagedOffset)
+	}
+	if db == nil {
+		fc.closeStreamNoMutex(errors.New("no existing database to apply the log entries to"))
+		return
+	}
+
+	hashRange, err := fc.applyLogEntries(stream, firstChunk, db, commitOffset)
+	if err != nil {
+		return
+	}
+
+	if hashRange != nil {
+		if err = db.DeleteOutsideHashRange(hashRange.MinHashInclusive, hashRange.MaxHashInclusive, WrapperUpdateOperationCallback); err != nil {
+			fc.closeStreamNoMutex(errors.Wrap(err, "Failed to trim the database to the shard hash range"))
+			return
+		}
+	}
+
+	if len(firstChunk.MergeShards) > 0 {
+		if err = fc.mergeCaughtUp(firstChunk.SourceShard, firstChunk.MergeShards); err != nil {
+			fc.closeStreamNoMutex(errors.Wrap(err, "Failed to combine the data of the merged shards"))
+			return
+		}
+	}
+
+	ackOffset := commitOffset.Load()
+	if err = stream.SendAndClose(&proto.SnapshotResponse{
+		AckOffset: ackOffset,
+	}); err != nil {
+		fc.closeStreamNoMutex(errors.Wrap(err, "Failed to send response after applying the log entries"))
+		return
+	}
+
+	fc.lastAppendedOffset = fc.commitOffset.Load()
+	fc.closeStreamNoMutex(nil)
+
+	fc.log.Info(
+		"Successfully caught up with the log entries",
+		slog.Int64("term", fc.term),
+		slog.Int64("source-shard", firstChunk.SourceShard),
+		slog.Int64("ack-offset", ackOffset),
+		slog.Int64("commit-offset", fc.commitOffset.Load()),
+	)
+}
+
+// applyLogEntries applies the log entries received in the stream to the
+// database, advancing its commit offset. It returns the hash range that the
+// database must be trimmed to, if any.
+func (fc *followerController) applyLogEntries(stream proto.OxiaLogReplication_SendSnapshotServer, firstChunk *proto.SnapshotChunk,
+	db kv.DB, commitOffset *atomic.Int64) (*proto.Int32HashRange, error) {
+	logEntryValue := proto.LogEntryValueFromVTPool()
+	defer logEntryValue.ReturnToVTPool()
+
+	var hashRange *proto.Int32HashRange
+	var err error
+	for chunk := firstChunk; chunk != nil; {
+		if chunk.Term != fc.term {
+			fc.closeStreamNoMutex(constant.ErrInvalidTerm)
+			return nil, constant.ErrInvalidTerm
+		}
+		if chunk.Int32HashRange != nil {
+			hashRange = chunk.Int32HashRange
+		}
+
+		for _, entry := range chunk.Entries {
+			if entry.Offset <= commitOffset.Load() {
+				continue
+			}
+			if entry.Offset != commitOffset.Load()+1 {
+				err = errors.Errorf("missing log entries before offset %d", entry.Offset)
+				fc.closeStreamNoMutex(err)
+				return nil, err
+			}
+
+			logEntryValue.ResetVT()
+			if err = logEntryValue.UnmarshalVT(entry.Value); err != nil {
+				fc.closeStreamNoMutex(err)
+				return nil, err
+			}
+			if err = fc.processCommitRequest(db, entry, logEntryValue); err != nil {
+				fc.closeStreamNoMutex(err)
+				return nil, err
+			}
+			commitOffset.Store(entry.Offset)
+		}
+
+		if chunk, err = fc.recvSnapshotChunk(stream); err != nil {
+			return nil, err
+		}
+	}
+	return hashRange, nil
+}
+
+// mergeCaughtUp records that the data of a shard being merged into this shard
+// is caught up. Once all the merged shards are caught up, the staged data is
+// merged into the database.
+//
+// Merging the staged data is a local copy of the records, which happens while
+// the merged shards are rejecting writes.
+func (fc *followerController) mergeCaughtUp(sourceShard int64, mergeShards []int64) error {
+	if fc.mergeSources == nil {
+		fc.mergeSources = map[int64]*mergeSource{}
+	}
+	source, found := fc.mergeSources[sourceShard]
+	if !found {
+		// The data of the shard was applied directly to the database
+		source = &mergeSource{}
+		fc.mergeSources[sourceShard] = source
+	}
+	source.caughtUp = true
+
+	for _, shard := range mergeShards {
+		if source, found := fc.mergeSources[shard]; !found || !source.caughtUp {
+			return nil
+		}
+	}
+
+	if fc.db == nil {
+		return errors.New("no existing database to merge the staged data into")
+	}
+	for _, shard := range mergeShards {
+		if source := fc.mergeSources[shard]; source.kv != nil {
+			if err := fc.db.Merge(source.kv, shard); err != nil {
+				return err
+			}
+		}
+	}
+	if err := fc.discardMergeSources(); err != nil {
+		return err
+	}
+
+	commitOffset, err := fc.db.ReadCommitOffset()
+	if err != nil {
+		return err
+	}
+	fc.commitOffset.Store(commitOffset)
+	return nil
+}
+
+// checkStaleness returns the database to serve a read request, if the data is
+// fresh enough for the requested staleness.
+//
+// The follower is only aware of the entries committed by the leader when they
+// are advertised in the replication stream. Therefore, a follower of a shard
+// without any write traffic will not be able to serve the reads, and the
+// clients will fall back to the leader.
+func (fc *followerController) checkStaleness(maxStalenessMs *uint64) (kv.DB, error) {
+	if maxStalenessMs == nil {
+		return nil, constant.ErrNodeIsNotLeader
+	}
+
+	fc.Lock()
+	defer fc.Unlock()
+	if fc.status != proto.ServingStatus_FOLLOWER {
+		return nil, status.Errorf(constant.CodeInvalidStatus,
+			"Received read request in invalid state - Expected: %v - actual: %v", proto.ServingStatus_FOLLOWER, fc.status)
+	}
+
+	upToDateTimestamp := fc.upToDateTimestamp.Load()
+	if upToDateTimestamp == 0 ||
+		time.SystemClock.Now().UnixMilli()-upToDateTimestamp > int64(*maxStalenessMs) {
+		return nil, constant.ErrFollowerTooStale
+	}
+	return fc.db, nil
+}
+
+func (fc *followerController) Read(ctx context.Context, request *proto.ReadRequest, cb concurrent.StreamCallback[*proto.GetResponse]) {
+	db, err := fc.checkStaleness(request.MaxStalenessMs)
+	if err != nil {
+		cb.OnComplete(err)
+		return
+	}
+	readFromDB(ctx, db, fc.shardId, fc.log, request, cb)
+}
+
+func (fc *followerController) List(ctx context.Context, request *proto.ListRequest, cb concurrent.StreamCallback[ListEntry]) {
+	db, err := fc.checkStaleness(request.MaxStalenessMs)
+	if err != nil {
+		cb.OnComplete(err)
+		return
+	}
+	listFromDB(ctx, db, fc.shardId, fc.log, request, cb)
+}
+
+func (fc *followerController) RangeScan(ctx context.Context, request *proto.RangeScanRequest, cb concurrent.StreamCallback[*proto.GetResponse]) {
+	db, err := fc.checkStaleness(request.MaxStalenessMs)
+	if err != nil {
+		cb.OnComplete(err)
+		return
+	}
+	rangeScanFromDB(ctx, db, fc.shardId, fc.log, request, cb)
+}
+
+func (fc *followerController) RangeStats(ctx context.Context, request *proto.RangeStatsRequest) (*proto.RangeStatsResponse, error) {
+	db, err := fc.checkStaleness(request.MaxStalenessMs)
+	if err != nil {
+		return nil, err
+	}
+	return rangeStatsFromDB(ctx, db, fc.log, request)
+}
+
 func (fc *followerController) GetStatus(_ *proto.GetStatusRequest) (*proto.GetStatusResponse, error) {
 	fc.Lock()
 	defer fc.Unlock()
@@ -17,6 +17,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"testing"
 	"time"
@@ -27,8 +28,11 @@ import (
 
 	"github.com/oxia-db/oxia/common/compare"
 
+	"github.com/oxia-db/oxia/common/channel"
 	"github.com/oxia-db/oxia/common/concurrent"
 	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/entity"
+	"github.com/oxia-db/oxia/common/hash"
 	"github.com/oxia-db/oxia/common/logging"
 	time2 "github.com/oxia-db/oxia/common/time"
 
@@ -706,6 +710,10 @@ func TestFollower_HandleSnapshot(t *testing.T) {
 	assert.EqualValues(t, 0, r1.Offset)
 	close(stream.requests)
 
+	// The snapshot is rejected until the follower has released the
+	// replication stream
+	assert.Eventually(t, func() bool { return !closeChanIsNotNil(fc)() }, 10*time.Second, 10*time.Millisecond)
+
 	// Load snapshot into follower
 	snapshot := prepareTestDb(t)
 
@@ -1005,6 +1013,184 @@ func TestFollower_GetStatus(t *testing.T) {
 	assert.NoError(t, walFactory.Close())
 }
 
+func TestFollower_HandleSnapshotCatchUp(t *testing.T) {
+	var shardId int64
+	kvFactory, err := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	walFactory := wal.NewWalFactory(&wal.FactoryOptions{BaseWalDir: t.TempDir()})
+
+	fc, err := NewFollowerController(Config{}, constant.DefaultNamespace, shardId, walFactory, kvFactory)
+	assert.NoError(t, err)
+
+	_, err = fc.NewTerm(&proto.NewTermRequest{Term: 1})
+	assert.NoError(t, err)
+
+	// Load the whole snapshot of the split shard
+	snapshot := prepareTestDb(t)
+	snapshotStream := newMockServerSendSnapshotStream()
+	wg := sync.WaitGroup{}
+	wg.Go(func() { assert.NoError(t, fc.SendSnapshot(snapshotStream)) })
+	for ; snapshot.Valid(); snapshot.Next() {
+		chunk, err := snapshot.Chunk()
+		assert.NoError(t, err)
+		snapshotStream.AddChunk(&proto.SnapshotChunk{
+			Term:       1,
+			Name:       chunk.Name(),
+			Content:    chunk.Content(),
+			ChunkIndex: chunk.Index(),
+			ChunkCount: chunk.TotalCount(),
+		})
+	}
+	close(snapshotStream.chunks)
+	wg.Wait()
+	assert.EqualValues(t, 99, snapshotStream.GetResponse().AckOffset)
+
+	// Catch up with the entries written after the snapshot, and trim the
+	// records outside the hash range
+	middle := uint32(math.MaxUint32 / 2)
+	catchUpStream := newMockServerSendSnapshotStream()
+	wg.Go(func() { assert.NoError(t, fc.SendSnapshot(catchUpStream)) })
+	catchUpStream.AddChunk(&proto.SnapshotChunk{
+		Term:    1,
+		CatchUp: true,
+		Entries: []*proto.LogEntry{
+			// Already included in the snapshot
+			createAddRequest(t, 1, 99, map[string]string{"key-0": "stale"}, 0).Entry,
+			createAddRequest(t, 1, 100, map[string]string{"key-100": "value-100"}, 0).Entry,
+		},
+	})
+	catchUpStream.AddChunk(&proto.SnapshotChunk{
+		Term:           1,
+		CatchUp:        true,
+		Int32HashRange: &proto.Int32HashRange{MinHashInclusive: 0, MaxHashInclusive: middle},
+		Entries: []*proto.LogEntry{
+			createAddRequest(t, 1, 101, map[string]string{"key-101": "value-101"}, 0).Entry,
+		},
+	})
+	close(catchUpStream.chunks)
+	wg.Wait()
+	assert.EqualValues(t, 101, catchUpStream.GetResponse().AckOffset)
+
+	statusRes, err := fc.(*followerController).GetStatus(&proto.GetStatusRequest{Shard: shardId})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 101, statusRes.HeadOffset)
+	assert.EqualValues(t, 101, statusRes.CommitOffset)
+
+	for i := 0; i < 102; i++ {
+		key := fmt.Sprintf("key-%d", i)
+		dbRes, err := fc.(*followerController).db.Get(&proto.GetRequest{Key: key, IncludeValue: true})
+		assert.NoError(t, err)
+		if hash.Xxh332(key) <= middle {
+			assert.Equal(t, proto.Status_OK, dbRes.Status)
+			assert.Equal(t, []byte(fmt.Sprintf("value-%d", i)), dbRes.Value)
+		} else {
+			assert.Equal(t, proto.Status_KEY_NOT_FOUND, dbRes.Status)
+		}
+	}
+
+	// The entries must follow the ones already applied
+	catchUpStream = newMockServerSendSnapshotStream()
+	catchUpStream.AddChunk(&proto.SnapshotChunk{
+		Term:    1,
+		CatchUp: true,
+		Entries: []*proto.LogEntry{
+			createAddRequest(t, 1, 105, map[string]string{"key-105": "value-105"}, 0).Entry,
+		},
+	})
+	close(catchUpStream.chunks)
+	assert.Error(t, fc.SendSnapshot(catchUpStream))
+
+	assert.NoError(t, fc.Close())
+	assert.NoError(t, kvFactory.Close())
+	assert.NoError(t, walFactory.Close())
+}
+
+func TestFollower_HandleMergeSnapshotCatchUp(t *testing.T) {
+	var shardId int64 = 3
+	kvFactory, err := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	walFactory := wal.NewWalFactory(&wal.FactoryOptions{BaseWalDir: t.TempDir()})
+
+	fc, err := NewFollowerController(Config{}, constant.DefaultNamespace, shardId, walFactory, kvFactory)
+	assert.NoError(t, err)
+
+	_, err = fc.NewTerm(&proto.NewTermRequest{Term: 1})
+	assert.NoError(t, err)
+
+	sendSnapshot := func(sourceShard int64, merge bool) int64 {
+		snapshot := prepareTestDb(t)
+		stream := newMockServerSendSnapshotStream()
+		wg := sync.WaitGroup{}
+		wg.Go(func() { assert.NoError(t, fc.SendSnapshot(stream)) })
+		for ; snapshot.Valid(); snapshot.Next() {
+			chunk, err := snapshot.Chunk()
+			assert.NoError(t, err)
+			stream.AddChunk(&proto.SnapshotChunk{
+				Term:        1,
+				Name:        chunk.Name(),
+				Content:     chunk.Content(),
+				ChunkIndex:  chunk.Index(),
+				ChunkCount:  chunk.TotalCount(),
+				SourceShard: sourceShard,
+				Merge:       merge,
+			})
+		}
+		close(stream.chunks)
+		wg.Wait()
+		return stream.GetResponse().AckOffset
+	}
+
+	catchUp := func(sourceShard int64, merge bool, key string) int64 {
+		stream := newMockServerSendSnapshotStream()
+		stream.AddChunk(&proto.SnapshotChunk{
+			Term:        1,
+			CatchUp:     true,
+			SourceShard: sourceShard,
+			Merge:       merge,
+			MergeShards: []int64{1, 2},
+			Entries: []*proto.LogEntry{
+				createAddRequest(t, 1, 100, map[string]string{key: key}, 0).Entry,
+			},
+		})
+		close(stream.chunks)
+		assert.NoError(t, fc.SendSnapshot(stream))
+		return stream.GetResponse().AckOffset
+	}
+
+	getKey := func(key string) proto.Status {
+		res, err := fc.(*followerController).db.Get(&proto.GetRequest{Key: key})
+		assert.NoError(t, err)
+		return res.Status
+	}
+
+	// The snapshot of the first shard replaces the database, while the one of
+	// the second shard is staged apart from it
+	assert.EqualValues(t, 99, sendSnapshot(1, false))
+	assert.EqualValues(t, 99, sendSnapshot(2, true))
+	assert.Len(t, fc.(*followerController).mergeSources, 1)
+
+	assert.EqualValues(t, 100, catchUp(1, false, "key-left"))
+	assert.Equal(t, proto.Status_OK, getKey("key-left"))
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, getKey("key-right"))
+
+	// Once the second shard is caught up too, the staged data is merged
+	assert.EqualValues(t, 100, catchUp(2, true, "key-right"))
+	assert.Equal(t, proto.Status_OK, getKey("key-left"))
+	assert.Equal(t, proto.Status_OK, getKey("key-right"))
+	assert.Equal(t, proto.Status_OK, getKey("key-50"))
+	assert.Empty(t, fc.(*followerController).mergeSources)
+
+	// The log entries of a shard that was not staged are rejected
+	stream := newMockServerSendSnapshotStream()
+	stream.AddChunk(&proto.SnapshotChunk{Term: 1, CatchUp: true, Merge: true, SourceShard: 2})
+	close(stream.chunks)
+	assert.Error(t, fc.SendSnapshot(stream))
+
+	assert.NoError(t, fc.Close())
+	assert.NoError(t, kvFactory.Close())
+	assert.NoError(t, walFactory.Close())
+}
+
 func TestFollower_HandleSnapshotWithWrongTerm(t *testing.T) {
 	var shardId int64
 	kvFactory, err := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
@@ -1030,6 +1216,10 @@ func TestFollower_HandleSnapshotWithWrongTerm(t *testing.T) {
 	assert.EqualValues(t, 0, r1.Offset)
 	close(stream.requests)
 
+	// The snapshot is rejected until the follower has released the
+	// replication stream
+	assert.Eventually(t, func() bool { return !closeChanIsNotNil(fc)() }, 10*time.Second, 10*time.Millisecond)
+
 	// Load snapshot into follower
 	snapshot := prepareTestDb(t)
 
@@ -1061,8 +1251,11 @@ func TestFollower_HandleSnapshotWithWrongTerm(t *testing.T) {
 
 	close(snapshotStream.chunks)
 
-	// The snapshot sending should fail because the term is invalid
-	assert.ErrorIs(t, constant.ErrInvalidTerm, wg.Wait(context.Background()))
+	// The snapshot sending should fail because the term is invalid, without
+	// wiping out the data of the follower
+	assert.ErrorIs(t, wg.Wait(context.Background()), constant.ErrInvalidTerm)
+	assert.EqualValues(t, 0, fc.(*followerController).wal.LastOffset())
+	assert.NotNil(t, fc.(*followerController).db)
 
 	_, err = fc.NewTerm(&proto.NewTermRequest{Term: 5})
 	assert.NoError(t, err)
@@ -1112,3 +1305,76 @@ func createAddRequest(t *testing.T, term int64, offset int64,
 		CommitOffset: commitOffset,
 	}
 }
+
+func followerGet(fc FollowerController, key string, maxStalenessMs *uint64) (*proto.GetResponse, error) {
+	ch := make(chan *entity.TWithError[*proto.GetResponse])
+	go fc.Read(context.Background(), &proto.ReadRequest{
+		Gets:           []*proto.GetRequest{{Key: key, IncludeValue: true}},
+		MaxStalenessMs: maxStalenessMs,
+	}, concurrent.ReadFromStreamCallback(ch))
+	res, err := channel.ReadAll(context.Background(), ch)
+	if err != nil {
+		return nil, err
+	}
+	return res[0], nil
+}
+
+func TestFollower_StaleReads(t *testing.T) {
+	var shardId int64
+	kvFactory, err := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	walFactory := newTestWalFactory(t)
+
+	fc, err := NewFollowerController(Config{}, constant.DefaultNamespace, shardId, walFactory, kvFactory)
+	assert.NoError(t, err)
+
+	_, err = fc.NewTerm(&proto.NewTermRequest{Term: 1})
+	assert.NoError(t, err)
+
+	// The follower is fenced
+	_, err = followerGet(fc, "a", pb.Uint64(60_000))
+	assert.Equal(t, constant.CodeInvalidStatus, status.Code(err))
+
+	_, err = fc.Truncate(&proto.TruncateRequest{
+		Term:        1,
+		HeadEntryId: &proto.EntryId{Term: 0, Offset: wal.InvalidOffset},
+	})
+	assert.NoError(t, err)
+
+	// Nothing was received from the leader yet
+	_, err = followerGet(fc, "a", pb.Uint64(60_000))
+	assert.ErrorIs(t, err, constant.ErrFollowerTooStale)
+
+	stream := newMockServerReplicateStream()
+	go func() {
+		// cancelled due to fc.Close() below
+		assert.ErrorIs(t, fc.Replicate(stream), context.Canceled)
+	}()
+
+	stream.AddRequest(createAddRequest(t, 1, 0, map[string]string{"a": "0"}, wal.InvalidOffset))
+	stream.AddRequest(createAddRequest(t, 1, 1, map[string]string{"a": "1"}, 0))
+	stream.GetResponse()
+	stream.GetResponse()
+
+	assert.Eventually(t, func() bool {
+		return fc.CommitOffset() == 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		res, err := followerGet(fc, "a", pb.Uint64(60_000))
+		return err == nil && string(res.Value) == "0"
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Regular reads are only served by the leader
+	_, err = followerGet(fc, "a", nil)
+	assert.ErrorIs(t, err, constant.ErrNodeIsNotLeader)
+
+	// The data is too old for the requested staleness
+	time.Sleep(50 * time.Millisecond)
+	_, err = followerGet(fc, "a", pb.Uint64(10))
+	assert.ErrorIs(t, err, constant.ErrFollowerTooStale)
+
+	assert.NoError(t, fc.Close())
+	assert.NoError(t, kvFactory.Close())
+	assert.NoError(t, walFactory.Close())
+}
@@ -265,37 +265,13 @@ func (fc *followerCursor) sendSnapshot() error {
 
 	defer snapshot.Close()
 
-	var chunksCount, totalSize int64
 	startTime := time.Now()
 
-	for ; snapshot.Valid(); snapshot.Next() {
-		chunk, err := snapshot.Chunk()
-		if err != nil {
-			return err
-		}
-		content := chunk.Content()
-
-		fc.log.Debug(
-			"Sending snapshot chunk",
-			slog.String("chunk-name", chunk.Name()),
-			slog.Int("chunk-size", len(content)),
-		)
-
-		if err := stream.Send(&proto.SnapshotChunk{
-			Term:       fc.term,
-			Name:       chunk.Name(),
-			ChunkIndex: chunk.Index(),
-			ChunkCount: chunk.TotalCount(),
-			Content:    content,
-		}); err != nil {
-			return err
-		}
-
-		chunksCount++
-		size := len(content)
-		totalSize += int64(size)
-		fc.snapshotsBytesSent.Add(size)
+	chunksCount, totalSize, err := sendSnapshotChunks(stream, snapshot, fc.shardId, fc.term, nil, false, fc.log)
+	if err != nil {
+		return err
 	}
+	fc.snapshotsBytesSent.Add(int(totalSize))
 
 	fc.log.Debug("Sent the complete snapshot, waiting for response")
 
@@ -321,6 +297,45 @@ func (fc *followerCursor) sendSnapshot() error {
 	return nil
 }
 
+// sendSnapshotChunks sends all the chunks of a DB snapshot through the stream.
+// If a hash range is specified, the receiver will only retain the records in
+// that range. If merge is set, the receiver will merge the records into its
+// existing data.
+func sendSnapshotChunks(stream proto.OxiaLogReplication_SendSnapshotClient, snapshot kv.Snapshot, sourceShard int64, term int64,
+	hashRange *proto.Int32HashRange, merge bool, log *slog.Logger) (chunksCount int64, totalSize int64, err error) {
+	for ; snapshot.Valid(); snapshot.Next() {
+		chunk, err := snapshot.Chunk()
+		if err != nil {
+			return chunksCount, totalSize, err
+		}
+		content := chunk.Content()
+
+		log.Debug(
+			"Sending snapshot chunk",
+			slog.String("chunk-name", chunk.Name()),
+			slog.Int("chunk-size", len(content)),
+		)
+
+		if err := stream.Send(&proto.SnapshotChunk{
+			Term:           term,
+			Name:           chunk.Name(),
+			ChunkIndex:     chunk.Index(),
+			ChunkCount:     chunk.TotalCount(),
+			Content:        content,
+			Int32HashRange: hashRange,
+			Merge:          merge,
+			SourceShard:    sourceShard,
+		}); err != nil {
+			return chunksCount, totalSize, err
+		}
+
+		chunksCount++
+		totalSize += int64(len(content))
+	}
+
+	return chunksCount, totalSize, nil
+}
+
 func (fc *followerCursor) streamEntriesLoop(ctx context.Context, reader wal.Reader, currentOffset int64) error {
 	for {
 		if fc.closed.Load() {
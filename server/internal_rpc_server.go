@@ -210,6 +210,141 @@ func (s *internalRpcServer) AddFollower(c context.Context, req *proto.AddFollowe
 	return res, err
 }
 
+func (s *internalRpcServer) SplitShard(c context.Context, req *proto.SplitShardRequest) (*proto.SplitShardResponse, error) {
+	log := s.log.With(
+		slog.Any("request", req),
+		slog.String("peer", rpc.GetPeer(c)),
+	)
+
+	log.Info("Received SplitShard request")
+
+	leader, err := s.shardsDirector.GetLeader(req.Shard)
+	if err != nil {
+		log.Warn(
+			"SplitShard failed: could not get leader controller",
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+
+	res, err := leader.SplitShard(c, req)
+	if err != nil {
+		log.Warn(
+			"SplitShard failed",
+			slog.Any("error", err),
+		)
+	}
+	return res, err
+}
+
+func (s *internalRpcServer) MergeShard(c context.Context, req *proto.MergeShardRequest) (*proto.MergeShardResponse, error) {
+	log := s.log.With(
+		slog.Any("request", req),
+		slog.String("peer", rpc.GetPeer(c)),
+	)
+
+	log.Info("Received MergeShard request")
+
+	leader, err := s.shardsDirector.GetLeader(req.Shard)
+	if err != nil {
+		log.Warn(
+			"MergeShard failed: could not get leader controller",
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+
+	res, err := leader.MergeShard(c, req)
+	if err != nil {
+		log.Warn(
+			"MergeShard failed",
+			slog.Any("error", err),
+		)
+	}
+	return res, err
+}
+
+func (s *internalRpcServer) TransferLeadership(c context.Context, req *proto.TransferLeadershipRequest) (*proto.TransferLeadershipResponse, error) {
+	log := s.log.With(
+		slog.Any("request", req),
+		slog.String("peer", rpc.GetPeer(c)),
+	)
+
+	log.Info("Received TransferLeadership request")
+
+	leader, err := s.shardsDirector.GetLeader(req.Shard)
+	if err != nil {
+		log.Warn(
+			"TransferLeadership failed: could not get leader controller",
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+
+	res, err := leader.TransferLeadership(c, req)
+	if err != nil {
+		log.Warn(
+			"TransferLeadership failed",
+			slog.Any("error", err),
+		)
+	}
+	return res, err
+}
+
+func (s *internalRpcServer) ListShardSessions(c context.Context, req *proto.ListShardSessionsRequest) (*proto.ListShardSessionsResponse, error) {
+	log := s.log.With(
+		slog.Any("request", req),
+		slog.String("peer", rpc.GetPeer(c)),
+	)
+
+	log.Info("Received ListShardSessions request")
+
+	leader, err := s.shardsDirector.GetLeader(req.Shard)
+	if err != nil {
+		log.Warn(
+			"ListShardSessions failed: could not get leader controller",
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+
+	res, err := leader.ListSessions(req)
+	if err != nil {
+		log.Warn(
+			"ListShardSessions failed",
+			slog.Any("error", err),
+		)
+	}
+	return res, err
+}
+
+func (s *internalRpcServer) RevokeShardSession(c context.Context, req *proto.RevokeShardSessionRequest) (*proto.RevokeShardSessionResponse, error) {
+	log := s.log.With(
+		slog.Any("request", req),
+		slog.String("peer", rpc.GetPeer(c)),
+	)
+
+	log.Info("Received RevokeShardSession request")
+
+	leader, err := s.shardsDirector.GetLeader(req.Shard)
+	if err != nil {
+		log.Warn(
+			"RevokeShardSession failed: could not get leader controller",
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+
+	res, err := leader.RevokeSession(req)
+	if err != nil {
+		log.Warn(
+			"RevokeShardSession failed",
+			slog.Any("error", err),
+		)
+	}
+	return res, err
+}
+
 func (s *internalRpcServer) Truncate(c context.Context, req *proto.TruncateRequest) (*proto.TruncateResponse, error) {
 	log := s.log.With(
 		slog.Any("request", req),
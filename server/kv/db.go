@@ -15,7 +15,9 @@
 package kv
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -70,12 +72,17 @@ type RangeScanIterator interface {
 
 type TermOptions struct {
 	NotificationsEnabled bool
+
+	// Whether the notifications capture the written and the replaced values
+	NotificationsIncludeValue         bool
+	NotificationsIncludePreviousValue bool
 }
 
 type DB interface {
 	io.Closer
 
 	EnableNotifications(enable bool)
+	CaptureNotificationValues(value bool, previousValue bool)
 
 	ProcessWrite(b *proto.WriteRequest, commitOffset int64, timestamp uint64, updateOperationCallback UpdateOperationCallback) (*proto.WriteResponse, error)
 	Get(request *proto.GetRequest) (*proto.GetResponse, error)
@@ -93,6 +100,17 @@ type DB interface {
 
 	Snapshot() (Snapshot, error)
 
+	// DeleteOutsideHashRange removes all the records that do not belong to the given hash range
+	DeleteOutsideHashRange(minInclusive uint32, maxInclusive uint32, updateOperationCallback UpdateOperationCallback) error
+
+	// Merge copies all the records from the source KV, taken from the source
+	// shard, into this database
+	Merge(source KV, sourceShard int64) error
+
+	// ExpiredRecords returns the requests to delete the records whose time-to-live
+	// has expired at the given timestamp
+	ExpiredRecords(timestamp uint64, maxCount int) ([]*proto.DeleteRequest, error)
+
 	// Delete and close the database and all its files
 	Delete() error
 }
@@ -107,12 +125,24 @@ func NewDB(namespace string, shardId int64, factory Factory,
 		return nil, err
 	}
 
+	return NewDBWithKV(namespace, shardId, kv, keyEncoder, notificationRetentionTime, clock)
+}
+
+// NewDBWithKV opens a database over an existing KV, such as the one of a
+// staging snapshot. The KV is closed along with the database.
+func NewDBWithKV(namespace string, shardId int64, kv KV,
+	keyEncoder compare.Encoder,
+	notificationRetentionTime time.Duration,
+	clock time2.Clock,
+) (DB, error) {
 	labels := metric.LabelsForShard(namespace, shardId)
 	db := &db{
 		kv:                    kv,
+		keyEncoder:            keyEncoder,
 		shardId:               shardId,
 		notificationsEnabled:  true,
 		sequenceWaiterTracker: NewSequencesWaitTracker(),
+		sequenceUpdates:       map[string]string{},
 		log: slog.With(
 			slog.String("component", "db"),
 			slog.String("namespace", namespace),
@@ -131,6 +161,12 @@ func NewDB(namespace string, shardId int64, factory Factory,
 			"The total number of delete operations", "count", labels),
 		deleteRangesCounter: metric.NewCounter("oxia_server_db_delete_ranges",
 			"The total number of delete ranges operations", "count", labels),
+		incrementCounter: metric.NewCounter("oxia_server_db_increments",
+			"The total number of increment operations", "count", labels),
+		appendCounter: metric.NewCounter("oxia_server_db_appends",
+			"The total number of append operations", "count", labels),
+		mergePatchCounter: metric.NewCounter("oxia_server_db_merge_patches",
+			"The total number of merge patch operations", "count", labels),
 		getCounter: metric.NewCounter("oxia_server_db_gets",
 			"The total number of get operations", "count", labels),
 		getSequenceUpdatesCounter: metric.NewCounter("oxia_server_db_get_sequence_updates",
@@ -158,6 +194,7 @@ func NewDB(namespace string, shardId int64, factory Factory,
 
 type db struct {
 	kv                    KV
+	keyEncoder            compare.Encoder
 	shardId               int64
 	committedVersionId    atomic.Int64
 	notificationsTracker  *notificationsTracker
@@ -165,9 +202,19 @@ type db struct {
 	notificationsEnabled  bool
 	sequenceWaiterTracker SequenceWaiterTracker
 
+	// The last keys of the sequences updated by the write being processed,
+	// notified to the waiters once the write is committed
+	sequenceUpdates map[string]string
+
+	notificationsIncludeValue         bool
+	notificationsIncludePreviousValue bool
+
 	putCounter                metric.Counter
 	deleteCounter             metric.Counter
 	deleteRangesCounter       metric.Counter
+	incrementCounter          metric.Counter
+	appendCounter             metric.Counter
+	mergePatchCounter         metric.Counter
 	getCounter                metric.Counter
 	getSequenceUpdatesCounter metric.Counter
 	listCounter               metric.Counter
@@ -186,6 +233,11 @@ func (d *db) EnableNotifications(enabled bool) {
 	d.notificationsEnabled = enabled
 }
 
+func (d *db) CaptureNotificationValues(value bool, previousValue bool) {
+	d.notificationsIncludeValue = value
+	d.notificationsIncludePreviousValue = previousValue
+}
+
 func (d *db) Close() error {
 	return multierr.Combine(
 		d.sequenceWaiterTracker.Close(),
@@ -210,13 +262,29 @@ func (d *db) applyWriteRequest(b *proto.WriteRequest, batch WriteBatch,
 	baseVersionId *atomic.Int64, commitOffset int64, timestamp uint64,
 	updateOperationCallback UpdateOperationCallback) (*Notifications, *proto.WriteResponse, error) {
 	res := &proto.WriteResponse{}
-	var notifications *Notifications
-	if d.notificationsEnabled {
-		notifications = newNotifications(d.shardId, commitOffset, timestamp)
+	notifications := d.newNotifications(commitOffset, timestamp)
+
+	if b.Transaction != nil {
+		res, err := d.applyTransaction(b, batch, baseVersionId, notifications, timestamp, updateOperationCallback)
+		if err != nil {
+			return nil, nil, err
+		}
+		return notifications, res, nil
 	}
 
 	d.putCounter.Add(len(b.Puts))
 	for _, putReq := range b.Puts {
+		if len(putReq.GetSequenceKeyDelta()) == 0 {
+			locked, err := isKeyLocked(batch, putReq.Key)
+			if err != nil {
+				return nil, nil, err
+			}
+			if locked {
+				res.Puts = append(res.Puts, &proto.PutResponse{Status: proto.Status_KEY_LOCKED})
+				continue
+			}
+		}
+
 		pr, err := d.applyPut(batch, baseVersionId, notifications, putReq, timestamp, updateOperationCallback, false)
 		if err != nil {
 			return nil, nil, err
@@ -226,6 +294,15 @@ func (d *db) applyWriteRequest(b *proto.WriteRequest, batch WriteBatch,
 
 	d.deleteCounter.Add(len(b.Deletes))
 	for _, delReq := range b.Deletes {
+		locked, err := isKeyLocked(batch, delReq.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if locked {
+			res.Deletes = append(res.Deletes, &proto.DeleteResponse{Status: proto.Status_KEY_LOCKED})
+			continue
+		}
+
 		dr, err := d.applyDelete(batch, notifications, delReq, updateOperationCallback)
 		if err != nil {
 			return nil, nil, err
@@ -236,6 +313,15 @@ func (d *db) applyWriteRequest(b *proto.WriteRequest, batch WriteBatch,
 
 	d.deleteRangesCounter.Add(len(b.DeleteRanges))
 	for _, delRangeReq := range b.DeleteRanges {
+		locked, err := d.isRangeLocked(batch, delRangeReq.StartInclusive, delRangeReq.EndExclusive)
+		if err != nil {
+			return nil, nil, err
+		}
+		if locked {
+			res.DeleteRanges = append(res.DeleteRanges, &proto.DeleteRangeResponse{Status: proto.Status_KEY_LOCKED})
+			continue
+		}
+
 		dr, err := d.applyDeleteRange(batch, notifications, delRangeReq, updateOperationCallback)
 		if err != nil {
 			return nil, nil, err
@@ -244,6 +330,63 @@ func (d *db) applyWriteRequest(b *proto.WriteRequest, batch WriteBatch,
 		res.DeleteRanges = append(res.DeleteRanges, dr)
 	}
 
+	d.incrementCounter.Add(len(b.Increments))
+	for _, incReq := range b.Increments {
+		locked, err := isKeyLocked(batch, incReq.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if locked {
+			res.Increments = append(res.Increments, &proto.IncrementResponse{Status: proto.Status_KEY_LOCKED})
+			continue
+		}
+
+		ir, err := d.applyIncrement(batch, baseVersionId, notifications, incReq, timestamp, updateOperationCallback)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res.Increments = append(res.Increments, ir)
+	}
+
+	d.appendCounter.Add(len(b.Appends))
+	for _, appReq := range b.Appends {
+		locked, err := isKeyLocked(batch, appReq.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if locked {
+			res.Appends = append(res.Appends, &proto.AppendResponse{Status: proto.Status_KEY_LOCKED})
+			continue
+		}
+
+		ar, err := d.applyAppend(batch, baseVersionId, notifications, appReq, timestamp, updateOperationCallback)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res.Appends = append(res.Appends, ar)
+	}
+
+	d.mergePatchCounter.Add(len(b.MergePatches))
+	for _, mergeReq := range b.MergePatches {
+		locked, err := isKeyLocked(batch, mergeReq.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if locked {
+			res.MergePatches = append(res.MergePatches, &proto.MergePatchResponse{Status: proto.Status_KEY_LOCKED})
+			continue
+		}
+
+		mr, err := d.applyMergePatch(batch, baseVersionId, notifications, mergeReq, timestamp, updateOperationCallback)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res.MergePatches = append(res.MergePatches, mr)
+	}
+
 	return notifications, res, nil
 }
 
@@ -253,6 +396,7 @@ func (d *db) ProcessWrite(b *proto.WriteRequest, commitOffset int64, timestamp u
 
 	baseVersionId := &atomic.Int64{}
 	baseVersionId.Store(d.committedVersionId.Load())
+	defer clear(d.sequenceUpdates)
 
 	batch := d.kv.NewWriteBatch()
 	notifications, res, err := d.applyWriteRequest(b, batch, baseVersionId, commitOffset, timestamp, updateOperationCallback)
@@ -260,6 +404,18 @@ func (d *db) ProcessWrite(b *proto.WriteRequest, commitOffset int64, timestamp u
 		return nil, err
 	}
 
+	if b.Atomic && abortAtomicWriteResponse(res) {
+		// Each operation was checked against the changes of the previous
+		// ones. Since some of them failed, throw away all the changes
+		if err := batch.Close(); err != nil {
+			return nil, err
+		}
+		batch = d.kv.NewWriteBatch()
+		baseVersionId.Store(d.committedVersionId.Load())
+		notifications = d.newNotifications(commitOffset, timestamp)
+		clear(d.sequenceUpdates)
+	}
+
 	if err := d.addASCIILong(commitOffsetKey, commitOffset, batch, timestamp); err != nil {
 		return nil, err
 	}
@@ -285,6 +441,9 @@ func (d *db) ProcessWrite(b *proto.WriteRequest, commitOffset int64, timestamp u
 	if notifications != nil {
 		d.notificationsTracker.UpdatedCommitOffset(commitOffset)
 	}
+	for prefixKey, lastKey := range d.sequenceUpdates {
+		d.sequenceWaiterTracker.SequenceUpdated(prefixKey, lastKey)
+	}
 
 	if err := batch.Close(); err != nil {
 		return nil, err
@@ -293,6 +452,16 @@ func (d *db) ProcessWrite(b *proto.WriteRequest, commitOffset int64, timestamp u
 	return res, nil
 }
 
+func (d *db) newNotifications(commitOffset int64, timestamp uint64) *Notifications {
+	if !d.notificationsEnabled {
+		return nil
+	}
+	notifications := newNotifications(d.shardId, commitOffset, timestamp)
+	notifications.includeValue = d.notificationsIncludeValue
+	notifications.includePreviousValue = d.notificationsIncludePreviousValue
+	return notifications
+}
+
 func (*db) addNotifications(batch WriteBatch, notifications *Notifications) error {
 	value, err := notifications.batch.MarshalVT()
 	if err != nil {
@@ -352,7 +521,7 @@ func (it *listIterator) Close() error {
 func (d *db) List(request *proto.ListRequest) (KeyIterator, error) {
 	d.listCounter.Add(1)
 
-	it, err := d.kv.KeyRangeScan(request.StartInclusive, request.EndExclusive)
+	it, err := d.scan(request.StartInclusive, request.EndExclusive, request.StartAfterKey, request.Reverse)
 	if err != nil {
 		return nil, err
 	}
@@ -384,12 +553,13 @@ func (it *rangeScanIterator) Value() (*proto.GetResponse, error) {
 		Value:  se.Value,
 		Status: proto.Status_OK,
 		Version: &proto.Version{
-			VersionId:          se.VersionId,
-			ModificationsCount: se.ModificationsCount,
-			CreatedTimestamp:   se.CreationTimestamp,
-			ModifiedTimestamp:  se.ModificationTimestamp,
-			SessionId:          se.SessionId,
-			ClientIdentity:     se.ClientIdentity,
+			VersionId:           se.VersionId,
+			ModificationsCount:  se.ModificationsCount,
+			CreatedTimestamp:    se.CreationTimestamp,
+			ModifiedTimestamp:   se.ModificationTimestamp,
+			SessionId:           se.SessionId,
+			ClientIdentity:      se.ClientIdentity,
+			ExpirationTimestamp: se.ExpirationTimestamp,
 		},
 	}
 
@@ -404,7 +574,7 @@ func (it *rangeScanIterator) Close() error {
 func (d *db) RangeScan(request *proto.RangeScanRequest) (RangeScanIterator, error) {
 	d.rangeScanCounter.Add(1)
 
-	it, err := d.kv.RangeScan(request.StartInclusive, request.EndExclusive)
+	it, err := d.scan(request.StartInclusive, request.EndExclusive, request.StartAfterKey, request.Reverse)
 	if err != nil {
 		return nil, err
 	}
@@ -415,6 +585,76 @@ func (d *db) RangeScan(request *proto.RangeScanRequest) (RangeScanIterator, erro
 	}, nil
 }
 
+// scan returns an iterator over the records in the range, in ascending or in
+// descending order. If `startAfterKey` is set, all the records up to that key,
+// in the order of the scan, are skipped.
+func (d *db) scan(startInclusive string, endExclusive string, startAfterKey *string, reverse bool) (KeyValueIterator, error) {
+	if !reverse {
+		return d.kv.RangeScan(d.scanStart(startInclusive, startAfterKey), endExclusive)
+	}
+
+	it, err := d.kv.RangeScanReverse(startInclusive, d.scanEnd(endExclusive, startAfterKey))
+	if err != nil {
+		return nil, err
+	}
+	return &reverseIterator{it}, nil
+}
+
+// scanStart returns the first key of a scan, skipping all the keys up to
+// `startAfterKey`, if it's set.
+func (d *db) scanStart(startInclusive string, startAfterKey *string) string {
+	if startAfterKey == nil {
+		return startInclusive
+	}
+
+	next := KeySuccessor(*startAfterKey)
+	if bytes.Compare(d.keyEncoder.Encode(next), d.keyEncoder.Encode(startInclusive)) > 0 {
+		return next
+	}
+	return startInclusive
+}
+
+// scanEnd returns the upper bound of a reverse scan, skipping all the keys
+// down to `startAfterKey`, if it's set.
+func (d *db) scanEnd(endExclusive string, startAfterKey *string) string {
+	if startAfterKey == nil {
+		return endExclusive
+	}
+
+	if endExclusive == "" || bytes.Compare(d.keyEncoder.Encode(*startAfterKey), d.keyEncoder.Encode(endExclusive)) < 0 {
+		return *startAfterKey
+	}
+	return endExclusive
+}
+
+// reverseIterator adapts a reverse iterator, so that it can be advanced with
+// Next.
+type reverseIterator struct {
+	ReverseKeyValueIterator
+}
+
+func (it *reverseIterator) Next() bool {
+	return it.ReverseKeyValueIterator.Prev()
+}
+
+func (*reverseIterator) Prev() bool {
+	panic("not supported")
+}
+
+func (*reverseIterator) SeekGE(string) bool {
+	panic("not supported")
+}
+
+func (*reverseIterator) SeekLT(string) bool {
+	panic("not supported")
+}
+
+// KeySuccessor returns the key that immediately follows the given key, in the
+// order of the keys in the DB.
+func KeySuccessor(key string) string {
+	return key + "\x00"
+}
+
 func (d *db) KeyIterator() (KeyIterator, error) {
 	return d.kv.KeyIterator()
 }
@@ -428,8 +668,10 @@ func (d *db) readLastVersionId() (int64, error) {
 }
 
 func (d *db) readASCIILong(key string) (int64, error) {
-	kv := d.kv
+	return readASCIILong(d.kv, key)
+}
 
+func readASCIILong(kv KV, key string) (int64, error) {
 	getReq := &proto.GetRequest{
 		Key:          key,
 		IncludeValue: true,
@@ -522,13 +764,17 @@ func (d *db) applyPut(batch WriteBatch, baseVersionId *atomic.Int64, notificatio
 	var se *proto.StorageEntry
 	var err error
 	var newKey string
+	status := proto.Status_OK
 	if len(putReq.GetSequenceKeyDelta()) > 0 {
 		prefixKey := putReq.Key
 		newKey, err = generateUniqueKeyFromSequences(batch, putReq)
 		putReq.Key = newKey
-		d.sequenceWaiterTracker.SequenceUpdated(prefixKey, newKey)
+		if err == nil {
+			d.sequenceUpdates[prefixKey] = newKey
+		}
 	} else if !internal {
-		se, err = checkExpectedVersionId(batch, putReq.Key, putReq.ExpectedVersionId)
+		se, status, err = checkConditions(batch, putReq.Key, putReq.ExpectedVersionId,
+			putReq.GetExpectedRecordExists(), putReq.ExpectedValueHash)
 	}
 
 	switch {
@@ -538,10 +784,15 @@ func (d *db) applyPut(batch WriteBatch, baseVersionId *atomic.Int64, notificatio
 		}, nil
 	case err != nil:
 		return nil, errors.Wrap(err, "oxia db: failed to apply batch")
+	case status != proto.Status_OK:
+		return &proto.PutResponse{
+			Status: status,
+		}, nil
 	}
 
 	// No version conflict
 	versionId := wal.InvalidOffset
+	var expirationTimestamp *uint64
 	if !internal {
 		status, err := updateOperationCallback.OnPut(batch, notifications, putReq, se)
 		if err != nil {
@@ -553,8 +804,16 @@ func (d *db) applyPut(batch WriteBatch, baseVersionId *atomic.Int64, notificatio
 			}, nil
 		}
 		versionId = baseVersionId.Add(1)
+
+		if putReq.TtlMs != nil {
+			expirationTimestamp = pb.Uint64(timestamp + putReq.GetTtlMs())
+		}
+		if err = updateExpirationIndex(batch, putReq.Key, se, expirationTimestamp); err != nil {
+			return nil, err
+		}
 	}
 
+	previousValue := se.GetValue()
 	if se == nil {
 		se = proto.StorageEntryFromVTPool()
 		se.VersionId = versionId
@@ -576,6 +835,7 @@ func (d *db) applyPut(batch WriteBatch, baseVersionId *atomic.Int64, notificatio
 	}
 
 	se.SecondaryIndexes = putReq.SecondaryIndexes
+	se.ExpirationTimestamp = expirationTimestamp
 
 	defer se.ReturnToVTPool()
 
@@ -589,16 +849,17 @@ func (d *db) applyPut(batch WriteBatch, baseVersionId *atomic.Int64, notificatio
 	}
 
 	if notifications != nil {
-		notifications.Modified(putReq.Key, se.VersionId, se.ModificationsCount)
+		notifications.Modified(putReq.Key, se.VersionId, se.ModificationsCount, se.Value, previousValue)
 	}
 
 	version := &proto.Version{
-		VersionId:          se.VersionId,
-		ModificationsCount: se.ModificationsCount,
-		CreatedTimestamp:   se.CreationTimestamp,
-		ModifiedTimestamp:  se.ModificationTimestamp,
-		SessionId:          se.SessionId,
-		ClientIdentity:     se.ClientIdentity,
+		VersionId:           se.VersionId,
+		ModificationsCount:  se.ModificationsCount,
+		CreatedTimestamp:    se.CreationTimestamp,
+		ModifiedTimestamp:   se.ModificationTimestamp,
+		SessionId:           se.SessionId,
+		ClientIdentity:      se.ClientIdentity,
+		ExpirationTimestamp: se.ExpirationTimestamp,
 	}
 
 	d.log.Debug(
@@ -615,30 +876,32 @@ func (d *db) applyPut(batch WriteBatch, baseVersionId *atomic.Int64, notificatio
 }
 
 func (d *db) applyDelete(batch WriteBatch, notifications *Notifications, delReq *proto.DeleteRequest, updateOperationCallback UpdateOperationCallback) (*proto.DeleteResponse, error) {
-	se, err := checkExpectedVersionId(batch, delReq.Key, delReq.ExpectedVersionId)
+	se, status, err := checkConditions(batch, delReq.Key, delReq.ExpectedVersionId, true, delReq.ExpectedValueHash)
 	if se != nil {
 		defer se.ReturnToVTPool()
 	}
 
 	switch {
-	case errors.Is(err, ErrBadVersionId):
-		return &proto.DeleteResponse{Status: proto.Status_UNEXPECTED_VERSION_ID}, nil
 	case err != nil:
 		return nil, errors.Wrap(err, "oxia db: failed to apply batch")
-	case se == nil:
-		return &proto.DeleteResponse{Status: proto.Status_KEY_NOT_FOUND}, nil
+	case status != proto.Status_OK:
+		return &proto.DeleteResponse{Status: status}, nil
 	default:
 		err = updateOperationCallback.OnDelete(batch, notifications, delReq.Key)
 		if err != nil {
 			return nil, err
 		}
 
+		if err = DeleteExpirationIndex(batch, delReq.Key, se); err != nil {
+			return nil, err
+		}
+
 		if err = batch.Delete(delReq.Key); err != nil {
 			return &proto.DeleteResponse{}, err
 		}
 
 		if notifications != nil {
-			notifications.Deleted(delReq.Key)
+			notifications.Deleted(delReq.Key, se.GetValue())
 		}
 
 		d.log.Debug(
@@ -677,7 +940,10 @@ func (d *db) applyDeleteRange(batch WriteBatch, notifications *Notifications, de
 			se.ReturnToVTPool()
 			return nil, err
 		}
-		if err = updateOperationCallback.OnDeleteWithEntry(batch, notifications, key, se); err != nil {
+		if err = updateOperationCallback.OnDeleteWithEntry(batch, notifications, key, se); err == nil {
+			err = DeleteExpirationIndex(batch, key, se)
+		}
+		if err != nil {
 			se.ReturnToVTPool()
 			return nil, errors.Wrap(multierr.Combine(err, it.Close()), "oxia db: failed to callback on delete range")
 		}
@@ -740,12 +1006,13 @@ func applyGet(kv KV, getReq *proto.GetRequest) (*proto.GetResponse, error) {
 	res := &proto.GetResponse{
 		Value: resValue,
 		Version: &proto.Version{
-			VersionId:          se.VersionId,
-			ModificationsCount: se.ModificationsCount,
-			CreatedTimestamp:   se.CreationTimestamp,
-			ModifiedTimestamp:  se.ModificationTimestamp,
-			SessionId:          se.SessionId,
-			ClientIdentity:     se.ClientIdentity,
+			VersionId:           se.VersionId,
+			ModificationsCount:  se.ModificationsCount,
+			CreatedTimestamp:    se.CreationTimestamp,
+			ModifiedTimestamp:   se.ModificationTimestamp,
+			SessionId:           se.SessionId,
+			ClientIdentity:      se.ClientIdentity,
+			ExpirationTimestamp: se.ExpirationTimestamp,
 		},
 	}
 
@@ -796,6 +1063,116 @@ func checkExpectedVersionId(batch WriteBatch, key string, expectedVersionId *int
 	return se, nil
 }
 
+// checkConditions verifies the conditions of an operation against the record
+// currently stored with the given key. If the conditions are satisfied, the
+// stored record is returned, if any.
+func checkConditions(batch WriteBatch, key string, expectedVersionId *int64,
+	expectedRecordExists bool, expectedValueHash []byte) (*proto.StorageEntry, proto.Status, error) {
+	se, err := checkExpectedVersionId(batch, key, expectedVersionId)
+	switch {
+	case errors.Is(err, ErrBadVersionId):
+		return nil, proto.Status_UNEXPECTED_VERSION_ID, nil
+	case err != nil:
+		return nil, proto.Status_OK, err
+	case se == nil:
+		if expectedRecordExists || expectedValueHash != nil {
+			return nil, proto.Status_KEY_NOT_FOUND, nil
+		}
+		return nil, proto.Status_OK, nil
+	}
+
+	if expectedValueHash != nil {
+		hash := sha256.Sum256(se.Value)
+		if !bytes.Equal(hash[:], expectedValueHash) {
+			se.ReturnToVTPool()
+			return nil, proto.Status_UNEXPECTED_VALUE, nil
+		}
+	}
+	return se, proto.Status_OK, nil
+}
+
+// checkOperationConditions verifies that an operation can be applied on the
+// record with the given key, without applying it.
+func checkOperationConditions(batch WriteBatch, key string, expectedVersionId *int64,
+	expectedRecordExists bool, expectedValueHash []byte) (proto.Status, error) {
+	locked, err := isKeyLocked(batch, key)
+	if err != nil {
+		return proto.Status_OK, err
+	}
+	if locked {
+		return proto.Status_KEY_LOCKED, nil
+	}
+
+	se, status, err := checkConditions(batch, key, expectedVersionId, expectedRecordExists, expectedValueHash)
+	if err != nil {
+		return proto.Status_OK, errors.Wrap(err, "oxia db: failed to check conditions")
+	}
+	if se != nil {
+		se.ReturnToVTPool()
+	}
+	return status, nil
+}
+
+// abortAtomicWriteResponse checks whether any operation of an atomic write
+// request failed. If so, all the other operations are marked as aborted in
+// the response, and it returns true.
+func abortAtomicWriteResponse(res *proto.WriteResponse) bool {
+	failed := false
+	for _, pr := range res.Puts {
+		failed = failed || pr.Status != proto.Status_OK
+	}
+	for _, dr := range res.Deletes {
+		failed = failed || dr.Status != proto.Status_OK
+	}
+	for _, dr := range res.DeleteRanges {
+		failed = failed || dr.Status != proto.Status_OK
+	}
+	for _, ir := range res.Increments {
+		failed = failed || ir.Status != proto.Status_OK
+	}
+	for _, ar := range res.Appends {
+		failed = failed || ar.Status != proto.Status_OK
+	}
+	for _, mr := range res.MergePatches {
+		failed = failed || mr.Status != proto.Status_OK
+	}
+	if !failed {
+		return false
+	}
+
+	for i, pr := range res.Puts {
+		if pr.Status == proto.Status_OK {
+			res.Puts[i] = &proto.PutResponse{Status: proto.Status_BATCH_ABORTED}
+		}
+	}
+	for i, dr := range res.Deletes {
+		if dr.Status == proto.Status_OK {
+			res.Deletes[i] = &proto.DeleteResponse{Status: proto.Status_BATCH_ABORTED}
+		}
+	}
+	for i, dr := range res.DeleteRanges {
+		if dr.Status == proto.Status_OK {
+			res.DeleteRanges[i] = &proto.DeleteRangeResponse{Status: proto.Status_BATCH_ABORTED}
+		}
+	}
+	for i, ir := range res.Increments {
+		if ir.Status == proto.Status_OK {
+			res.Increments[i] = &proto.IncrementResponse{Status: proto.Status_BATCH_ABORTED}
+		}
+	}
+	for i, ar := range res.Appends {
+		if ar.Status == proto.Status_OK {
+			res.Appends[i] = &proto.AppendResponse{Status: proto.Status_BATCH_ABORTED}
+		}
+	}
+	for i, mr := range res.MergePatches {
+		if mr.Status == proto.Status_OK {
+			res.MergePatches[i] = &proto.MergePatchResponse{Status: proto.Status_BATCH_ABORTED}
+		}
+	}
+	return true
+}
+
 func Deserialize(value []byte, se *proto.StorageEntry) error {
 	if err := se.UnmarshalVT(value); err != nil {
 		return errors.Wrap(err, "failed to Deserialize storage entry")
@@ -835,6 +1212,8 @@ func ToDbOption(opt *proto.NewTermOptions) TermOptions {
 	to := TermOptions{NotificationsEnabled: true}
 	if opt != nil {
 		to.NotificationsEnabled = opt.EnableNotifications
+		to.NotificationsIncludeValue = opt.NotificationsIncludeValue
+		to.NotificationsIncludePreviousValue = opt.NotificationsIncludePreviousValue
 	}
 
 	return to
@@ -0,0 +1,110 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/proto"
+)
+
+// The records created with a time-to-live are tracked in an index of internal
+// keys, sorted by expiration time, so that the expired records can be found
+// without scanning the whole database.
+const expirationIndexPrefix = constant.InternalKeyPrefix + "expiration"
+
+func expirationIndexKey(key string, expirationTimestamp uint64) string {
+	return fmt.Sprintf("%s/%020d-%s", expirationIndexPrefix, expirationTimestamp, url.PathEscape(key))
+}
+
+func parseExpirationIndexKey(indexKey string) (string, error) {
+	idx := strings.IndexByte(indexKey, '-')
+	if idx < 0 {
+		return "", errors.Errorf("invalid expiration index key: %s", indexKey)
+	}
+	return url.PathUnescape(indexKey[idx+1:])
+}
+
+// updateExpirationIndex replaces the index entry of the existing record, if
+// any, with the one for the new expiration time.
+func updateExpirationIndex(batch WriteBatch, key string, existing *proto.StorageEntry, expirationTimestamp *uint64) error {
+	if err := DeleteExpirationIndex(batch, key, existing); err != nil {
+		return err
+	}
+	if expirationTimestamp == nil {
+		return nil
+	}
+	return batch.Put(expirationIndexKey(key, *expirationTimestamp), []byte{})
+}
+
+// DeleteExpirationIndex removes the index entry of a record that is deleted,
+// if it was created with a time-to-live.
+func DeleteExpirationIndex(batch WriteBatch, key string, se *proto.StorageEntry) error {
+	if se == nil || se.ExpirationTimestamp == nil {
+		return nil
+	}
+	return batch.Delete(expirationIndexKey(key, *se.ExpirationTimestamp))
+}
+
+// ExpiredRecords returns the requests to delete up to maxCount records that
+// expired at the given timestamp.
+//
+// The requests are conditional on the current version of the records, so that
+// a record updated in the meantime will not be deleted. The index entries left
+// by records that do not exist anymore are deleted as well.
+func (d *db) ExpiredRecords(timestamp uint64, maxCount int) ([]*proto.DeleteRequest, error) {
+	it, err := d.kv.KeyRangeScan(expirationIndexPrefix+"/", fmt.Sprintf("%s/%020d", expirationIndexPrefix, timestamp+1))
+	if err != nil {
+		return nil, err
+	}
+
+	var deletes []*proto.DeleteRequest
+	for ; it.Valid() && len(deletes) < maxCount; it.Next() {
+		key, err := parseExpirationIndexKey(it.Key())
+		if err != nil {
+			return nil, multierr.Append(err, it.Close())
+		}
+
+		_, value, closer, err := d.kv.Get(key, ComparisonEqual)
+		if errors.Is(err, ErrKeyNotFound) {
+			deletes = append(deletes, &proto.DeleteRequest{Key: it.Key()})
+			continue
+		} else if err != nil {
+			return nil, multierr.Append(err, it.Close())
+		}
+
+		se := proto.StorageEntryFromVTPool()
+		if err = multierr.Append(Deserialize(value, se), closer.Close()); err != nil {
+			se.ReturnToVTPool()
+			return nil, multierr.Append(err, it.Close())
+		}
+		if se.ExpirationTimestamp != nil && *se.ExpirationTimestamp <= timestamp {
+			deletes = append(deletes, &proto.DeleteRequest{
+				Key:               key,
+				ExpectedVersionId: pb.Int64(se.VersionId),
+			})
+		}
+		se.ReturnToVTPool()
+	}
+
+	return deletes, it.Close()
+}
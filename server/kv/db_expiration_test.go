@@ -0,0 +1,123 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/common/compare"
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/time"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func TestDB_ExpiredRecords(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	d, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	res, err := d.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{
+		{Key: "/a", Value: []byte("a"), TtlMs: pb.Uint64(100)},
+		{Key: "/b", Value: []byte("b"), TtlMs: pb.Uint64(200)},
+		{Key: "/c/d", Value: []byte("c"), TtlMs: pb.Uint64(100)},
+		{Key: "/e", Value: []byte("e"), TtlMs: pb.Uint64(100)},
+		{Key: "/f", Value: []byte("f")},
+	}}, 0, 1000, NoOpCallback)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1100, res.Puts[0].Version.GetExpirationTimestamp())
+	assert.Nil(t, res.Puts[4].Version.ExpirationTimestamp)
+
+	gr, err := d.Get(&proto.GetRequest{Key: "/b"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1200, gr.Version.GetExpirationTimestamp())
+
+	// Updating a record replaces its expiration time
+	_, err = d.ProcessWrite(&proto.WriteRequest{
+		Puts:    []*proto.PutRequest{{Key: "/a", Value: []byte("a"), TtlMs: pb.Uint64(500)}},
+		Deletes: []*proto.DeleteRequest{{Key: "/e"}},
+	}, 1, 1050, NoOpCallback)
+	assert.NoError(t, err)
+
+	expired, err := d.ExpiredRecords(1099, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, expired)
+
+	expired, err = d.ExpiredRecords(1100, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(expired))
+	assert.Equal(t, "/c/d", expired[0].Key)
+	assert.EqualValues(t, 2, expired[0].GetExpectedVersionId())
+
+	expired, err = d.ExpiredRecords(2000, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(expired))
+	assert.Equal(t, "/c/d", expired[0].Key)
+	assert.Equal(t, "/b", expired[1].Key)
+
+	// Removing the time-to-live makes the record persistent
+	_, err = d.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{Key: "/b", Value: []byte("b")}},
+	}, 2, 1100, NoOpCallback)
+	assert.NoError(t, err)
+
+	res, err = d.ProcessWrite(&proto.WriteRequest{Deletes: expired}, 3, 2000, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Deletes[0].Status)
+	assert.Equal(t, proto.Status_UNEXPECTED_VERSION_ID, res.Deletes[1].Status)
+
+	expired, err = d.ExpiredRecords(2000, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(expired))
+	assert.Equal(t, "/a", expired[0].Key)
+
+	_, err = d.ProcessWrite(&proto.WriteRequest{
+		DeleteRanges: []*proto.DeleteRangeRequest{{StartInclusive: "/a", EndExclusive: "/b"}},
+	}, 4, 2000, NoOpCallback)
+	assert.NoError(t, err)
+
+	expired, err = d.ExpiredRecords(2000, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, expired)
+
+	// The index entry of a record deleted without its expiration is removed
+	_, err = d.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{Key: "/g", Value: []byte("g"), TtlMs: pb.Uint64(100)}},
+	}, 5, 2000, NoOpCallback)
+	assert.NoError(t, err)
+	batch := d.(*db).kv.NewWriteBatch()
+	assert.NoError(t, batch.Delete("/g"))
+	assert.NoError(t, batch.Commit())
+	assert.NoError(t, batch.Close())
+
+	expired, err = d.ExpiredRecords(3000, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(expired))
+	assert.Equal(t, expirationIndexKey("/g", 2100), expired[0].Key)
+
+	res, err = d.ProcessWrite(&proto.WriteRequest{Deletes: expired}, 6, 3000, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Deletes[0].Status)
+
+	expired, err = d.ExpiredRecords(3000, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, expired)
+
+	assert.NoError(t, d.Close())
+	assert.NoError(t, factory.Close())
+}
@@ -0,0 +1,94 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"log/slog"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/oxia-db/oxia/proto"
+)
+
+func (d *db) applyIncrement(batch WriteBatch, baseVersionId *atomic.Int64, notifications *Notifications,
+	incReq *proto.IncrementRequest, timestamp uint64,
+	updateOperationCallback UpdateOperationCallback) (*proto.IncrementResponse, error) {
+	// The counter keeps its secondary indexes, its session and its expiration,
+	// like the records that are appended to or patched
+	var value int64
+	putReq, status, err := modifiedPutRequest(batch, incReq.Key, incReq.PartitionKey,
+		incrementModifier(incReq, &value), timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if status != proto.Status_OK {
+		return &proto.IncrementResponse{Status: status}, nil
+	}
+
+	pr, err := d.applyPut(batch, baseVersionId, notifications, putReq, timestamp, updateOperationCallback, false)
+	if err != nil {
+		return nil, err
+	}
+	if pr.Status != proto.Status_OK {
+		return &proto.IncrementResponse{Status: pr.Status}, nil
+	}
+
+	d.log.Debug(
+		"Applied increment operation",
+		slog.String("key", incReq.Key),
+		slog.Int64("delta", incReq.Delta),
+		slog.Int64("value", value),
+	)
+	return &proto.IncrementResponse{
+		Status:  proto.Status_OK,
+		Version: pr.Version,
+		Value:   value,
+	}, nil
+}
+
+// incrementModifier adds the delta of the increment request to the counter,
+// and sets the new value in value.
+func incrementModifier(incReq *proto.IncrementRequest, value *int64) valueModifier {
+	return func(current []byte) ([]byte, proto.Status) {
+		next, status := nextCounterValue(current, incReq)
+		if status != proto.Status_OK {
+			return nil, status
+		}
+		*value = next
+		return []byte(strconv.FormatInt(next, 10)), proto.Status_OK
+	}
+}
+
+// nextCounterValue returns the value that the counter has once the delta of
+// the increment request is added, if it's within the requested bounds.
+func nextCounterValue(current []byte, incReq *proto.IncrementRequest) (int64, proto.Status) {
+	var counter int64
+	if current != nil {
+		var err error
+		if counter, err = strconv.ParseInt(string(current), 10, 64); err != nil {
+			return 0, proto.Status_INVALID_NUMBER
+		}
+	}
+
+	value := counter + incReq.Delta
+	if (incReq.Delta > 0 && value < counter) || (incReq.Delta < 0 && value > counter) {
+		return 0, proto.Status_VALUE_OUT_OF_BOUNDS
+	}
+	if (incReq.MinValue != nil && value < *incReq.MinValue) ||
+		(incReq.MaxValue != nil && value > *incReq.MaxValue) {
+		return 0, proto.Status_VALUE_OUT_OF_BOUNDS
+	}
+	return value, proto.Status_OK
+}
@@ -0,0 +1,146 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+// Number of records accumulated in a single batch before committing it.
+const mergeBatchSize = 1000
+
+// isShardStateKey checks whether the key holds state that is specific to the
+// shard, rather than a record. These keys are never copied when merging.
+func isShardStateKey(key string) bool {
+	switch key {
+	case commitOffsetKey, commitLastVersionIdKey, termKey, termOptionsKey:
+		return true
+	default:
+		return strings.HasPrefix(key, notificationsPrefix)
+	}
+}
+
+// Merge copies all the records of the source KV into this database. This is
+// used to combine the data of two shards with disjoint hash ranges into a
+// single shard.
+//
+// Along with the records, all the internal keys that describe them, such as
+// the sessions of the ephemeral records and the secondary indexes, are copied
+// as well. The commit offset and the last version id are set to the highest
+// between the two, so that the new entries will not reuse older versions.
+//
+// The ids of the sessions are only unique within a shard, so the sessions of
+// the source that collide with the ones of this database are given new ids.
+// The sessions of the source record the source shard and their original id,
+// for the clients to keep them alive on the merged shard.
+func (d *db) Merge(source KV, sourceShard int64) error {
+	sourceCommitOffset, err := readASCIILong(source, commitOffsetKey)
+	if err != nil {
+		return err
+	}
+	sourceLastVersionId, err := readASCIILong(source, commitLastVersionIdKey)
+	if err != nil {
+		return err
+	}
+	commitOffset, err := d.ReadCommitOffset()
+	if err != nil {
+		return err
+	}
+
+	sessionIds, err := readSessionIds(d.kv)
+	if err != nil {
+		return err
+	}
+	sourceSessionIds, err := readSessionIds(source)
+	if err != nil {
+		return err
+	}
+	sessionsRemap, err := remapSessionIds(sessionIds, sourceSessionIds, max(commitOffset, sourceCommitOffset))
+	if err != nil {
+		return errors.Wrap(err, "oxia db: failed to merge sessions")
+	}
+
+	it, err := source.RangeScan("", "")
+	if err != nil {
+		return err
+	}
+
+	batch := d.kv.NewWriteBatch()
+	var copied int64
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if isShardStateKey(key) {
+			continue
+		}
+
+		value, err := it.Value()
+		if err == nil {
+			value, err = annotateSession(key, value, sourceShard)
+		}
+		if err == nil {
+			key, value, err = remapSessionKey(sessionsRemap, key, value)
+		}
+		if err == nil {
+			err = batch.Put(key, value)
+		}
+		if err != nil {
+			return multierr.Combine(errors.Wrap(err, "oxia db: failed to merge record"), it.Close(), batch.Close())
+		}
+		copied++
+
+		if batch.Count() >= mergeBatchSize {
+			if err = multierr.Combine(batch.Commit(), batch.Close()); err != nil {
+				return multierr.Append(err, it.Close())
+			}
+			batch = d.kv.NewWriteBatch()
+		}
+	}
+
+	if err = it.Close(); err != nil {
+		return multierr.Append(err, batch.Close())
+	}
+
+	lastVersionId := max(d.committedVersionId.Load(), sourceLastVersionId)
+
+	timestamp := now()
+	if err = multierr.Combine(
+		d.addASCIILong(commitOffsetKey, max(commitOffset, sourceCommitOffset), batch, timestamp),
+		d.addASCIILong(commitLastVersionIdKey, lastVersionId, batch, timestamp),
+	); err != nil {
+		return multierr.Append(err, batch.Close())
+	}
+
+	if err = multierr.Combine(batch.Commit(), batch.Close()); err != nil {
+		return err
+	}
+	d.committedVersionId.Store(lastVersionId)
+
+	d.log.Info(
+		"Merged records into the database",
+		slog.Int64("merged-records", copied),
+		slog.Int64("source-shard", sourceShard),
+		slog.Int("remapped-sessions", len(sessionsRemap)),
+		slog.Int64("source-commit-offset", sourceCommitOffset),
+		slog.Int64("commit-offset", max(commitOffset, sourceCommitOffset)),
+	)
+
+	// The merged records are not stored in the WAL, we must force
+	// the database to flush, in order to ensure they are durable
+	return d.kv.Flush()
+}
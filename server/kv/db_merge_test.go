@@ -0,0 +1,185 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/common/compare"
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/time"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func TestDB_Merge(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db1, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+	db2, err := NewDB(constant.DefaultNamespace, 2, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		_, err = db1.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{{
+			Key:   fmt.Sprintf("/a-%d", i),
+			Value: []byte("a"),
+		}}}, int64(i), 0, NoOpCallback)
+		assert.NoError(t, err)
+	}
+
+	for i := 0; i < 20; i++ {
+		_, err = db2.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{{
+			Key:   fmt.Sprintf("/b-%d", i),
+			Value: []byte("b"),
+		}}}, int64(i), 0, NoOpCallback)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, db2.UpdateTerm(5, TermOptions{}))
+
+	assert.NoError(t, db1.Merge(db2.(*db).kv, 2))
+
+	for i := 0; i < 10; i++ {
+		res, err := db1.Get(&proto.GetRequest{Key: fmt.Sprintf("/a-%d", i), IncludeValue: true})
+		assert.NoError(t, err)
+		assert.Equal(t, proto.Status_OK, res.Status)
+		assert.Equal(t, []byte("a"), res.Value)
+	}
+	for i := 0; i < 20; i++ {
+		res, err := db1.Get(&proto.GetRequest{Key: fmt.Sprintf("/b-%d", i), IncludeValue: true})
+		assert.NoError(t, err)
+		assert.Equal(t, proto.Status_OK, res.Status)
+		assert.Equal(t, []byte("b"), res.Value)
+		assert.EqualValues(t, i, res.Version.VersionId)
+	}
+
+	// The commit offset is the highest of the two, while the term is retained
+	commitOffset, err := db1.ReadCommitOffset()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 19, commitOffset)
+	term, _, err := db1.ReadTerm()
+	assert.NoError(t, err)
+	assert.EqualValues(t, -1, term)
+
+	// New versions must not conflict with the merged records
+	res, err := db1.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{{
+		Key:   "/c",
+		Value: []byte("c"),
+	}}}, 20, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 20, res.Puts[0].Version.VersionId)
+
+	assert.NoError(t, db1.Close())
+	assert.NoError(t, db2.Close())
+	assert.NoError(t, factory.Close())
+}
+
+func TestDB_MergeSessions(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db1, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+	db2, err := NewDB(constant.DefaultNamespace, 2, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	sessionKey := func(sessionId int64) string {
+		return fmt.Sprintf("%s/%016x", SessionsPrefix, sessionId)
+	}
+	// The sessions get the offset of the entry that created them
+	writeSession := func(d DB, offset int64) {
+		metadata, err := (&proto.SessionMetadata{TimeoutMs: 5000}).MarshalVT()
+		assert.NoError(t, err)
+		_, err = d.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{{
+			Key: sessionKey(offset), Value: metadata,
+		}}}, offset, 0, NoOpCallback)
+		assert.NoError(t, err)
+	}
+	writeEphemeral := func(d DB, offset int64, sessionId int64, key string) {
+		_, err := d.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{
+			{Key: key, Value: []byte("e"), SessionId: pb.Int64(sessionId)},
+			{Key: sessionKey(sessionId) + "/" + url.PathEscape(key), Value: []byte{}},
+		}}, offset, 0, NoOpCallback)
+		assert.NoError(t, err)
+	}
+
+	writeSession(db1, 1)
+	writeEphemeral(db1, 2, 1, "/a")
+	writeSession(db1, 3)
+	writeEphemeral(db1, 4, 3, "/a-3")
+
+	writeSession(db2, 1)
+	writeEphemeral(db2, 2, 1, "/b")
+	writeSession(db2, 5)
+	writeEphemeral(db2, 6, 5, "/b-5")
+	_, err = db2.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{{
+		Key: "/c", Value: []byte("c"),
+	}}}, 9, 0, NoOpCallback)
+	assert.NoError(t, err)
+
+	assert.NoError(t, db1.Merge(db2.(*db).kv, 2))
+
+	// The session 1 of the second shard was given a free id, below the
+	// commit offset
+	sessionIds, err := readSessionIds(db1.(*db).kv)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]bool{1: true, 3: true, 5: true, 9: true}, sessionIds)
+
+	for key, sessionId := range map[string]int64{"/a": 1, "/a-3": 3, "/b": 9, "/b-5": 5} {
+		res, err := db1.Get(&proto.GetRequest{Key: key})
+		assert.NoError(t, err)
+		assert.Equal(t, proto.Status_OK, res.Status)
+		assert.Equal(t, sessionId, res.Version.GetSessionId(), key)
+
+		res, err = db1.Get(&proto.GetRequest{Key: sessionKey(sessionId) + "/" + url.PathEscape(key)})
+		assert.NoError(t, err)
+		assert.Equal(t, proto.Status_OK, res.Status, key)
+	}
+
+	res, err := db1.Get(&proto.GetRequest{Key: sessionKey(1) + "/" + url.PathEscape("/b")})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, res.Status)
+
+	// The sessions of the second shard record the id they had there
+	for sessionId, sourceSessionId := range map[int64]*int64{1: nil, 3: nil, 5: pb.Int64(5), 9: pb.Int64(1)} {
+		res, err = db1.Get(&proto.GetRequest{Key: sessionKey(sessionId), IncludeValue: true})
+		assert.NoError(t, err)
+		metadata := &proto.SessionMetadata{}
+		assert.NoError(t, metadata.UnmarshalVT(res.Value))
+		assert.EqualValues(t, 5000, metadata.TimeoutMs)
+		assert.Equal(t, sourceSessionId, metadata.SourceSessionId, sessionId)
+		if sourceSessionId != nil {
+			assert.EqualValues(t, 2, metadata.GetSourceShard())
+		} else {
+			assert.Nil(t, metadata.SourceShard)
+		}
+	}
+
+	assert.NoError(t, db1.Close())
+	assert.NoError(t, db2.Close())
+	assert.NoError(t, factory.Close())
+}
+
+func TestDB_RemapSessionIds(t *testing.T) {
+	remap, err := remapSessionIds(map[int64]bool{0: true, 2: true}, map[int64]bool{1: true, 2: true}, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int64{2: 3}, remap)
+
+	_, err = remapSessionIds(map[int64]bool{0: true, 1: true}, map[int64]bool{0: true, 1: true}, 1)
+	assert.ErrorIs(t, err, ErrNoSessionIdAvailable)
+}
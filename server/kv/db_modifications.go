@@ -0,0 +1,195 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/proto"
+)
+
+// valueModifier computes the new value of a record from its current value,
+// which is empty if the record does not exist.
+type valueModifier func(current []byte) ([]byte, proto.Status)
+
+func appendModifier(appReq *proto.AppendRequest) valueModifier {
+	return func(current []byte) ([]byte, proto.Status) {
+		value := make([]byte, 0, len(current)+len(appReq.Value))
+		value = append(value, current...)
+		return append(value, appReq.Value...), proto.Status_OK
+	}
+}
+
+func mergePatchModifier(mergeReq *proto.MergePatchRequest) valueModifier {
+	return func(current []byte) ([]byte, proto.Status) {
+		patch, err := decodeJSON(mergeReq.Patch)
+		if err != nil {
+			return nil, proto.Status_INVALID_JSON
+		}
+
+		var target any
+		if len(current) > 0 {
+			if target, err = decodeJSON(current); err != nil {
+				return nil, proto.Status_INVALID_JSON
+			}
+		}
+
+		value, err := json.Marshal(mergePatch(target, patch))
+		if err != nil {
+			return nil, proto.Status_INVALID_JSON
+		}
+		return value, proto.Status_OK
+	}
+}
+
+func (d *db) applyAppend(batch WriteBatch, baseVersionId *atomic.Int64, notifications *Notifications,
+	appReq *proto.AppendRequest, timestamp uint64,
+	updateOperationCallback UpdateOperationCallback) (*proto.AppendResponse, error) {
+	status, version, err := d.applyModification(batch, baseVersionId, notifications, appReq.Key, appReq.PartitionKey,
+		appendModifier(appReq), timestamp, updateOperationCallback)
+	if err != nil {
+		return nil, err
+	}
+
+	d.log.Debug(
+		"Applied append operation",
+		slog.String("key", appReq.Key),
+		slog.Any("status", status),
+	)
+	return &proto.AppendResponse{Status: status, Version: version}, nil
+}
+
+func (d *db) applyMergePatch(batch WriteBatch, baseVersionId *atomic.Int64, notifications *Notifications,
+	mergeReq *proto.MergePatchRequest, timestamp uint64,
+	updateOperationCallback UpdateOperationCallback) (*proto.MergePatchResponse, error) {
+	status, version, err := d.applyModification(batch, baseVersionId, notifications, mergeReq.Key, mergeReq.PartitionKey,
+		mergePatchModifier(mergeReq), timestamp, updateOperationCallback)
+	if err != nil {
+		return nil, err
+	}
+
+	d.log.Debug(
+		"Applied merge patch operation",
+		slog.String("key", mergeReq.Key),
+		slog.Any("status", status),
+	)
+	return &proto.MergePatchResponse{Status: status, Version: version}, nil
+}
+
+// applyModification replaces the value of a record with the one computed by
+// the modifier. The record keeps its secondary indexes, its session and its
+// expiration, as if they were passed again to a put.
+func (d *db) applyModification(batch WriteBatch, baseVersionId *atomic.Int64, notifications *Notifications,
+	key string, partitionKey *string, modify valueModifier, timestamp uint64,
+	updateOperationCallback UpdateOperationCallback) (proto.Status, *proto.Version, error) {
+	putReq, status, err := modifiedPutRequest(batch, key, partitionKey, modify, timestamp)
+	if err != nil || status != proto.Status_OK {
+		return status, nil, err
+	}
+
+	pr, err := d.applyPut(batch, baseVersionId, notifications, putReq, timestamp, updateOperationCallback, false)
+	if err != nil {
+		return proto.Status_OK, nil, err
+	}
+	return pr.Status, pr.Version, nil
+}
+
+// modifiedPutRequest returns the put request that replaces the record stored
+// with the key with its modified value.
+func modifiedPutRequest(batch WriteBatch, key string, partitionKey *string, modify valueModifier,
+	timestamp uint64) (*proto.PutRequest, proto.Status, error) {
+	putReq := &proto.PutRequest{
+		Key:          key,
+		PartitionKey: partitionKey,
+	}
+
+	se, err := GetStorageEntry(batch, key)
+	switch {
+	case errors.Is(err, ErrKeyNotFound):
+		value, status := modify(nil)
+		if status != proto.Status_OK {
+			return nil, status, nil
+		}
+		putReq.Value = value
+		return putReq, proto.Status_OK, nil
+	case err != nil:
+		return nil, proto.Status_OK, errors.Wrap(err, "oxia db: failed to read record")
+	}
+	defer se.ReturnToVTPool()
+
+	value, status := modify(se.Value)
+	if status != proto.Status_OK {
+		return nil, status, nil
+	}
+
+	putReq.Value = value
+	putReq.SessionId = se.SessionId
+	putReq.ClientIdentity = se.ClientIdentity
+	for _, si := range se.SecondaryIndexes {
+		putReq.SecondaryIndexes = append(putReq.SecondaryIndexes, si.CloneVT())
+	}
+	if se.ExpirationTimestamp != nil {
+		var ttl uint64
+		if *se.ExpirationTimestamp > timestamp {
+			ttl = *se.ExpirationTimestamp - timestamp
+		}
+		putReq.TtlMs = &ttl
+	}
+	return putReq, proto.Status_OK, nil
+}
+
+// decodeJSON parses a single JSON document, keeping the numbers as they are
+// written.
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the JSON document")
+	}
+	return value, nil
+}
+
+// mergePatch applies a JSON merge patch to the target document, as defined by
+// RFC 7386.
+func mergePatch(target any, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+		} else {
+			targetObject[name] = mergePatch(targetObject[name], value)
+		}
+	}
+	return targetObject
+}
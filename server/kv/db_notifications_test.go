@@ -233,6 +233,74 @@ func TestDB_NotificationsDisabled(t *testing.T) {
 	assert.NoError(t, factory.Close())
 }
 
+func TestDB_NotificationsValues(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderNatural, 1*time.Hour, time2.SystemClock)
+	assert.NoError(t, err)
+
+	db.CaptureNotificationValues(true, true)
+	_, _ = db.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{Key: "a", Value: []byte("0")}},
+	}, 0, now(), NoOpCallback)
+	_, _ = db.ProcessWrite(&proto.WriteRequest{
+		Puts:    []*proto.PutRequest{{Key: "a", Value: []byte("1")}},
+		Appends: []*proto.AppendRequest{{Key: "b", Value: []byte("x")}},
+	}, 1, now(), NoOpCallback)
+	_, _ = db.ProcessWrite(&proto.WriteRequest{
+		Deletes: []*proto.DeleteRequest{{Key: "a"}},
+		Appends: []*proto.AppendRequest{{Key: "b", Value: []byte("y")}},
+	}, 2, now(), NoOpCallback)
+
+	db.CaptureNotificationValues(true, false)
+	_, _ = db.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{Key: "b", Value: []byte("z")}},
+	}, 3, now(), NoOpCallback)
+
+	db.CaptureNotificationValues(false, false)
+	_, _ = db.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{Key: "b", Value: []byte("w")}},
+	}, 4, now(), NoOpCallback)
+
+	notifications, err := db.ReadNextNotifications(context.Background(), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(notifications))
+
+	n := notifications[0].Notifications["a"]
+	assert.Equal(t, proto.NotificationType_KEY_CREATED, n.Type)
+	assert.Equal(t, []byte("0"), n.Value)
+	assert.Nil(t, n.PreviousValue)
+
+	n = notifications[1].Notifications["a"]
+	assert.Equal(t, proto.NotificationType_KEY_MODIFIED, n.Type)
+	assert.Equal(t, []byte("1"), n.Value)
+	assert.Equal(t, []byte("0"), n.PreviousValue)
+	n = notifications[1].Notifications["b"]
+	assert.Equal(t, proto.NotificationType_KEY_CREATED, n.Type)
+	assert.Equal(t, []byte("x"), n.Value)
+	assert.Nil(t, n.PreviousValue)
+
+	n = notifications[2].Notifications["a"]
+	assert.Equal(t, proto.NotificationType_KEY_DELETED, n.Type)
+	assert.Nil(t, n.Value)
+	assert.Equal(t, []byte("1"), n.PreviousValue)
+	n = notifications[2].Notifications["b"]
+	assert.Equal(t, proto.NotificationType_KEY_MODIFIED, n.Type)
+	assert.Equal(t, []byte("xy"), n.Value)
+	assert.Equal(t, []byte("x"), n.PreviousValue)
+
+	n = notifications[3].Notifications["b"]
+	assert.Equal(t, []byte("z"), n.Value)
+	assert.Nil(t, n.PreviousValue)
+
+	n = notifications[4].Notifications["b"]
+	assert.Nil(t, n.Value)
+	assert.Nil(t, n.PreviousValue)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
+
 func TestDB_NotificationsDeleteRange(t *testing.T) {
 	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
 	assert.NoError(t, err)
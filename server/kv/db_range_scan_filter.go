@@ -0,0 +1,153 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"bytes"
+	"path"
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/proto"
+)
+
+// RangeScanFilter is the compiled form of the filter and the projection of a
+// range-scan request.
+type RangeScanFilter struct {
+	*proto.RangeScanFilter
+	keyRegex     *regexp.Regexp
+	metadataOnly bool
+}
+
+// NewRangeScanFilter compiles the filter of a range-scan request. It returns
+// nil if all the records must be returned unchanged.
+func NewRangeScanFilter(request *proto.RangeScanRequest) (*RangeScanFilter, error) {
+	if request.Filter == nil && !request.MetadataOnly {
+		return nil, nil //nolint:nilnil
+	}
+
+	f := &RangeScanFilter{
+		RangeScanFilter: request.Filter,
+		metadataOnly:    request.MetadataOnly,
+	}
+	if f.RangeScanFilter == nil {
+		f.RangeScanFilter = &proto.RangeScanFilter{}
+	}
+	if f.KeyRegex != nil {
+		var err error
+		if f.keyRegex, err = regexp.Compile(*f.KeyRegex); err != nil {
+			return nil, errors.Wrap(err, "invalid key regex")
+		}
+	}
+	if f.KeyGlob != nil {
+		if _, err := path.Match(*f.KeyGlob, ""); err != nil {
+			return nil, errors.Wrap(err, "invalid key glob")
+		}
+	}
+	return f, nil
+}
+
+// Apply returns an iterator that skips the records that do not match the
+// filter.
+func (f *RangeScanFilter) Apply(it RangeScanIterator) RangeScanIterator {
+	fit := &filteredRangeScanIterator{
+		RangeScanIterator: it,
+		filter:            f,
+	}
+	fit.seek()
+	return fit
+}
+
+func (f *RangeScanFilter) matches(gr *proto.GetResponse) bool {
+	key := gr.GetKey()
+	if f.keyRegex != nil && !f.keyRegex.MatchString(key) {
+		return false
+	}
+	if f.KeyGlob != nil {
+		// The pattern was already validated
+		if matched, _ := path.Match(*f.KeyGlob, key); !matched {
+			return false
+		}
+	}
+	if f.ValuePrefix != nil && !bytes.HasPrefix(gr.Value, f.ValuePrefix) {
+		return false
+	}
+
+	version := gr.GetVersion()
+	if version == nil {
+		return false
+	}
+	if f.MinVersionId != nil && version.VersionId < *f.MinVersionId {
+		return false
+	}
+	if f.MaxVersionId != nil && version.VersionId > *f.MaxVersionId {
+		return false
+	}
+	if f.MinModifiedTimestamp != nil && version.ModifiedTimestamp < *f.MinModifiedTimestamp {
+		return false
+	}
+	if f.MaxModifiedTimestamp != nil && version.ModifiedTimestamp > *f.MaxModifiedTimestamp {
+		return false
+	}
+	return !f.EphemeralOnly || version.SessionId != nil
+}
+
+// filteredRangeScanIterator skips the records that do not match the filter of
+// a range-scan request, and strips the values of the records if only the
+// metadata was requested.
+type filteredRangeScanIterator struct {
+	RangeScanIterator
+	filter *RangeScanFilter
+
+	current *proto.GetResponse
+	err     error
+}
+
+// seek moves the iterator to the first record, from the current position,
+// that matches the filter.
+func (it *filteredRangeScanIterator) seek() {
+	for ; it.RangeScanIterator.Valid(); it.RangeScanIterator.Next() {
+		gr, err := it.RangeScanIterator.Value()
+		if err != nil {
+			it.err = err
+			return
+		}
+
+		if it.filter.matches(gr) {
+			if it.filter.metadataOnly {
+				gr.Value = nil
+			}
+			it.current = gr
+			return
+		}
+	}
+}
+
+func (it *filteredRangeScanIterator) Valid() bool {
+	return it.err != nil || it.RangeScanIterator.Valid()
+}
+
+func (it *filteredRangeScanIterator) Value() (*proto.GetResponse, error) {
+	return it.current, it.err
+}
+
+func (it *filteredRangeScanIterator) Next() bool {
+	it.current, it.err = nil, nil
+	if it.RangeScanIterator.Next() {
+		it.seek()
+	}
+	return it.Valid()
+}
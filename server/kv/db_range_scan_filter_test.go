@@ -0,0 +1,109 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/common/compare"
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/time"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func TestDB_RangeScanFilter(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	_, err = db.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{
+		{Key: "/a/1", Value: []byte("x-1")},
+		{Key: "/a/2", Value: []byte("y-2")},
+		{Key: "/b/1", Value: []byte("x-3"), SessionId: pb.Int64(5)},
+	}}, 0, 1000, NoOpCallback)
+	assert.NoError(t, err)
+	_, err = db.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{
+		{Key: "/a/2", Value: []byte("x-4")},
+		{Key: "/c", Value: []byte("y-5")},
+	}}, 1, 2000, NoOpCallback)
+	assert.NoError(t, err)
+
+	for _, test := range []struct {
+		name         string
+		filter       *proto.RangeScanFilter
+		expectedKeys []string
+	}{
+		{"none", &proto.RangeScanFilter{}, []string{"/c", "/a/1", "/a/2", "/b/1"}},
+		{"key-regex", &proto.RangeScanFilter{KeyRegex: pb.String("/1$")}, []string{"/a/1", "/b/1"}},
+		{"key-glob", &proto.RangeScanFilter{KeyGlob: pb.String("/a/*")}, []string{"/a/1", "/a/2"}},
+		{"key-glob-separator", &proto.RangeScanFilter{KeyGlob: pb.String("/*")}, []string{"/c"}},
+		{"value-prefix", &proto.RangeScanFilter{ValuePrefix: []byte("x-")}, []string{"/a/1", "/a/2", "/b/1"}},
+		{"version-id", &proto.RangeScanFilter{MinVersionId: pb.Int64(2), MaxVersionId: pb.Int64(3)},
+			[]string{"/a/2", "/b/1"}},
+		{"modified-timestamp", &proto.RangeScanFilter{MinModifiedTimestamp: pb.Uint64(1500)}, []string{"/c", "/a/2"}},
+		{"ephemeral", &proto.RangeScanFilter{EphemeralOnly: true}, []string{"/b/1"}},
+		{"combined", &proto.RangeScanFilter{KeyGlob: pb.String("/a/*"), MaxModifiedTimestamp: pb.Uint64(1000)},
+			[]string{"/a/1"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			request := &proto.RangeScanRequest{
+				StartInclusive: "/",
+				EndExclusive:   "///",
+				Filter:         test.filter,
+			}
+			filter, err := NewRangeScanFilter(request)
+			assert.NoError(t, err)
+
+			it, err := db.RangeScan(request)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedKeys, rangeScanIteratorToSlice(filter.Apply(it), nil))
+		})
+	}
+
+	// Metadata only
+	request := &proto.RangeScanRequest{
+		StartInclusive: "/a/",
+		EndExclusive:   "/a//",
+		MetadataOnly:   true,
+	}
+	filter, err := NewRangeScanFilter(request)
+	assert.NoError(t, err)
+	it, err := db.RangeScan(request)
+	assert.NoError(t, err)
+	it = filter.Apply(it)
+	for ; it.Valid(); it.Next() {
+		gr, err := it.Value()
+		assert.NoError(t, err)
+		assert.Nil(t, gr.Value)
+		assert.NotNil(t, gr.Version)
+	}
+	assert.NoError(t, it.Close())
+
+	filter, err = NewRangeScanFilter(&proto.RangeScanRequest{})
+	assert.NoError(t, err)
+	assert.Nil(t, filter)
+
+	_, err = NewRangeScanFilter(&proto.RangeScanRequest{Filter: &proto.RangeScanFilter{KeyRegex: pb.String("(")}})
+	assert.Error(t, err)
+	_, err = NewRangeScanFilter(&proto.RangeScanRequest{Filter: &proto.RangeScanFilter{KeyGlob: pb.String("[")}})
+	assert.Error(t, err)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
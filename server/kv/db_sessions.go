@@ -0,0 +1,199 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/proto"
+)
+
+// The sessions are managed by the server, with a key for each session and a
+// shadow key for each of its ephemeral records, under the session key:
+//
+//	__oxia/session/<session-id>
+//	__oxia/session/<session-id>/<record-key>
+//
+// The ids of the sessions are the offsets of the entries that created them.
+const SessionsPrefix = constant.InternalKeyPrefix + "session"
+
+const sessionIdLength = 16
+
+var ErrNoSessionIdAvailable = errors.New("oxia db: no session id available")
+
+// sessionIdFromKey returns the id of the session that the session key or the
+// shadow key refers to.
+func sessionIdFromKey(key string) (id int64, ok bool) {
+	if !strings.HasPrefix(key, SessionsPrefix+"/") {
+		return 0, false
+	}
+	key = key[len(SessionsPrefix)+1:]
+	if len(key) < sessionIdLength || (len(key) > sessionIdLength && key[sessionIdLength] != '/') {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(key[:sessionIdLength], 16, 64)
+	return id, err == nil
+}
+
+// replaceSessionId rewrites the session key or the shadow key with a
+// different session id.
+func replaceSessionId(key string, id int64) string {
+	return fmt.Sprintf("%s/%016x%s", SessionsPrefix, id, key[len(SessionsPrefix)+1+sessionIdLength:])
+}
+
+// readSessionIds returns the ids of all the sessions stored in the KV.
+func readSessionIds(kv KV) (map[int64]bool, error) {
+	it, err := kv.KeyRangeScan(SessionsPrefix+"/", SessionsPrefix+"//")
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[int64]bool{}
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		// Skip the shadow keys
+		if len(key) != len(SessionsPrefix)+1+sessionIdLength {
+			continue
+		}
+		if id, ok := sessionIdFromKey(key); ok {
+			ids[id] = true
+		}
+	}
+	return ids, it.Close()
+}
+
+// remapSessionIds assigns new ids to the sessions of the source that
+// collide with the sessions of the target.
+//
+// The new ids are taken among the offsets up to the commit offset that are not
+// used by any session, so that they will not collide with the sessions created
+// later, since these get the offset of a new entry. The ids are assigned in
+// order, for all the replicas of the shard to get the same ones.
+func remapSessionIds(targetIds map[int64]bool, sourceIds map[int64]bool, commitOffset int64) (map[int64]int64, error) {
+	remap := map[int64]int64{}
+	nextId := commitOffset
+	for _, id := range slices.Sorted(maps.Keys(sourceIds)) {
+		if !targetIds[id] {
+			continue
+		}
+		for nextId >= 0 && (targetIds[nextId] || sourceIds[nextId]) {
+			nextId--
+		}
+		if nextId < 0 {
+			return nil, ErrNoSessionIdAvailable
+		}
+		remap[id] = nextId
+		nextId--
+	}
+	return remap, nil
+}
+
+// remapSessionKey returns the key and the value to store for a key copied
+// from another shard, whose sessions were given new ids.
+func remapSessionKey(remap map[int64]int64, key string, value []byte) (string, []byte, error) {
+	if len(remap) == 0 {
+		return key, value, nil
+	}
+
+	if id, ok := sessionIdFromKey(key); ok {
+		if newId, found := remap[id]; found {
+			return replaceSessionId(key, newId), value, nil
+		}
+		return key, value, nil
+	}
+
+	// The ephemeral records refer to their session, as well as the ephemeral
+	// records staged by the prepared transactions
+	intent := strings.HasPrefix(key, TransactionIntentsPrefix+"/")
+	if !intent && strings.HasPrefix(key, constant.InternalKeyPrefix) {
+		return key, value, nil
+	}
+
+	se := proto.StorageEntryFromVTPool()
+	defer se.ReturnToVTPool()
+	if err := Deserialize(value, se); err != nil {
+		return "", nil, err
+	}
+
+	remapped := false
+	remapId := func(sessionId *int64) *int64 {
+		if sessionId == nil {
+			return nil
+		}
+		if newId, found := remap[*sessionId]; found {
+			remapped = true
+			return &newId
+		}
+		return sessionId
+	}
+
+	if intent {
+		txnIntent := &proto.TransactionIntent{}
+		if err := txnIntent.UnmarshalVT(se.Value); err != nil {
+			return "", nil, errors.Wrap(err, "oxia db: failed to deserialize transaction intent")
+		}
+		for _, putReq := range txnIntent.Puts {
+			putReq.SessionId = remapId(putReq.SessionId)
+		}
+		if !remapped {
+			return key, value, nil
+		}
+		var err error
+		if se.Value, err = txnIntent.MarshalVT(); err != nil {
+			return "", nil, err
+		}
+	} else if se.SessionId = remapId(se.SessionId); !remapped {
+		return key, value, nil
+	}
+
+	value, err := se.MarshalVT()
+	return key, value, err
+}
+
+// annotateSession records, in the metadata of a session copied from another
+// shard, the shard and the id that the clients know the session with. This
+// lets the clients keep alive the session after its id was remapped.
+func annotateSession(key string, value []byte, sourceShard int64) ([]byte, error) {
+	id, ok := sessionIdFromKey(key)
+	if !ok || len(key) != len(SessionsPrefix)+1+sessionIdLength {
+		return value, nil
+	}
+
+	se := proto.StorageEntryFromVTPool()
+	defer se.ReturnToVTPool()
+	if err := Deserialize(value, se); err != nil {
+		return nil, err
+	}
+
+	metadata := &proto.SessionMetadata{}
+	if err := metadata.UnmarshalVT(se.Value); err != nil {
+		return nil, errors.Wrap(err, "oxia db: failed to deserialize session metadata")
+	}
+	metadata.SourceShard = &sourceShard
+	metadata.SourceSessionId = &id
+
+	var err error
+	if se.Value, err = metadata.MarshalVT(); err != nil {
+		return nil, err
+	}
+	return se.MarshalVT()
+}
@@ -0,0 +1,215 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/hash"
+	"github.com/oxia-db/oxia/proto"
+)
+
+// Number of deletions accumulated in a single batch before committing it.
+const deleteOutsideHashRangeBatchSize = 1000
+
+// DeleteOutsideHashRange removes all the records whose partition key (or key, when
+// there is no partition key) hashes outside the [minInclusive, maxInclusive] range.
+// This is used to trim the data of a shard that was created by splitting a
+// larger shard.
+//
+// The internal keys that describe the deleted records are removed along with
+// them: the session shadow keys and the secondary indexes, through the update
+// operation callback, and the expiration index. The operations of the prepared
+// transactions are trimmed in the same way, releasing their locks.
+//
+// The sessions are retained in all the new shards, each with the ephemeral
+// records in its hash range. The session ids are unchanged, so the clients
+// keep the sessions alive on the new shards, and a session that is not kept
+// alive expires there, as for any other client that went away.
+func (d *db) DeleteOutsideHashRange(minInclusive uint32, maxInclusive uint32, updateOperationCallback UpdateOperationCallback) error {
+	// The transactions are trimmed first, since their deletes are routed by
+	// the partition key of the records
+	if err := d.trimTransactionIntents(minInclusive, maxInclusive); err != nil {
+		return err
+	}
+
+	it, err := d.kv.RangeScan("", "")
+	if err != nil {
+		return err
+	}
+
+	batch := d.kv.NewWriteBatch()
+	var deleted int64
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if strings.HasPrefix(key, constant.InternalKeyPrefix) {
+			continue
+		}
+
+		value, err := it.Value()
+		if err != nil {
+			return multierr.Combine(err, it.Close(), batch.Close())
+		}
+
+		se := proto.StorageEntryFromVTPool()
+		if err = Deserialize(value, se); err != nil {
+			se.ReturnToVTPool()
+			return multierr.Combine(err, it.Close(), batch.Close())
+		}
+
+		routingKey := key
+		if se.PartitionKey != nil {
+			routingKey = *se.PartitionKey
+		}
+
+		if h := hash.Xxh332(routingKey); h < minInclusive || h > maxInclusive {
+			if err = updateOperationCallback.OnDeleteWithEntry(batch, nil, key, se); err == nil {
+				err = multierr.Combine(DeleteExpirationIndex(batch, key, se), batch.Delete(key))
+			}
+			if err != nil {
+				se.ReturnToVTPool()
+				return multierr.Combine(errors.Wrap(err, "oxia db: failed to delete record outside hash range"),
+					it.Close(), batch.Close())
+			}
+			deleted++
+		}
+		se.ReturnToVTPool()
+
+		if batch.Count() >= deleteOutsideHashRangeBatchSize {
+			if err = multierr.Combine(batch.Commit(), batch.Close()); err != nil {
+				return multierr.Append(err, it.Close())
+			}
+			batch = d.kv.NewWriteBatch()
+		}
+	}
+
+	if err = multierr.Combine(batch.Commit(), batch.Close(), it.Close()); err != nil {
+		return err
+	}
+
+	d.log.Info(
+		"Deleted records outside of hash range",
+		slog.Any("min-inclusive", minInclusive),
+		slog.Any("max-inclusive", maxInclusive),
+		slog.Int64("deleted-records", deleted),
+	)
+	return d.kv.Flush()
+}
+
+// trimTransactionIntents removes from the prepared transactions all the
+// operations outside the hash range. The intents are retained even when no
+// operation is left, so that the transaction can still be committed.
+func (d *db) trimTransactionIntents(minInclusive uint32, maxInclusive uint32) error {
+	inRange := func(routingKey string) bool {
+		h := hash.Xxh332(routingKey)
+		return h >= minInclusive && h <= maxInclusive
+	}
+
+	it, err := d.kv.RangeScan(TransactionIntentsPrefix+"/", TransactionIntentsPrefix+"//")
+	if err != nil {
+		return err
+	}
+
+	batch := d.kv.NewWriteBatch()
+	timestamp := now()
+	var trimmed int64
+	for ; it.Valid(); it.Next() {
+		transactionId, err := TransactionIdFromKey(it.Key())
+		if err != nil {
+			return multierr.Combine(err, it.Close(), batch.Close())
+		}
+		intent, err := readTransactionIntent(batch, transactionId)
+		if err != nil {
+			return multierr.Combine(err, it.Close(), batch.Close())
+		}
+
+		var locksToRelease []string
+		puts := intent.Puts[:0]
+		for _, putReq := range intent.Puts {
+			putRoutingKey := putReq.Key
+			if putReq.PartitionKey != nil {
+				putRoutingKey = *putReq.PartitionKey
+			}
+			if inRange(putRoutingKey) {
+				puts = append(puts, putReq)
+			} else {
+				locksToRelease = append(locksToRelease, putReq.Key)
+			}
+		}
+		deletes := intent.Deletes[:0]
+		for _, delReq := range intent.Deletes {
+			deleteRoutingKey, err := routingKey(batch, delReq.Key)
+			if err != nil {
+				return multierr.Combine(err, it.Close(), batch.Close())
+			}
+			if inRange(deleteRoutingKey) {
+				deletes = append(deletes, delReq)
+			} else {
+				locksToRelease = append(locksToRelease, delReq.Key)
+			}
+		}
+
+		if len(locksToRelease) == 0 {
+			continue
+		}
+		intent.Puts = puts
+		intent.Deletes = deletes
+		value, err := intent.MarshalVT()
+		if err == nil {
+			err = d.putInternal(batch, TransactionIntentKey(transactionId), value, timestamp)
+		}
+		for _, key := range locksToRelease {
+			err = multierr.Append(err, batch.Delete(transactionLockKey(key)))
+		}
+		if err != nil {
+			return multierr.Combine(errors.Wrap(err, "oxia db: failed to trim transaction outside hash range"),
+				it.Close(), batch.Close())
+		}
+		trimmed++
+	}
+
+	if err = multierr.Combine(batch.Commit(), batch.Close(), it.Close()); err != nil {
+		return err
+	}
+	if trimmed > 0 {
+		d.log.Info(
+			"Trimmed transactions outside of hash range",
+			slog.Int64("trimmed-transactions", trimmed),
+		)
+	}
+	return nil
+}
+
+// routingKey returns the key that determines the shard of an existing record,
+// which is its partition key, if it has one.
+func routingKey(batch WriteBatch, key string) (string, error) {
+	se, err := GetStorageEntry(batch, key)
+	if errors.Is(err, ErrKeyNotFound) {
+		return key, nil
+	} else if err != nil {
+		return "", err
+	}
+	defer se.ReturnToVTPool()
+
+	if se.PartitionKey != nil {
+		return *se.PartitionKey, nil
+	}
+	return key, nil
+}
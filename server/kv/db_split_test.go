@@ -0,0 +1,163 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/common/compare"
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/hash"
+	"github.com/oxia-db/oxia/common/time"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func TestDB_DeleteOutsideHashRange(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	req := &proto.WriteRequest{}
+	for i := 0; i < 100; i++ {
+		req.Puts = append(req.Puts, &proto.PutRequest{
+			Key:   fmt.Sprintf("/key-%d", i),
+			Value: []byte("value"),
+		})
+	}
+	// All the records with the same partition key must be retained together
+	for i := 0; i < 10; i++ {
+		req.Puts = append(req.Puts, &proto.PutRequest{
+			Key:          fmt.Sprintf("/partitioned-%d", i),
+			Value:        []byte("value"),
+			PartitionKey: pb.String("my-partition"),
+		})
+	}
+
+	_, err = db.ProcessWrite(req, 0, 0, NoOpCallback)
+	assert.NoError(t, err)
+
+	const middle = math.MaxUint32 / 2
+	assert.NoError(t, db.DeleteOutsideHashRange(0, middle, NoOpCallback))
+
+	partitionInRange := hash.Xxh332("my-partition") <= middle
+	for _, put := range req.Puts {
+		routingKey := put.Key
+		if put.PartitionKey != nil {
+			routingKey = *put.PartitionKey
+		}
+
+		res, err := db.Get(&proto.GetRequest{Key: put.Key, IncludeValue: true})
+		assert.NoError(t, err)
+		if hash.Xxh332(routingKey) <= middle {
+			assert.Equal(t, proto.Status_OK, res.Status, "key %s", put.Key)
+		} else {
+			assert.Equal(t, proto.Status_KEY_NOT_FOUND, res.Status, "key %s", put.Key)
+		}
+
+		if put.PartitionKey != nil {
+			assert.Equal(t, partitionInRange, res.Status == proto.Status_OK)
+		}
+	}
+
+	// Internal keys must not be affected
+	commitOffset, err := db.ReadCommitOffset()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, commitOffset)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
+
+func TestDB_DeleteOutsideHashRangeInternalKeys(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	d, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	const middle = math.MaxUint32 / 2
+	keyInRange := func(inRange bool, prefix string) string {
+		for i := 0; ; i++ {
+			key := fmt.Sprintf("%s-%d", prefix, i)
+			if (hash.Xxh332(key) <= middle) == inRange {
+				return key
+			}
+		}
+	}
+	in, out := keyInRange(true, "/in"), keyInRange(false, "/out")
+	outPartition := keyInRange(false, "partition")
+
+	// The record in range is deleted by the transaction, but it is routed
+	// by a partition key outside the range
+	_, err = d.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{
+		{Key: in, Value: []byte("0"), TtlMs: pb.Uint64(60_000)},
+		{Key: out, Value: []byte("0"), TtlMs: pb.Uint64(60_000)},
+		{Key: "/partitioned", Value: []byte("0"), PartitionKey: pb.String(outPartition)},
+	}}, 0, 0, NoOpCallback)
+	assert.NoError(t, err)
+
+	prepare := transactionRequest("txn-1", proto.TransactionAction_PREPARE)
+	prepare.Puts = []*proto.PutRequest{
+		{Key: in + "-txn", Value: []byte("1"), PartitionKey: pb.String(in)},
+		{Key: out + "-txn", Value: []byte("1"), PartitionKey: pb.String(out)},
+	}
+	prepare.Deletes = []*proto.DeleteRequest{{Key: in}, {Key: "/partitioned"}}
+	_, err = d.ProcessWrite(prepare, 1, 0, NoOpCallback)
+	assert.NoError(t, err)
+
+	assert.NoError(t, d.DeleteOutsideHashRange(0, middle, NoOpCallback))
+
+	batch := d.(*db).kv.NewWriteBatch()
+	intent, err := readTransactionIntent(batch, "txn-1")
+	assert.NoError(t, err)
+	assert.Len(t, intent.Puts, 1)
+	assert.Equal(t, in+"-txn", intent.Puts[0].Key)
+	assert.Len(t, intent.Deletes, 1)
+	assert.Equal(t, in, intent.Deletes[0].Key)
+
+	for key, locked := range map[string]bool{in + "-txn": true, in: true, out + "-txn": false, "/partitioned": false} {
+		isLocked, err := isKeyLocked(batch, key)
+		assert.NoError(t, err)
+		assert.Equal(t, locked, isLocked, key)
+	}
+	assert.NoError(t, batch.Close())
+
+	// The expiration index only refers to the records in range
+	it, err := d.(*db).kv.KeyRangeScan(expirationIndexPrefix+"/", expirationIndexPrefix+"//")
+	assert.NoError(t, err)
+	var expirationKeys []string
+	for ; it.Valid(); it.Next() {
+		expirationKeys = append(expirationKeys, it.Key())
+	}
+	assert.NoError(t, it.Close())
+	assert.Len(t, expirationKeys, 1)
+	assert.Contains(t, expirationKeys[0], url.PathEscape(in))
+
+	// The trimmed transaction can still be committed
+	res, err := d.ProcessWrite(transactionRequest("txn-1", proto.TransactionAction_COMMIT), 2, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_COMMITTED, res.GetTransactionStatus())
+	assert.Len(t, res.Puts, 1)
+	assert.Len(t, res.Deletes, 1)
+
+	assert.NoError(t, d.Close())
+	assert.NoError(t, factory.Close())
+}
@@ -15,7 +15,10 @@
 package kv
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"math"
+	"strconv"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -873,6 +876,54 @@ func TestDBRangeScan(t *testing.T) {
 	assert.NoError(t, factory.Close())
 }
 
+func TestDBReverseScan(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	writeReq := &proto.WriteRequest{}
+	for _, key := range []string{"/a", "/b", "/c", "/d", "/e", "/a/b"} {
+		writeReq.Puts = append(writeReq.Puts, &proto.PutRequest{Key: key, Value: []byte(key)})
+	}
+	_, err = db.ProcessWrite(writeReq, wal.InvalidOffset, now(), NoOpCallback)
+	assert.NoError(t, err)
+
+	keys := keyIteratorToSlice(db.List(&proto.ListRequest{
+		StartInclusive: "/b",
+		EndExclusive:   "/e",
+		Reverse:        true,
+	}))
+	assert.Equal(t, []string{"/d", "/c", "/b"}, keys)
+
+	keys = keyIteratorToSlice(db.List(&proto.ListRequest{
+		StartInclusive: "/",
+		EndExclusive:   "/e",
+		Reverse:        true,
+		StartAfterKey:  pb.String("/c"),
+	}))
+	assert.Equal(t, []string{"/b", "/a"}, keys)
+
+	// The start key is after the end of the range
+	keys = keyIteratorToSlice(db.List(&proto.ListRequest{
+		StartInclusive: "/",
+		EndExclusive:   "/c",
+		Reverse:        true,
+		StartAfterKey:  pb.String("/z"),
+	}))
+	assert.Equal(t, []string{"/b", "/a"}, keys)
+
+	keys = rangeScanIteratorToSlice(db.RangeScan(&proto.RangeScanRequest{
+		StartInclusive: "/",
+		EndExclusive:   "///",
+		Reverse:        true,
+	}))
+	assert.Equal(t, []string{"/a/b", "/e", "/d", "/c", "/b", "/a"}, keys)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
+
 func TestDb_versionId(t *testing.T) {
 	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
 	assert.NoError(t, err)
@@ -1064,3 +1115,341 @@ func TestDB_SequentialKeysNotification(t *testing.T) {
 	assert.NoError(t, db.Close())
 	assert.NoError(t, factory.Close())
 }
+
+func TestDB_SequentialKeysNotificationAtomic(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderNatural, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	sw, err := db.GetSequenceUpdates("a")
+	assert.NoError(t, err)
+
+	request := func(expectedVersionId int64) *proto.WriteRequest {
+		return &proto.WriteRequest{
+			Atomic: true,
+			Puts: []*proto.PutRequest{{
+				Key:              "a",
+				Value:            []byte("0"),
+				SequenceKeyDelta: []uint64{1},
+				PartitionKey:     pb.String("x"),
+			}, {
+				Key:               "b",
+				Value:             []byte("0"),
+				ExpectedVersionId: pb.Int64(expectedVersionId),
+				PartitionKey:      pb.String("x"),
+			}},
+		}
+	}
+
+	// The sequence keys of a discarded batch are not notified
+	res, err := db.ProcessWrite(request(5), 0, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_BATCH_ABORTED, res.Puts[0].Status)
+	assert.Empty(t, sw.Ch())
+
+	res, err = db.ProcessWrite(request(-1), 1, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Puts[0].Status)
+
+	n1 := <-sw.Ch()
+	assert.Equal(t, fmt.Sprintf("a-%020d", 1), n1)
+
+	assert.NoError(t, sw.Close())
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
+
+func TestDB_ExpectedValue(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	hash := sha256.Sum256([]byte("0"))
+	otherHash := sha256.Sum256([]byte("1"))
+
+	res, err := db.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{
+		{Key: "/a", Value: []byte("0"), ExpectedRecordExists: pb.Bool(true)},
+		{Key: "/a", Value: []byte("0"), ExpectedValueHash: hash[:]},
+		{Key: "/a", Value: []byte("0")},
+		{Key: "/a", Value: []byte("1"), ExpectedRecordExists: pb.Bool(true)},
+		{Key: "/a", Value: []byte("1"), ExpectedValueHash: hash[:]},
+	}}, 0, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, res.Puts[0].Status)
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, res.Puts[1].Status)
+	assert.Equal(t, proto.Status_OK, res.Puts[2].Status)
+	assert.Equal(t, proto.Status_OK, res.Puts[3].Status)
+	assert.Equal(t, proto.Status_UNEXPECTED_VALUE, res.Puts[4].Status)
+
+	res, err = db.ProcessWrite(&proto.WriteRequest{Deletes: []*proto.DeleteRequest{
+		{Key: "/a", ExpectedValueHash: hash[:]},
+		{Key: "/a", ExpectedValueHash: otherHash[:]},
+	}}, 1, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_UNEXPECTED_VALUE, res.Deletes[0].Status)
+	assert.Equal(t, proto.Status_OK, res.Deletes[1].Status)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
+
+func TestDB_AtomicWriteRequest(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	res, err := db.ProcessWrite(&proto.WriteRequest{
+		Atomic: true,
+		Puts: []*proto.PutRequest{
+			{Key: "/a", Value: []byte("0"), ExpectedVersionId: pb.Int64(-1)},
+			{Key: "/b", Value: []byte("0"), ExpectedVersionId: pb.Int64(-1)},
+		},
+	}, 0, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Puts[0].Status)
+	assert.EqualValues(t, 0, res.Puts[0].Version.VersionId)
+	assert.Equal(t, proto.Status_OK, res.Puts[1].Status)
+	assert.EqualValues(t, 1, res.Puts[1].Version.VersionId)
+
+	// A single failed condition aborts all the operations
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Atomic: true,
+		Puts: []*proto.PutRequest{
+			{Key: "/a", Value: []byte("1"), ExpectedVersionId: pb.Int64(0)},
+			{Key: "/b", Value: []byte("1"), ExpectedVersionId: pb.Int64(0)},
+			{Key: "/c", Value: []byte("1")},
+		},
+		Deletes:      []*proto.DeleteRequest{{Key: "/d"}},
+		DeleteRanges: []*proto.DeleteRangeRequest{{StartInclusive: "/e", EndExclusive: "/f"}},
+	}, 1, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_BATCH_ABORTED, res.Puts[0].Status)
+	assert.Equal(t, proto.Status_UNEXPECTED_VERSION_ID, res.Puts[1].Status)
+	assert.Equal(t, proto.Status_BATCH_ABORTED, res.Puts[2].Status)
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, res.Deletes[0].Status)
+	assert.Equal(t, proto.Status_BATCH_ABORTED, res.DeleteRanges[0].Status)
+
+	for _, key := range []string{"/a", "/b"} {
+		gr, err := db.Get(&proto.GetRequest{Key: key, IncludeValue: true})
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("0"), gr.Value)
+	}
+	gr, err := db.Get(&proto.GetRequest{Key: "/c"})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, gr.Status)
+
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Atomic: true,
+		Puts: []*proto.PutRequest{
+			{Key: "/a", Value: []byte("1"), ExpectedVersionId: pb.Int64(0)},
+		},
+		Deletes: []*proto.DeleteRequest{{Key: "/b", ExpectedVersionId: pb.Int64(1)}},
+	}, 2, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Puts[0].Status)
+	assert.Equal(t, proto.Status_OK, res.Deletes[0].Status)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
+
+func TestDB_AtomicWriteRequestSameKey(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	// The second put sees the record created by the first one
+	res, err := db.ProcessWrite(&proto.WriteRequest{
+		Atomic: true,
+		Puts: []*proto.PutRequest{
+			{Key: "/a", Value: []byte("0"), ExpectedVersionId: pb.Int64(-1)},
+			{Key: "/a", Value: []byte("1"), ExpectedVersionId: pb.Int64(-1)},
+		},
+	}, 0, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_BATCH_ABORTED, res.Puts[0].Status)
+	assert.Nil(t, res.Puts[0].Version)
+	assert.Equal(t, proto.Status_UNEXPECTED_VERSION_ID, res.Puts[1].Status)
+
+	gr, err := db.Get(&proto.GetRequest{Key: "/a"})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, gr.Status)
+
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{Key: "/b", Value: []byte("0")}},
+	}, 1, 0, NoOpCallback)
+	assert.NoError(t, err)
+	versionId := res.Puts[0].Version.VersionId
+
+	// The delete expects the version that the put replaces
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Atomic:  true,
+		Puts:    []*proto.PutRequest{{Key: "/b", Value: []byte("1"), ExpectedVersionId: pb.Int64(versionId)}},
+		Deletes: []*proto.DeleteRequest{{Key: "/b", ExpectedVersionId: pb.Int64(versionId)}},
+	}, 2, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_BATCH_ABORTED, res.Puts[0].Status)
+	assert.Equal(t, proto.Status_UNEXPECTED_VERSION_ID, res.Deletes[0].Status)
+
+	gr, err = db.Get(&proto.GetRequest{Key: "/b", IncludeValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("0"), gr.Value)
+	assert.Equal(t, versionId, gr.Version.VersionId)
+
+	// The version ids of the discarded changes are not consumed
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Atomic: true,
+		Puts: []*proto.PutRequest{
+			{Key: "/b", Value: []byte("1"), ExpectedVersionId: pb.Int64(versionId)},
+			{Key: "/b", Value: []byte("2"), ExpectedVersionId: pb.Int64(versionId + 1)},
+		},
+	}, 3, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Puts[0].Status)
+	assert.Equal(t, versionId+1, res.Puts[0].Version.VersionId)
+	assert.Equal(t, proto.Status_OK, res.Puts[1].Status)
+	assert.Equal(t, versionId+2, res.Puts[1].Version.VersionId)
+
+	gr, err = db.Get(&proto.GetRequest{Key: "/b", IncludeValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("2"), gr.Value)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
+
+func TestDB_Increment(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	res, err := db.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{Key: "/x", Value: []byte("not-a-number")}},
+		Increments: []*proto.IncrementRequest{
+			{Key: "/a", Delta: 5},
+			{Key: "/a", Delta: -2},
+			{Key: "/a", Delta: 10, MaxValue: pb.Int64(10)},
+			{Key: "/a", Delta: -10, MinValue: pb.Int64(0)},
+			{Key: "/x", Delta: 1},
+		},
+	}, 0, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Increments[0].Status)
+	assert.EqualValues(t, 5, res.Increments[0].Value)
+	assert.EqualValues(t, 0, res.Increments[0].Version.ModificationsCount)
+	assert.Equal(t, proto.Status_OK, res.Increments[1].Status)
+	assert.EqualValues(t, 3, res.Increments[1].Value)
+	assert.EqualValues(t, 1, res.Increments[1].Version.ModificationsCount)
+	assert.Equal(t, proto.Status_VALUE_OUT_OF_BOUNDS, res.Increments[2].Status)
+	assert.Equal(t, proto.Status_VALUE_OUT_OF_BOUNDS, res.Increments[3].Status)
+	assert.Equal(t, proto.Status_INVALID_NUMBER, res.Increments[4].Status)
+
+	gr, err := db.Get(&proto.GetRequest{Key: "/a", IncludeValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("3"), gr.Value)
+
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{Key: "/max", Value: []byte(strconv.FormatInt(math.MaxInt64, 10))}},
+		Increments: []*proto.IncrementRequest{
+			{Key: "/max", Delta: 1},
+		},
+	}, 1, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_VALUE_OUT_OF_BOUNDS, res.Increments[0].Status)
+
+	// A counter out of bounds aborts the whole atomic request. Each increment
+	// applies on top of the previous ones
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Atomic: true,
+		Puts:   []*proto.PutRequest{{Key: "/b", Value: []byte("0")}},
+		Increments: []*proto.IncrementRequest{
+			{Key: "/a", Delta: -2},
+			{Key: "/a", Delta: -2, MinValue: pb.Int64(0)},
+		},
+	}, 2, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_BATCH_ABORTED, res.Puts[0].Status)
+	assert.Equal(t, proto.Status_BATCH_ABORTED, res.Increments[0].Status)
+	assert.Equal(t, proto.Status_VALUE_OUT_OF_BOUNDS, res.Increments[1].Status)
+
+	gr, err = db.Get(&proto.GetRequest{Key: "/a", IncludeValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("3"), gr.Value)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
+
+func TestDB_AppendAndMergePatch(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	res, err := db.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{
+			Key:   "/a",
+			Value: []byte("x"),
+			TtlMs: pb.Uint64(60_000),
+		}},
+		Appends: []*proto.AppendRequest{
+			{Key: "/a", Value: []byte("y")},
+			{Key: "/a", Value: []byte("z")},
+			{Key: "/b", Value: []byte("new")},
+		},
+		MergePatches: []*proto.MergePatchRequest{
+			{Key: "/c", Patch: []byte(`{"a":1,"b":{"c":2,"d":null}}`)},
+			{Key: "/c", Patch: []byte(`{"a":null,"b":{"e":12345678901234567890}}`)},
+			{Key: "/a", Patch: []byte(`{}`)},
+			{Key: "/c", Patch: []byte(`{"a":`)},
+		},
+	}, 0, 1000, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Appends[0].Status)
+	assert.EqualValues(t, 1, res.Appends[0].Version.ModificationsCount)
+	assert.EqualValues(t, 61_000, res.Appends[0].Version.GetExpirationTimestamp())
+	assert.Equal(t, proto.Status_OK, res.Appends[1].Status)
+	assert.EqualValues(t, 2, res.Appends[1].Version.ModificationsCount)
+	assert.Equal(t, proto.Status_OK, res.Appends[2].Status)
+	assert.EqualValues(t, 0, res.Appends[2].Version.ModificationsCount)
+	assert.Equal(t, proto.Status_OK, res.MergePatches[0].Status)
+	assert.Equal(t, proto.Status_OK, res.MergePatches[1].Status)
+	assert.EqualValues(t, 1, res.MergePatches[1].Version.ModificationsCount)
+	assert.Equal(t, proto.Status_INVALID_JSON, res.MergePatches[2].Status)
+	assert.Equal(t, proto.Status_INVALID_JSON, res.MergePatches[3].Status)
+
+	gr, err := db.Get(&proto.GetRequest{Key: "/a", IncludeValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("xyz"), gr.Value)
+	assert.EqualValues(t, 61_000, gr.Version.GetExpirationTimestamp())
+
+	gr, err = db.Get(&proto.GetRequest{Key: "/b", IncludeValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("new"), gr.Value)
+
+	gr, err = db.Get(&proto.GetRequest{Key: "/c", IncludeValue: true})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"b":{"c":2,"e":12345678901234567890}}`, string(gr.Value))
+
+	// An invalid patch aborts the whole atomic request
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Atomic:       true,
+		Appends:      []*proto.AppendRequest{{Key: "/b", Value: []byte("-more")}},
+		MergePatches: []*proto.MergePatchRequest{{Key: "/b", Patch: []byte(`{"a":1}`)}},
+	}, 1, 2000, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_BATCH_ABORTED, res.Appends[0].Status)
+	assert.Equal(t, proto.Status_INVALID_JSON, res.MergePatches[0].Status)
+
+	gr, err = db.Get(&proto.GetRequest{Key: "/b", IncludeValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("new"), gr.Value)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
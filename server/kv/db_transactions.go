@@ -0,0 +1,320 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"bytes"
+	"log/slog"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/proto"
+)
+
+// The state of the multi-shard transactions is kept in internal keys:
+//   - the intent holds the operations staged by a prepared transaction
+//   - the lock prevents any other write on a record of a prepared transaction.
+//     The reads are not blocked, and they keep returning the last committed
+//     value until the transaction is committed on the shard
+//   - the outcome records whether the transaction was committed or aborted, so
+//     that the requests can be safely retried
+const (
+	TransactionIntentsPrefix  = constant.InternalKeyPrefix + "txn-intent"
+	TransactionOutcomesPrefix = constant.InternalKeyPrefix + "txn-outcome"
+	transactionLocksPrefix    = constant.InternalKeyPrefix + "txn-lock"
+)
+
+func TransactionIntentKey(transactionId string) string {
+	return TransactionIntentsPrefix + "/" + url.PathEscape(transactionId)
+}
+
+func TransactionOutcomeKey(transactionId string) string {
+	return TransactionOutcomesPrefix + "/" + url.PathEscape(transactionId)
+}
+
+func transactionLockKey(key string) string {
+	return transactionLocksPrefix + "/" + url.PathEscape(key)
+}
+
+// TransactionIdFromKey extracts the transaction id from an intent or outcome key.
+func TransactionIdFromKey(key string) (string, error) {
+	idx := strings.LastIndexByte(key, '/')
+	return url.PathUnescape(key[idx+1:])
+}
+
+func (d *db) applyTransaction(b *proto.WriteRequest, batch WriteBatch, baseVersionId *atomic.Int64,
+	notifications *Notifications, timestamp uint64, updateOperationCallback UpdateOperationCallback) (*proto.WriteResponse, error) {
+	txn := b.Transaction
+
+	status, found, err := readTransactionOutcome(batch, txn.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		// The transaction was already completed, this is a retry
+		return &proto.WriteResponse{TransactionStatus: &status}, nil
+	}
+
+	switch txn.Action {
+	case proto.TransactionAction_PREPARE:
+		return d.prepareTransaction(b, batch, timestamp)
+	case proto.TransactionAction_COMMIT:
+		return d.commitTransaction(txn.TransactionId, batch, baseVersionId, notifications, timestamp, updateOperationCallback)
+	case proto.TransactionAction_ABORT:
+		return d.abortTransaction(txn.TransactionId, batch, timestamp)
+	default:
+		return nil, errors.Errorf("oxia db: unknown transaction action %v", txn.Action)
+	}
+}
+
+func (d *db) prepareTransaction(b *proto.WriteRequest, batch WriteBatch, timestamp uint64) (*proto.WriteResponse, error) {
+	txn := b.Transaction
+	intent, err := readTransactionIntent(batch, txn.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+	if intent != nil {
+		// The transaction was already prepared, this is a retry
+		return &proto.WriteResponse{TransactionStatus: proto.TransactionStatus_PENDING.Enum()}, nil
+	}
+
+	res := &proto.WriteResponse{}
+	prepared := true
+	for _, putReq := range b.Puts {
+		status, err := checkOperationConditions(batch, putReq.Key, putReq.ExpectedVersionId,
+			putReq.GetExpectedRecordExists(), putReq.ExpectedValueHash)
+		if err != nil {
+			return nil, err
+		}
+		prepared = prepared && status == proto.Status_OK
+		res.Puts = append(res.Puts, &proto.PutResponse{Status: status})
+	}
+	for _, delReq := range b.Deletes {
+		// As for the atomic batches, the record must exist, otherwise the
+		// delete would fail once the transaction is committed
+		status, err := checkOperationConditions(batch, delReq.Key, delReq.ExpectedVersionId, true, delReq.ExpectedValueHash)
+		if err != nil {
+			return nil, err
+		}
+		prepared = prepared && status == proto.Status_OK
+		res.Deletes = append(res.Deletes, &proto.DeleteResponse{Status: status})
+	}
+
+	if !prepared {
+		res.TransactionStatus = proto.TransactionStatus_ABORTED.Enum()
+		return res, d.putTransactionOutcome(batch, txn.TransactionId, proto.TransactionStatus_ABORTED, timestamp)
+	}
+
+	intent = &proto.TransactionIntent{
+		PrimaryShard:        txn.PrimaryShard,
+		PrimaryHash:         txn.PrimaryHash,
+		ExpirationTimestamp: timestamp + uint64(txn.TimeoutMs),
+	}
+	for _, putReq := range b.Puts {
+		// The conditions were already verified, and the records
+		// cannot change until the transaction is completed
+		putReq.ExpectedVersionId = nil
+		putReq.ExpectedRecordExists = nil
+		putReq.ExpectedValueHash = nil
+		intent.Puts = append(intent.Puts, putReq)
+		if err = d.putInternal(batch, transactionLockKey(putReq.Key), []byte(txn.TransactionId), timestamp); err != nil {
+			return nil, err
+		}
+	}
+	for _, delReq := range b.Deletes {
+		delReq.ExpectedVersionId = nil
+		delReq.ExpectedValueHash = nil
+		intent.Deletes = append(intent.Deletes, delReq)
+		if err = d.putInternal(batch, transactionLockKey(delReq.Key), []byte(txn.TransactionId), timestamp); err != nil {
+			return nil, err
+		}
+	}
+
+	value, err := intent.MarshalVT()
+	if err != nil {
+		return nil, err
+	}
+	if err = d.putInternal(batch, TransactionIntentKey(txn.TransactionId), value, timestamp); err != nil {
+		return nil, err
+	}
+
+	d.log.Debug(
+		"Prepared transaction",
+		slog.String("transaction-id", txn.TransactionId),
+		slog.Int64("primary-shard", txn.PrimaryShard),
+	)
+	res.TransactionStatus = proto.TransactionStatus_PENDING.Enum()
+	return res, nil
+}
+
+func (d *db) commitTransaction(transactionId string, batch WriteBatch, baseVersionId *atomic.Int64,
+	notifications *Notifications, timestamp uint64, updateOperationCallback UpdateOperationCallback) (*proto.WriteResponse, error) {
+	intent, err := readTransactionIntent(batch, transactionId)
+	if err != nil {
+		return nil, err
+	}
+	if intent == nil {
+		// The transaction was never prepared on this shard, it can
+		// only be aborted
+		return d.abortTransaction(transactionId, batch, timestamp)
+	}
+
+	if err = d.releaseTransaction(transactionId, intent, batch); err != nil {
+		return nil, err
+	}
+
+	res := &proto.WriteResponse{TransactionStatus: proto.TransactionStatus_COMMITTED.Enum()}
+	d.putCounter.Add(len(intent.Puts))
+	for _, putReq := range intent.Puts {
+		pr, err := d.applyPut(batch, baseVersionId, notifications, putReq, timestamp, updateOperationCallback, false)
+		if err != nil {
+			return nil, err
+		}
+		res.Puts = append(res.Puts, pr)
+	}
+	d.deleteCounter.Add(len(intent.Deletes))
+	for _, delReq := range intent.Deletes {
+		dr, err := d.applyDelete(batch, notifications, delReq, updateOperationCallback)
+		if err != nil {
+			return nil, err
+		}
+		res.Deletes = append(res.Deletes, dr)
+	}
+
+	d.log.Debug(
+		"Committed transaction",
+		slog.String("transaction-id", transactionId),
+	)
+	return res, d.putTransactionOutcome(batch, transactionId, proto.TransactionStatus_COMMITTED, timestamp)
+}
+
+func (d *db) abortTransaction(transactionId string, batch WriteBatch, timestamp uint64) (*proto.WriteResponse, error) {
+	intent, err := readTransactionIntent(batch, transactionId)
+	if err != nil {
+		return nil, err
+	}
+	if intent != nil {
+		if err = d.releaseTransaction(transactionId, intent, batch); err != nil {
+			return nil, err
+		}
+	}
+
+	d.log.Debug(
+		"Aborted transaction",
+		slog.String("transaction-id", transactionId),
+	)
+	// The outcome is recorded even if the transaction was not prepared, to
+	// make sure a late prepare request will not lock the records again
+	return &proto.WriteResponse{TransactionStatus: proto.TransactionStatus_ABORTED.Enum()},
+		d.putTransactionOutcome(batch, transactionId, proto.TransactionStatus_ABORTED, timestamp)
+}
+
+// releaseTransaction removes the intent and all the locks of a transaction.
+func (*db) releaseTransaction(transactionId string, intent *proto.TransactionIntent, batch WriteBatch) error {
+	for _, putReq := range intent.Puts {
+		if err := batch.Delete(transactionLockKey(putReq.Key)); err != nil {
+			return err
+		}
+	}
+	for _, delReq := range intent.Deletes {
+		if err := batch.Delete(transactionLockKey(delReq.Key)); err != nil {
+			return err
+		}
+	}
+	return batch.Delete(TransactionIntentKey(transactionId))
+}
+
+func (d *db) putTransactionOutcome(batch WriteBatch, transactionId string, status proto.TransactionStatus, timestamp uint64) error {
+	return d.putInternal(batch, TransactionOutcomeKey(transactionId), []byte(status.String()), timestamp)
+}
+
+func (d *db) putInternal(batch WriteBatch, key string, value []byte, timestamp uint64) error {
+	_, err := d.applyPut(batch, nil, nil, &proto.PutRequest{
+		Key:   key,
+		Value: value,
+	}, timestamp, NoOpCallback, true)
+	return err
+}
+
+func isKeyLocked(batch WriteBatch, key string) (bool, error) {
+	_, closer, err := batch.Get(transactionLockKey(key))
+	if errors.Is(err, ErrKeyNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, closer.Close()
+}
+
+// isRangeLocked checks whether any of the records in the range is locked by
+// a pending transaction.
+func (d *db) isRangeLocked(batch WriteBatch, startInclusive string, endExclusive string) (bool, error) {
+	it, err := batch.KeyRangeScan(transactionLocksPrefix+"/", transactionLocksPrefix+"//")
+	if err != nil {
+		return false, err
+	}
+
+	encodedStart := d.keyEncoder.Encode(startInclusive)
+	encodedEnd := d.keyEncoder.Encode(endExclusive)
+	for ; it.Valid(); it.Next() {
+		key, err := url.PathUnescape(it.Key()[len(transactionLocksPrefix)+1:])
+		if err != nil {
+			return false, multierr.Append(err, it.Close())
+		}
+
+		encodedKey := d.keyEncoder.Encode(key)
+		if bytes.Compare(encodedKey, encodedStart) >= 0 && bytes.Compare(encodedKey, encodedEnd) < 0 {
+			return true, it.Close()
+		}
+	}
+	return false, it.Close()
+}
+
+func readTransactionIntent(batch WriteBatch, transactionId string) (*proto.TransactionIntent, error) {
+	se, err := GetStorageEntry(batch, TransactionIntentKey(transactionId))
+	if errors.Is(err, ErrKeyNotFound) {
+		return nil, nil //nolint:nilnil
+	} else if err != nil {
+		return nil, err
+	}
+	defer se.ReturnToVTPool()
+
+	intent := &proto.TransactionIntent{}
+	if err = intent.UnmarshalVT(se.Value); err != nil {
+		return nil, errors.Wrap(err, "oxia db: failed to deserialize transaction intent")
+	}
+	return intent, nil
+}
+
+func readTransactionOutcome(batch WriteBatch, transactionId string) (status proto.TransactionStatus, found bool, err error) {
+	se, err := GetStorageEntry(batch, TransactionOutcomeKey(transactionId))
+	if errors.Is(err, ErrKeyNotFound) {
+		return proto.TransactionStatus_PENDING, false, nil
+	} else if err != nil {
+		return proto.TransactionStatus_PENDING, false, err
+	}
+	defer se.ReturnToVTPool()
+
+	value, ok := proto.TransactionStatus_value[string(se.Value)]
+	if !ok {
+		return proto.TransactionStatus_PENDING, false, errors.Errorf("oxia db: invalid transaction outcome %q", se.Value)
+	}
+	return proto.TransactionStatus(value), true, nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/common/compare"
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/time"
+	"github.com/oxia-db/oxia/proto"
+)
+
+func transactionRequest(id string, action proto.TransactionAction) *proto.WriteRequest {
+	return &proto.WriteRequest{Transaction: &proto.TransactionRequest{
+		TransactionId: id,
+		Action:        action,
+		PrimaryShard:  1,
+		TimeoutMs:     1000,
+	}}
+}
+
+func TestDB_TransactionCommit(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	res, err := db.ProcessWrite(&proto.WriteRequest{Puts: []*proto.PutRequest{{
+		Key:   "/b",
+		Value: []byte("0"),
+	}}}, 0, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, res.Puts[0].Version.VersionId)
+
+	prepare := transactionRequest("txn-1", proto.TransactionAction_PREPARE)
+	prepare.Puts = []*proto.PutRequest{{Key: "/a", Value: []byte("1"), ExpectedVersionId: pb.Int64(-1)}}
+	prepare.Deletes = []*proto.DeleteRequest{{Key: "/b", ExpectedVersionId: pb.Int64(0)}}
+	res, err = db.ProcessWrite(prepare, 1, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_PENDING, res.GetTransactionStatus())
+	assert.Equal(t, proto.Status_OK, res.Puts[0].Status)
+	assert.Equal(t, proto.Status_OK, res.Deletes[0].Status)
+
+	// The staged operations are not visible yet, and the locked records
+	// can still be read
+	gr, err := db.Get(&proto.GetRequest{Key: "/a"})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, gr.Status)
+	gr, err = db.Get(&proto.GetRequest{Key: "/b", IncludeValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, gr.Status)
+	assert.Equal(t, []byte("0"), gr.Value)
+
+	// The records are locked
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Puts:         []*proto.PutRequest{{Key: "/a", Value: []byte("x")}},
+		Deletes:      []*proto.DeleteRequest{{Key: "/b"}},
+		DeleteRanges: []*proto.DeleteRangeRequest{{StartInclusive: "/", EndExclusive: "//"}},
+	}, 2, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_KEY_LOCKED, res.Puts[0].Status)
+	assert.Equal(t, proto.Status_KEY_LOCKED, res.Deletes[0].Status)
+	assert.Equal(t, proto.Status_KEY_LOCKED, res.DeleteRanges[0].Status)
+
+	// Ranges without locked records can still be deleted
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		DeleteRanges: []*proto.DeleteRangeRequest{{StartInclusive: "/c", EndExclusive: "/d"}},
+	}, 3, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.DeleteRanges[0].Status)
+
+	// Prepare is idempotent
+	res, err = db.ProcessWrite(prepare, 4, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_PENDING, res.GetTransactionStatus())
+
+	res, err = db.ProcessWrite(transactionRequest("txn-1", proto.TransactionAction_COMMIT), 5, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_COMMITTED, res.GetTransactionStatus())
+	assert.Equal(t, proto.Status_OK, res.Puts[0].Status)
+	assert.EqualValues(t, 1, res.Puts[0].Version.VersionId)
+	assert.Equal(t, proto.Status_OK, res.Deletes[0].Status)
+
+	gr, err = db.Get(&proto.GetRequest{Key: "/a", IncludeValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, gr.Status)
+	assert.Equal(t, []byte("1"), gr.Value)
+	gr, err = db.Get(&proto.GetRequest{Key: "/b"})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, gr.Status)
+
+	// Retries and late aborts return the outcome
+	res, err = db.ProcessWrite(transactionRequest("txn-1", proto.TransactionAction_COMMIT), 6, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_COMMITTED, res.GetTransactionStatus())
+	res, err = db.ProcessWrite(transactionRequest("txn-1", proto.TransactionAction_ABORT), 7, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_COMMITTED, res.GetTransactionStatus())
+
+	// The records are not locked anymore
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{Key: "/a", Value: []byte("2")}},
+	}, 8, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Puts[0].Status)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
+
+func TestDB_TransactionAbort(t *testing.T) {
+	factory, err := NewPebbleKVFactory(NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	db, err := NewDB(constant.DefaultNamespace, 1, factory, compare.EncoderHierarchical, 0, time.SystemClock)
+	assert.NoError(t, err)
+
+	prepare := transactionRequest("txn-1", proto.TransactionAction_PREPARE)
+	prepare.Puts = []*proto.PutRequest{{Key: "/a", Value: []byte("1")}}
+	res, err := db.ProcessWrite(prepare, 0, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_PENDING, res.GetTransactionStatus())
+
+	// A conflicting transaction cannot be prepared
+	conflicting := transactionRequest("txn-2", proto.TransactionAction_PREPARE)
+	conflicting.Puts = []*proto.PutRequest{{Key: "/a", Value: []byte("2")}, {Key: "/b", Value: []byte("2")}}
+	res, err = db.ProcessWrite(conflicting, 1, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_ABORTED, res.GetTransactionStatus())
+	assert.Equal(t, proto.Status_KEY_LOCKED, res.Puts[0].Status)
+	assert.Equal(t, proto.Status_OK, res.Puts[1].Status)
+
+	res, err = db.ProcessWrite(transactionRequest("txn-1", proto.TransactionAction_ABORT), 2, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_ABORTED, res.GetTransactionStatus())
+
+	// An aborted transaction cannot be committed
+	res, err = db.ProcessWrite(transactionRequest("txn-1", proto.TransactionAction_COMMIT), 3, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_ABORTED, res.GetTransactionStatus())
+
+	gr, err := db.Get(&proto.GetRequest{Key: "/a"})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, gr.Status)
+
+	// A prepare request received after the abort must not lock the records
+	late := transactionRequest("txn-3", proto.TransactionAction_ABORT)
+	_, err = db.ProcessWrite(late, 4, 0, NoOpCallback)
+	assert.NoError(t, err)
+	late = transactionRequest("txn-3", proto.TransactionAction_PREPARE)
+	late.Puts = []*proto.PutRequest{{Key: "/a", Value: []byte("3")}}
+	res, err = db.ProcessWrite(late, 5, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_ABORTED, res.GetTransactionStatus())
+
+	// Unexpected versions abort the prepare
+	prepare = transactionRequest("txn-4", proto.TransactionAction_PREPARE)
+	prepare.Puts = []*proto.PutRequest{{Key: "/a", Value: []byte("1"), ExpectedVersionId: pb.Int64(5)}}
+	res, err = db.ProcessWrite(prepare, 6, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_ABORTED, res.GetTransactionStatus())
+	assert.Equal(t, proto.Status_UNEXPECTED_VERSION_ID, res.Puts[0].Status)
+
+	// Deleting a record that does not exist aborts the prepare
+	prepare = transactionRequest("txn-5", proto.TransactionAction_PREPARE)
+	prepare.Deletes = []*proto.DeleteRequest{{Key: "/c"}}
+	res, err = db.ProcessWrite(prepare, 7, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_ABORTED, res.GetTransactionStatus())
+	assert.Equal(t, proto.Status_KEY_NOT_FOUND, res.Deletes[0].Status)
+
+	res, err = db.ProcessWrite(&proto.WriteRequest{
+		Puts: []*proto.PutRequest{{Key: "/a", Value: []byte("x")}},
+	}, 8, 0, NoOpCallback)
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Puts[0].Status)
+
+	assert.NoError(t, db.Close())
+	assert.NoError(t, factory.Close())
+}
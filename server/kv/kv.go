@@ -78,6 +78,12 @@ type KeyValueIterator interface {
 	Value() ([]byte, error)
 }
 
+type ReverseKeyValueIterator interface {
+	ReverseKeyIterator
+
+	Value() ([]byte, error)
+}
+
 type SnapshotChunk interface {
 	Name() string
 	Index() int32
@@ -104,6 +110,17 @@ type SnapshotLoader interface {
 	Complete()
 }
 
+// StagingSnapshotLoader loads a snapshot into a temporary location, without
+// replacing the database of the shard. The loaded data is discarded when the
+// loader is closed.
+type StagingSnapshotLoader interface {
+	SnapshotLoader
+
+	// OpenKV opens the loaded snapshot, once it is complete. The returned KV
+	// must be closed before closing the loader.
+	OpenKV(keyEncoder compare.Encoder) (KV, error)
+}
+
 type ComparisonType proto.KeyComparisonType
 
 const (
@@ -126,6 +143,7 @@ type KV interface {
 	KeyIterator() (KeyIterator, error)
 
 	RangeScan(lowerBound, upperBound string) (KeyValueIterator, error)
+	RangeScanReverse(lowerBound, upperBound string) (ReverseKeyValueIterator, error)
 
 	Snapshot() (Snapshot, error)
 
@@ -173,4 +191,8 @@ type Factory interface {
 	NewKV(namespace string, shardId int64, keyEncoder compare.Encoder) (KV, error)
 
 	NewSnapshotLoader(namespace string, shardId int64) (SnapshotLoader, error)
+
+	// NewStagingSnapshotLoader loads a snapshot for the shard into a temporary
+	// location, apart from the ones with a different name
+	NewStagingSnapshotLoader(namespace string, shardId int64, name string) (StagingSnapshotLoader, error)
 }
@@ -112,11 +112,18 @@ func (p *PebbleFactory) Close() error {
 }
 
 func (p *PebbleFactory) NewKV(namespace string, shardId int64, keyEncoder compare.Encoder) (KV, error) {
-	return newKVPebble(p, namespace, shardId, keyEncoder)
+	return newKVPebble(p, namespace, shardId, p.getKVPath(namespace, shardId), keyEncoder)
 }
 
 func (p *PebbleFactory) NewSnapshotLoader(namespace string, shardId int64) (SnapshotLoader, error) {
-	return newPebbleSnapshotLoader(p, namespace, shardId)
+	return newPebbleSnapshotLoader(p, namespace, shardId, p.getKVPath(namespace, shardId), false)
+}
+
+func (p *PebbleFactory) NewStagingSnapshotLoader(namespace string, shardId int64, name string) (StagingSnapshotLoader, error) {
+	// Staging snapshots are placed in the snapshots directory, so that they get
+	// cleaned up on restart
+	stagingPath := filepath.Join(p.dataDir, "snapshots", "staging", namespace, fmt.Sprint("shard-", shardId), name)
+	return newPebbleSnapshotLoader(p, namespace, shardId, stagingPath, true)
 }
 
 func (p *PebbleFactory) getKVPath(namespace string, shard int64) string {
@@ -160,7 +167,7 @@ type Pebble struct {
 	batchCountHisto metric.Histogram
 }
 
-func newKVPebble(factory *PebbleFactory, namespace string, shardId int64, keyEncoder compare.Encoder) (KV, error) {
+func newKVPebble(factory *PebbleFactory, namespace string, shardId int64, dbPath string, keyEncoder compare.Encoder) (KV, error) {
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	labels := metric.LabelsForShard(namespace, shardId)
 	pb := &Pebble{
@@ -169,7 +176,7 @@ func newKVPebble(factory *PebbleFactory, namespace string, shardId int64, keyEnc
 		factory:    factory,
 		namespace:  namespace,
 		shardId:    shardId,
-		dbPath:     factory.getKVPath(namespace, shardId),
+		dbPath:     dbPath,
 		keyEncoder: keyEncoder,
 
 		batchCommitLatency: metric.NewLatencyHistogram("oxia_server_kv_batch_commit_latency",
@@ -377,7 +384,7 @@ func (p *Pebble) Close() error {
 func (p *Pebble) Delete() error {
 	return multierr.Combine(
 		p.Close(),
-		os.RemoveAll(p.factory.getKVPath(p.namespace, p.shardId)),
+		os.RemoveAll(p.dbPath),
 	)
 }
 
@@ -511,6 +518,10 @@ func (p *Pebble) KeyIterator() (KeyIterator, error) {
 }
 
 func (p *Pebble) KeyRangeScanReverse(lowerBound, upperBound string) (ReverseKeyIterator, error) {
+	return p.RangeScanReverse(lowerBound, upperBound)
+}
+
+func (p *Pebble) RangeScanReverse(lowerBound, upperBound string) (ReverseKeyValueIterator, error) {
 	opts := &pebble.IterOptions{}
 	if lowerBound != "" {
 		opts.LowerBound = p.keyEncoder.Encode(lowerBound)
@@ -729,16 +740,18 @@ type pebbleSnapshotLoader struct {
 	namespace string
 	shard     int64
 	dbPath    string
+	staging   bool
 	complete  bool
 	file      *os.File
 }
 
-func newPebbleSnapshotLoader(pf *PebbleFactory, namespace string, shard int64) (SnapshotLoader, error) {
+func newPebbleSnapshotLoader(pf *PebbleFactory, namespace string, shard int64, dbPath string, staging bool) (*pebbleSnapshotLoader, error) {
 	sl := &pebbleSnapshotLoader{
 		pf:        pf,
 		namespace: namespace,
 		shard:     shard,
-		dbPath:    pf.getKVPath(namespace, shard),
+		dbPath:    dbPath,
+		staging:   staging,
 	}
 
 	if err := os.RemoveAll(sl.dbPath); err != nil {
@@ -753,11 +766,12 @@ func newPebbleSnapshotLoader(pf *PebbleFactory, namespace string, shard int64) (
 }
 
 func (sl *pebbleSnapshotLoader) Close() error {
-	if sl.complete {
+	if sl.complete && !sl.staging {
 		return nil
 	}
 
-	// If we failed to successfully load, remove all intermediate files
+	// If we failed to successfully load, remove all intermediate files.
+	// Staging snapshots are always discarded after use.
 	return os.RemoveAll(sl.dbPath)
 }
 
@@ -793,3 +807,10 @@ func (sl *pebbleSnapshotLoader) AddChunk(fileName string, chunkIndex int32, chun
 func (sl *pebbleSnapshotLoader) Complete() {
 	sl.complete = true
 }
+
+func (sl *pebbleSnapshotLoader) OpenKV(keyEncoder compare.Encoder) (KV, error) {
+	if !sl.complete {
+		return nil, errors.New("snapshot is not complete")
+	}
+	return newKVPebble(sl.pf, sl.namespace, sl.shard, sl.dbPath, keyEncoder)
+}
@@ -48,11 +48,14 @@ var (
 
 type Notifications struct {
 	batch proto.NotificationBatch
+
+	includeValue         bool
+	includePreviousValue bool
 }
 
 func newNotifications(shardId int64, offset int64, timestamp uint64) *Notifications {
 	return &Notifications{
-		proto.NotificationBatch{
+		batch: proto.NotificationBatch{
 			Shard:         shardId,
 			Offset:        offset,
 			Timestamp:     timestamp,
@@ -61,7 +64,13 @@ func newNotifications(shardId int64, offset int64, timestamp uint64) *Notificati
 	}
 }
 
-func (n *Notifications) Modified(key string, versionId, modificationsCount int64) {
+// IncludesPreviousValue returns whether the notifications capture the value
+// replaced by each change.
+func (n *Notifications) IncludesPreviousValue() bool {
+	return n.includePreviousValue
+}
+
+func (n *Notifications) Modified(key string, versionId, modificationsCount int64, value []byte, previousValue []byte) {
 	if strings.HasPrefix(key, constant.InternalKeyPrefix) {
 		return
 	}
@@ -69,19 +78,37 @@ func (n *Notifications) Modified(key string, versionId, modificationsCount int64
 	if modificationsCount > 0 {
 		nType = proto.NotificationType_KEY_MODIFIED
 	}
-	n.batch.Notifications[key] = &proto.Notification{
+	notification := &proto.Notification{
 		Type:      nType,
 		VersionId: &versionId,
 	}
+	if n.includeValue {
+		notification.Value = cloneValue(value)
+	}
+	if n.includePreviousValue && nType == proto.NotificationType_KEY_MODIFIED {
+		notification.PreviousValue = cloneValue(previousValue)
+	}
+	n.batch.Notifications[key] = notification
 }
 
-func (n *Notifications) Deleted(key string) {
+func (n *Notifications) Deleted(key string, previousValue []byte) {
 	if strings.HasPrefix(key, constant.InternalKeyPrefix) {
 		return
 	}
-	n.batch.Notifications[key] = &proto.Notification{
+	notification := &proto.Notification{
 		Type: proto.NotificationType_KEY_DELETED,
 	}
+	if n.includePreviousValue {
+		notification.PreviousValue = cloneValue(previousValue)
+	}
+	n.batch.Notifications[key] = notification
+}
+
+// cloneValue copies a value that is captured in a notification, since the
+// storage entries it comes from are reused. The value is never nil, to
+// distinguish an empty value from a missing one.
+func cloneValue(value []byte) []byte {
+	return append([]byte{}, value...)
 }
 
 func (n *Notifications) DeletedRange(keyStartInclusive, keyEndExclusive string) {
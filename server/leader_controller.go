@@ -22,8 +22,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	pb "google.golang.org/protobuf/proto"
 
@@ -52,9 +54,10 @@ type LeaderController interface {
 	ListBlock(ctx context.Context, request *proto.ListRequest) ([]string, error)
 
 	Write(ctx context.Context, request *proto.WriteRequest, cb concurrent.Callback[*proto.WriteResponse])
-	List(ctx context.Context, request *proto.ListRequest, cb concurrent.StreamCallback[string])
+	List(ctx context.Context, request *proto.ListRequest, cb concurrent.StreamCallback[ListEntry])
 	Read(ctx context.Context, request *proto.ReadRequest, cb concurrent.StreamCallback[*proto.GetResponse])
 	RangeScan(ctx context.Context, request *proto.RangeScanRequest, cb concurrent.StreamCallback[*proto.GetResponse])
+	RangeStats(ctx context.Context, request *proto.RangeStatsRequest) (*proto.RangeStatsResponse, error)
 
 	GetSequenceUpdates(ctx context.Context, request *proto.GetSequenceUpdatesRequest) (kv.SequenceWaiter, error)
 
@@ -71,6 +74,13 @@ type LeaderController interface {
 	GetStatus(request *proto.GetStatusRequest) (*proto.GetStatusResponse, error)
 	DeleteShard(request *proto.DeleteShardRequest) (*proto.DeleteShardResponse, error)
 
+	// SplitShard Copies the shard data into the ensembles of the children shards
+	SplitShard(ctx context.Context, request *proto.SplitShardRequest) (*proto.SplitShardResponse, error)
+	// MergeShard Copies the shard data into the ensemble of the target shard
+	MergeShard(ctx context.Context, request *proto.MergeShardRequest) (*proto.MergeShardResponse, error)
+	// TransferLeadership Stops accepting writes until the given follower is caught up, so it can become leader
+	TransferLeadership(ctx context.Context, request *proto.TransferLeadershipRequest) (*proto.TransferLeadershipResponse, error)
+
 	Context() context.Context
 	// Term The current term of the leader
 	Term() int64
@@ -80,10 +90,24 @@ type LeaderController interface {
 	ShardID() int64
 
 	CreateSession(*proto.CreateSessionRequest) (*proto.CreateSessionResponse, error)
-	KeepAlive(sessionId int64) error
+	KeepAlive(sessionId int64, sourceShard *int64) (int64, error)
 	CloseSession(*proto.CloseSessionRequest) (*proto.CloseSessionResponse, error)
+	ListSessions(*proto.ListShardSessionsRequest) (*proto.ListShardSessionsResponse, error)
+	RevokeSession(*proto.RevokeShardSessionRequest) (*proto.RevokeShardSessionResponse, error)
+
+	PrepareTransaction(ctx context.Context, request *proto.PrepareTransactionRequest) (*proto.TransactionResponse, error)
+	CommitTransaction(ctx context.Context, request *proto.CommitTransactionRequest) (*proto.TransactionResponse, error)
+	AbortTransaction(ctx context.Context, request *proto.AbortTransactionRequest) (*proto.TransactionResponse, error)
 }
 
+// Interval at which the leader checks whether the new leader has caught up,
+// while transferring the leadership
+const followerAckPollInterval = 10 * time.Millisecond
+
+// Time after which the leader resumes accepting writes, if the leadership was
+// not handed off with a new term, when the request doesn't specify it
+const defaultLeadershipHandOffTimeout = 10 * time.Second
+
 type leaderController struct {
 	sync.RWMutex
 
@@ -95,6 +119,13 @@ type leaderController struct {
 	quorumAckTracker  QuorumAckTracker
 	followers         map[string]FollowerCursor
 
+	// While the shard data is being copied into other shards (eg: split or
+	// merge), or the leadership is being transferred, all the new writes are
+	// rejected with this error
+	writesRejectedErr error
+	// The offset of the barrier entry appended when the writes were rejected
+	writesRejectedOffset int64
+
 	// This represents the last entry in the WAL at the time this node
 	// became leader. It's used in the logic for deciding where to
 	// truncate the followers.
@@ -109,21 +140,33 @@ type leaderController struct {
 	sessionManager SessionManager
 	log            *slog.Logger
 
+	transactionResolver TransactionResolver
+	transactionManager  TransactionManager
+	recordsExpirer      RecordsExpirer
+
+	// The pending quorum round that confirms the leadership for the
+	// linearizable reads, and whether a round is already in flight
+	leadershipCheckMutex   sync.Mutex
+	leadershipCheck        *leadershipCheck
+	leadershipCheckRunning bool
+
 	writeLatencyHisto       metric.LatencyHistogram
 	headOffsetGauge         metric.Gauge
 	commitOffsetGauge       metric.Gauge
 	followerAckOffsetGauges map[string]metric.Gauge
 }
 
-func NewLeaderController(config Config, namespace string, shardId int64, rpcClient ReplicationRpcProvider, walFactory wal.Factory, kvFactory kv.Factory) (LeaderController, error) {
+func NewLeaderController(config Config, namespace string, shardId int64, rpcClient ReplicationRpcProvider,
+	transactionResolver TransactionResolver, walFactory wal.Factory, kvFactory kv.Factory) (LeaderController, error) {
 	labels := metric.LabelsForShard(namespace, shardId)
 	lc := &leaderController{
-		status:           proto.ServingStatus_NOT_MEMBER,
-		namespace:        namespace,
-		shardId:          shardId,
-		quorumAckTracker: nil,
-		rpcClient:        rpcClient,
-		followers:        make(map[string]FollowerCursor),
+		status:              proto.ServingStatus_NOT_MEMBER,
+		namespace:           namespace,
+		shardId:             shardId,
+		quorumAckTracker:    nil,
+		rpcClient:           rpcClient,
+		transactionResolver: transactionResolver,
+		followers:           make(map[string]FollowerCursor),
 
 		writeLatencyHisto: metric.NewLatencyHistogram("oxia_server_leader_write_latency",
 			"Latency for write operations in the leader", labels),
@@ -152,6 +195,8 @@ func NewLeaderController(config Config, namespace string, shardId int64, rpcClie
 	lc.ctx, lc.cancel = context.WithCancel(context.Background())
 
 	lc.sessionManager = NewSessionManager(lc.ctx, namespace, shardId, lc)
+	lc.transactionManager = NewTransactionManager(lc.ctx, namespace, shardId, lc, transactionResolver)
+	lc.recordsExpirer = NewRecordsExpirer(lc.ctx, namespace, shardId, lc)
 
 	var err error
 	if lc.wal, err = walFactory.NewWal(namespace, shardId, lc); err != nil {
@@ -171,6 +216,7 @@ func NewLeaderController(config Config, namespace string, shardId int64, rpcClie
 	}
 
 	lc.db.EnableNotifications(lc.termOptions.NotificationsEnabled)
+	lc.db.CaptureNotificationValues(lc.termOptions.NotificationsIncludeValue, lc.termOptions.NotificationsIncludePreviousValue)
 	lc.setLogger()
 	lc.log.Info("Created leader controller")
 	return lc, nil
@@ -255,10 +301,12 @@ func (lc *leaderController) NewTerm(req *proto.NewTermRequest) (*proto.NewTermRe
 	}
 
 	lc.db.EnableNotifications(lc.termOptions.NotificationsEnabled)
+	lc.db.CaptureNotificationValues(lc.termOptions.NotificationsIncludeValue, lc.termOptions.NotificationsIncludePreviousValue)
 	lc.term = req.Term
 	lc.setLogger()
 	lc.status = proto.ServingStatus_FENCED
 	lc.replicationFactor = 0
+	lc.writesRejectedErr = nil
 
 	lc.headOffsetGauge.Unregister()
 	lc.commitOffsetGauge.Unregister()
@@ -286,7 +334,11 @@ func (lc *leaderController) NewTerm(req *proto.NewTermRequest) (*proto.NewTermRe
 		return nil, err
 	}
 
-	err = lc.sessionManager.Close()
+	err = multierr.Combine(
+		lc.sessionManager.Close(),
+		lc.transactionManager.Close(),
+		lc.recordsExpirer.Close(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -356,8 +408,17 @@ func (lc *leaderController) BecomeLeader(ctx context.Context, req *proto.BecomeL
 		return nil, err
 	}
 
+	if lc.leaderElectionHeadEntryId.Offset < leaderCommitOffset {
+		// The DB was loaded from a snapshot (eg: after a shard split) and the
+		// WAL does not contain the entries up to the commit offset. We need
+		// to continue from the commit offset, to avoid reusing older offsets.
+		lc.leaderElectionHeadEntryId = &proto.EntryId{Term: wal.InvalidTerm, Offset: leaderCommitOffset}
+	}
+
 	lc.quorumAckTracker = NewQuorumAckTracker(req.GetReplicationFactor(), lc.leaderElectionHeadEntryId.Offset, leaderCommitOffset)
 	lc.sessionManager = NewSessionManager(lc.ctx, lc.namespace, lc.shardId, lc)
+	lc.transactionManager = NewTransactionManager(lc.ctx, lc.namespace, lc.shardId, lc, lc.transactionResolver)
+	lc.recordsExpirer = NewRecordsExpirer(lc.ctx, lc.namespace, lc.shardId, lc)
 
 	for follower, followerHeadEntryId := range req.FollowerMaps {
 		if err := lc.addFollower(follower, followerHeadEntryId); err != nil { //nolint:contextcheck
@@ -513,6 +574,9 @@ func (lc *leaderController) applyAllEntriesIntoDB() error {
 		)
 		return err
 	}
+
+	lc.transactionManager.Initialize()
+	lc.recordsExpirer.Initialize()
 	return nil
 }
 
@@ -605,37 +669,101 @@ func (lc *leaderController) Read(ctx context.Context, request *proto.ReadRequest
 		cb.OnComplete(err)
 		return
 	}
-	go process.DoWithLabels(
-		ctx,
-		map[string]string{
-			"oxia":  "read",
-			"shard": fmt.Sprintf("%d", lc.shardId),
-			"peer":  rpc.GetPeer(ctx),
-		},
-		func() {
-			lc.log.Debug("Received read request")
-			var response *proto.GetResponse
-			var err error
-
-			for _, get := range request.Gets {
-				if get.SecondaryIndexName != nil {
-					response, err = secondaryIndexGet(get, lc.db)
-				} else {
-					response, err = lc.db.Get(get)
-				}
-				if err != nil {
-					break
-				}
-				if err = cb.OnNext(response); err != nil {
-					break
-				}
-				if err = ctx.Err(); err != nil {
-					break
-				}
-			}
+	if request.Linearizable {
+		if err = lc.confirmLeadership(ctx); err != nil {
 			cb.OnComplete(err)
-		},
-	)
+			return
+		}
+	}
+	readFromDB(ctx, lc.db, lc.shardId, lc.log, request, cb)
+}
+
+type leadershipCheck struct {
+	done chan struct{}
+	err  error
+}
+
+// confirmLeadership blocks until a quorum of the followers has confirmed that
+// this node is still the leader of the shard, by acknowledging a no-op entry.
+// Once a new term is started, the fenced followers reject any entry from the
+// old leader, so a deposed leader will not be able to serve the request.
+//
+// When the no-op entry is committed, all the entries before it were applied
+// into the DB, and the reads will observe all the writes that were acknowledged
+// before the request was received. For the same reason, the requests received
+// before the no-op entry is appended can share the same check. The requests
+// received later wait for the next check, since a write acknowledged in the
+// meantime might come after the no-op entry in the log.
+//
+// Only one check is in flight at any time: the requests received meanwhile
+// are batched into the next check, which starts once the current one is
+// completed. This bounds the number of no-op entries appended into the log
+// to one per quorum round, regardless of the rate of linearizable reads.
+func (lc *leaderController) confirmLeadership(ctx context.Context) error {
+	lc.leadershipCheckMutex.Lock()
+	check := lc.leadershipCheck
+	if check == nil {
+		check = &leadershipCheck{done: make(chan struct{})}
+		lc.leadershipCheck = check
+	}
+	start := !lc.leadershipCheckRunning
+	lc.leadershipCheckRunning = true
+	lc.leadershipCheckMutex.Unlock()
+
+	if start {
+		lc.startLeadershipCheck(check)
+	}
+
+	select {
+	case <-check.done:
+		return check.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (lc *leaderController) startLeadershipCheck(check *leadershipCheck) {
+	complete := func(err error) {
+		check.err = err
+		close(check.done)
+
+		// Start the check of the requests received meanwhile, if any. It
+		// is started in background, since the completion might be invoked
+		// with the controller lock held
+		lc.leadershipCheckMutex.Lock()
+		next := lc.leadershipCheck
+		lc.leadershipCheckRunning = next != nil
+		lc.leadershipCheckMutex.Unlock()
+		if next != nil {
+			go lc.startLeadershipCheck(next)
+		}
+	}
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	// Stop accepting requests into this check before appending the no-op
+	// entry. Since the entries are appended with the lock held, all the
+	// requests that joined the check were received before the append
+	lc.leadershipCheckMutex.Lock()
+	if lc.leadershipCheck == check {
+		lc.leadershipCheck = nil
+	}
+	lc.leadershipCheckMutex.Unlock()
+
+	if err := checkStatusIsLeader(lc.status); err != nil {
+		complete(err)
+		return
+	}
+
+	// The check is not bound to the context of the first request, since it
+	// might be shared with other requests
+	lc.appendEntry(lc.ctx, func(_ int64) *proto.WriteRequest {
+		return &proto.WriteRequest{Shard: &lc.shardId}
+	}, concurrent.NewOnce(
+		func(_ *proto.WriteResponse) { complete(nil) },
+		complete,
+	))
 }
 
 func (lc *leaderController) GetSequenceUpdates(_ context.Context, request *proto.GetSequenceUpdatesRequest) (kv.SequenceWaiter, error) {
@@ -650,7 +778,7 @@ func (lc *leaderController) GetSequenceUpdates(_ context.Context, request *proto
 	return lc.db.GetSequenceUpdates(request.Key)
 }
 
-func (lc *leaderController) List(ctx context.Context, request *proto.ListRequest, cb concurrent.StreamCallback[string]) {
+func (lc *leaderController) List(ctx context.Context, request *proto.ListRequest, cb concurrent.StreamCallback[ListEntry]) {
 	lc.RLock()
 	err := checkStatusIsLeader(lc.status)
 	lc.RUnlock()
@@ -658,57 +786,33 @@ func (lc *leaderController) List(ctx context.Context, request *proto.ListRequest
 		cb.OnComplete(err)
 		return
 	}
+	if request.Linearizable {
+		if err = lc.confirmLeadership(ctx); err != nil {
+			cb.OnComplete(err)
+			return
+		}
+	}
 	lc.list(ctx, request, cb)
 }
 
-func (lc *leaderController) list(ctx context.Context, request *proto.ListRequest, cb concurrent.StreamCallback[string]) {
-	go process.DoWithLabels(
-		ctx,
-		map[string]string{
-			"oxia":  "list",
-			"shard": fmt.Sprintf("%d", lc.shardId),
-			"peer":  rpc.GetPeer(ctx),
-		},
-		func() {
-			lc.log.Debug("Received list request", slog.Any("request", request))
-
-			var it kv.KeyIterator
-			var err error
-
-			if request.SecondaryIndexName != nil {
-				it, err = newSecondaryIndexListIterator(request, lc.db)
-			} else {
-				it, err = lc.db.List(request)
-			}
-			if err != nil {
-				lc.log.Warn(
-					"Failed to process list request",
-					slog.Any("error", err),
-				)
-				cb.OnComplete(err)
-				return
-			}
-
-			for ; it.Valid(); it.Next() {
-				if err = cb.OnNext(it.Key()); err != nil {
-					break
-				}
-				if err = ctx.Err(); err != nil {
-					break
-				}
-			}
-
-			err = multierr.Combine(err, it.Close())
-			cb.OnComplete(err)
-		},
-	)
+func (lc *leaderController) list(ctx context.Context, request *proto.ListRequest, cb concurrent.StreamCallback[ListEntry]) {
+	listFromDB(ctx, lc.db, lc.shardId, lc.log, request, cb)
 }
 
 func (lc *leaderController) ListBlock(ctx context.Context, request *proto.ListRequest) ([]string, error) {
 	// todo: support leader status check without lock
-	ch := make(chan *entity.TWithError[string])
+	ch := make(chan *entity.TWithError[ListEntry])
 	go lc.list(ctx, request, concurrent.ReadFromStreamCallback(ch))
-	return channel.ReadAll(ctx, ch)
+	entries, err := channel.ReadAll(ctx, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(entries))
+	for i, entry := range entries {
+		keys[i] = entry.Key
+	}
+	return keys, nil
 }
 
 func (lc *leaderController) RangeScan(ctx context.Context, request *proto.RangeScanRequest, cb concurrent.StreamCallback[*proto.GetResponse]) {
@@ -719,55 +823,54 @@ func (lc *leaderController) RangeScan(ctx context.Context, request *proto.RangeS
 		cb.OnComplete(err)
 		return
 	}
+	if request.Linearizable {
+		if err = lc.confirmLeadership(ctx); err != nil {
+			cb.OnComplete(err)
+			return
+		}
+	}
+	rangeScanFromDB(ctx, lc.db, lc.shardId, lc.log, request, cb)
+}
 
-	go process.DoWithLabels(ctx,
-		map[string]string{
-			"oxia":  "range-scan",
-			"shard": fmt.Sprintf("%d", lc.shardId),
-			"peer":  rpc.GetPeer(ctx),
-		},
-		func() {
-			lc.log.Debug("Received list request", slog.Any("request", request))
-
-			var it kv.RangeScanIterator
-			var err error
-
-			if request.SecondaryIndexName != nil {
-				it, err = newSecondaryIndexRangeScanIterator(request, lc.db)
-			} else {
-				it, err = lc.db.RangeScan(request)
-			}
-
-			if err != nil {
-				lc.log.Warn("Failed to process range-scan request", slog.Any("error", err))
-				cb.OnComplete(err)
-				return
-			}
-
-			var gr *proto.GetResponse
-			for ; it.Valid(); it.Next() {
-				if gr, err = it.Value(); err != nil {
-					break
-				}
-				if err = cb.OnNext(gr); err != nil {
-					break
-				}
-				if err = ctx.Err(); err != nil {
-					break
-				}
-			}
+func (lc *leaderController) RangeStats(ctx context.Context, request *proto.RangeStatsRequest) (*proto.RangeStatsResponse, error) {
+	lc.RLock()
+	err := checkStatusIsLeader(lc.status)
+	lc.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if request.Linearizable {
+		if err = lc.confirmLeadership(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return rangeStatsFromDB(ctx, lc.db, lc.log, request)
+}
 
-			err = multierr.Combine(err, it.Close())
-			cb.OnComplete(err)
-		},
-	)
+// expiredRecords returns the deletes of the records whose time-to-live has
+// expired. The DB is read under the lock, since it is closed with the leader.
+func (lc *leaderController) expiredRecords(timestamp uint64, maxCount int) ([]*proto.DeleteRequest, error) {
+	lc.RLock()
+	defer lc.RUnlock()
+	if err := checkStatusIsLeader(lc.status); err != nil {
+		return nil, err
+	}
+	return lc.db.ExpiredRecords(timestamp, maxCount)
 }
 
 func (lc *leaderController) WriteBlock(ctx context.Context, request *proto.WriteRequest) (*proto.WriteResponse, error) {
+	if request.Transaction != nil {
+		return nil, constant.ErrInvalidTransaction
+	}
 	return lc.writeBlock(ctx, func(_ int64) *proto.WriteRequest { return request })
 }
 
 func (lc *leaderController) Write(ctx context.Context, request *proto.WriteRequest, cb concurrent.Callback[*proto.WriteResponse]) {
+	if request.Transaction != nil {
+		// The transactions can only be handled through the dedicated rpcs
+		cb.OnCompleteError(constant.ErrInvalidTransaction)
+		return
+	}
 	lc.write(ctx, func(_ int64) *proto.WriteRequest { return request }, cb)
 }
 
@@ -789,13 +892,27 @@ func (lc *leaderController) writeBlock(ctx context.Context, requestSupplier func
 }
 
 func (lc *leaderController) write(ctx context.Context, requestSupplier func(offset int64) *proto.WriteRequest, cb concurrent.Callback[*proto.WriteResponse]) {
-	timer := lc.writeLatencyHisto.Timer()
 	lc.Lock()
 	if err := checkStatusIsLeader(lc.status); err != nil {
 		lc.Unlock()
 		cb.OnCompleteError(err)
 		return
 	}
+	if lc.writesRejectedErr != nil {
+		err := lc.writesRejectedErr
+		lc.Unlock()
+		cb.OnCompleteError(err)
+		return
+	}
+
+	lc.appendEntry(ctx, requestSupplier, cb)
+	lc.Unlock()
+}
+
+// appendEntry appends a write request to the WAL and applies it to the DB once
+// it's committed. It must be called while holding the lock.
+func (lc *leaderController) appendEntry(ctx context.Context, requestSupplier func(offset int64) *proto.WriteRequest, cb concurrent.Callback[*proto.WriteResponse]) {
+	timer := lc.writeLatencyHisto.Timer()
 	newOffset := lc.quorumAckTracker.NextOffset()
 	walLog := lc.wal
 	tracker := lc.quorumAckTracker
@@ -811,7 +928,6 @@ func (lc *leaderController) write(ctx context.Context, requestSupplier func(offs
 	logEntryValue.Value = &proto.LogEntryValue_Requests{Requests: &proto.WriteRequests{Writes: []*proto.WriteRequest{request}}}
 	value, err := logEntryValue.MarshalVT()
 	if err != nil {
-		lc.Unlock()
 		cb.OnCompleteError(err)
 		return
 	}
@@ -838,7 +954,6 @@ func (lc *leaderController) write(ctx context.Context, requestSupplier func(offs
 			}))
 	}
 	walLog.AppendAndSync(&proto.LogEntry{Term: term, Offset: newOffset, Value: value, Timestamp: timestamp}, deferDbWrite)
-	lc.Unlock()
 }
 
 //nolint:revive
@@ -885,6 +1000,8 @@ func (lc *leaderController) GetNotifications(ctx context.Context, req *proto.Not
 		offsetExclusive = commitOffset
 	}
 
+	filter := newNotificationsFilter(req)
+
 	go process.DoWithLabels(
 		ctx,
 		map[string]string{
@@ -916,9 +1033,11 @@ func (lc *leaderController) GetNotifications(ctx context.Context, req *proto.Not
 					if len(notifications) > 0 {
 						for idx := range notifications {
 							notification := notifications[idx]
-							if err := cb.OnNext(notification); err != nil {
-								cb.OnComplete(err)
-								return
+							if filtered := filter.apply(notification); filtered != nil {
+								if err := cb.OnNext(filtered); err != nil {
+									cb.OnComplete(err)
+									return
+								}
 							}
 							offset = notification.Offset
 						}
@@ -956,7 +1075,11 @@ func (lc *leaderController) close() error {
 	}
 	lc.followerAckOffsetGauges = map[string]metric.Gauge{}
 
-	err = lc.sessionManager.Close()
+	err = multierr.Combine(err,
+		lc.sessionManager.Close(),
+		lc.transactionManager.Close(),
+		lc.recordsExpirer.Close(),
+	)
 
 	if lc.wal != nil {
 		err = multierr.Append(err, lc.wal.Close())
@@ -1054,18 +1177,446 @@ func (lc *leaderController) DeleteShard(request *proto.DeleteShardRequest) (*pro
 	return &proto.DeleteShardResponse{}, nil
 }
 
+// SplitShard copies the shard data into the ensembles of the children shards.
+//
+// Each node in the children ensembles first receives a snapshot of the whole
+// DB, while the shard keeps accepting writes. Then, the shard stops accepting
+// writes, and the nodes catch up with the log entries written in the meantime.
+// Only at that point they trim the records outside the hash range of their
+// shard, since the entries must be applied on the same data as in this shard.
+func (lc *leaderController) SplitShard(ctx context.Context, request *proto.SplitShardRequest) (*proto.SplitShardResponse, error) {
+	lc.log.Info(
+		"Splitting shard",
+		slog.Any("children", request.Children),
+	)
+
+	lc.RLock()
+	db := lc.db
+	err := lc.checkTermIsLeader(request.Term)
+	lc.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotOffsets := make([]map[string]int64, len(request.Children))
+	for i, child := range request.Children {
+		snapshotOffsets[i] = make(map[string]int64)
+		for _, node := range child.Ensemble {
+			ackOffset, err := lc.sendShardSnapshot(ctx, db, node, child.Shard, child.Term, nil, false)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to send snapshot to node %s for shard %d", node, child.Shard)
+			}
+			snapshotOffsets[i][node] = ackOffset
+		}
+	}
+
+	offset, err := lc.copyShardData(ctx, request.Term, constant.ErrShardSplitting, func(_ kv.DB, barrierOffset int64) error {
+		for i, child := range request.Children {
+			for _, node := range child.Ensemble {
+				if err := lc.sendShardLogEntries(ctx, node, child.Shard, snapshotOffsets[i][node], barrierOffset,
+					&proto.SnapshotChunk{Term: child.Term, Int32HashRange: child.Int32HashRange}); err != nil {
+					return errors.Wrapf(err, "failed to send log entries to node %s for shard %d", node, child.Shard)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SplitShardResponse{Offset: offset}, nil
+}
+
+// MergeShard copies the shard data into the ensemble of the target shard.
+//
+// The target shard is receiving the data of multiple shards. The data of the
+// first shard replaces any existing data in the target nodes, while the
+// following ones are staged and merged into it.
+//
+// The copy happens in two requests. The first one copies a snapshot of the
+// shard, while it keeps accepting writes. The second one stops accepting
+// writes, and copies the log entries that follow the snapshot.
+func (lc *leaderController) MergeShard(ctx context.Context, request *proto.MergeShardRequest) (*proto.MergeShardResponse, error) {
+	lc.log.Info(
+		"Merging shard",
+		slog.Int64("target-shard", request.TargetShard),
+		slog.Any("target-ensemble", request.TargetEnsemble),
+		slog.Bool("merge", request.Merge),
+		slog.Bool("catch-up", request.CatchUp),
+	)
+
+	if !request.CatchUp {
+		lc.RLock()
+		db := lc.db
+		err := lc.checkTermIsLeader(request.Term)
+		lc.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+
+		res := &proto.MergeShardResponse{TargetOffsets: map[string]int64{}}
+		for _, node := range request.TargetEnsemble {
+			ackOffset, err := lc.sendShardSnapshot(ctx, db, node, request.TargetShard, request.TargetTerm, nil, request.Merge)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to send snapshot to node %s for shard %d", node, request.TargetShard)
+			}
+			res.TargetOffsets[node] = ackOffset
+		}
+		return res, nil
+	}
+
+	offset, err := lc.copyShardData(ctx, request.Term, constant.ErrShardMerging, func(_ kv.DB, barrierOffset int64) error {
+		for _, node := range request.TargetEnsemble {
+			afterOffset, found := request.TargetOffsets[node]
+			if !found {
+				return errors.Errorf("no snapshot was copied to node %s for shard %d", node, request.TargetShard)
+			}
+			if err := lc.sendShardLogEntries(ctx, node, request.TargetShard, afterOffset, barrierOffset,
+				&proto.SnapshotChunk{Term: request.TargetTerm, Merge: request.Merge, MergeShards: request.MergeShards}); err != nil {
+				return errors.Wrapf(err, "failed to send log entries to node %s for shard %d", node, request.TargetShard)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.MergeShardResponse{Offset: offset}, nil
+}
+
+// copyShardData fences the shard from new writes and copies its data into
+// other shards, up to the barrier entry that rejects the writes.
+//
+// From this point, the leader stops accepting new writes, until the shard is
+// either deleted, or the copy is aborted with a new term.
+func (lc *leaderController) copyShardData(ctx context.Context, term int64, writesRejectedErr error,
+	sendData func(db kv.DB, barrierOffset int64) error) (int64, error) {
+	barrierOffset, db, err := lc.rejectWrites(ctx, term, writesRejectedErr)
+	if err == nil {
+		err = sendData(db, barrierOffset)
+	}
+
+	if err != nil {
+		lc.log.Warn(
+			"Failed to copy the shard data",
+			slog.Any("error", err),
+		)
+
+		lc.resumeWrites(term)
+		return wal.InvalidOffset, err
+	}
+
+	lc.log.Info(
+		"Successfully copied the shard data",
+		slog.Int64("offset", barrierOffset),
+	)
+	return barrierOffset, nil
+}
+
+// TransferLeadership prepares the hand-off of the leadership to one of the
+// followers.
+//
+// The follower first catches up with the entries in the log while the leader
+// keeps accepting writes. Then, the leader stops accepting new writes, until
+// the coordinator hands off the leadership in a new term, and waits until the
+// follower has acknowledged the last entries. At that point, the follower can
+// become the leader right away, and no write is lost in the transfer.
+//
+// If the follower doesn't catch up, the leader resumes accepting writes right
+// away. Otherwise, it resumes them if no new term is started within the
+// hand-off timeout, e.g. because the coordinator went away.
+func (lc *leaderController) TransferLeadership(ctx context.Context, request *proto.TransferLeadershipRequest) (*proto.TransferLeadershipResponse, error) {
+	lc.log.Info(
+		"Transferring leadership",
+		slog.String("new-leader", request.NewLeader),
+	)
+
+	lc.RLock()
+	cursor, exist := lc.followers[request.NewLeader]
+	var headOffset int64
+	if exist {
+		headOffset = lc.quorumAckTracker.HeadOffset()
+	}
+	lc.RUnlock()
+	if !exist {
+		return nil, status.Errorf(codes.InvalidArgument, "oxia: node %s is not a follower of shard %d", request.NewLeader, lc.shardId)
+	}
+
+	var barrierOffset int64
+	err := waitForFollowerAck(ctx, cursor, headOffset)
+	if err == nil {
+		barrierOffset, _, err = lc.rejectWrites(ctx, request.Term, constant.ErrLeaderTransferring)
+	}
+	if err == nil {
+		err = waitForFollowerAck(ctx, cursor, barrierOffset)
+	}
+
+	if err != nil {
+		lc.log.Warn(
+			"Failed to transfer the leadership",
+			slog.String("new-leader", request.NewLeader),
+			slog.Any("error", err),
+		)
+
+		lc.resumeWrites(request.Term)
+		return nil, err
+	}
+
+	handOffTimeout := defaultLeadershipHandOffTimeout
+	if request.HandOffTimeoutMs > 0 {
+		handOffTimeout = time.Duration(request.HandOffTimeoutMs) * time.Millisecond
+	}
+	time.AfterFunc(handOffTimeout, func() {
+		if lc.resumeWritesRejectedAt(request.Term, barrierOffset) {
+			lc.log.Warn(
+				"The leadership was not handed off in time, resuming writes",
+				slog.String("new-leader", request.NewLeader),
+				slog.Duration("hand-off-timeout", handOffTimeout),
+			)
+		}
+	})
+
+	lc.log.Info(
+		"The new leader is caught up, ready to transfer the leadership",
+		slog.String("new-leader", request.NewLeader),
+		slog.Int64("offset", barrierOffset),
+	)
+	return &proto.TransferLeadershipResponse{
+		HeadEntryId: &proto.EntryId{Term: request.Term, Offset: barrierOffset},
+	}, nil
+}
+
+func waitForFollowerAck(ctx context.Context, cursor FollowerCursor, offset int64) error {
+	ticker := time.NewTicker(followerAckPollInterval)
+	defer ticker.Stop()
+
+	for cursor.AckOffset() < offset {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// rejectWrites stops accepting new writes in the given term, failing them with
+// the given error.
+//
+// An empty barrier entry is appended to the log, and the function returns once
+// it's committed, to ensure all the previously accepted writes are applied into
+// the DB as well.
+func (lc *leaderController) rejectWrites(ctx context.Context, term int64, writesRejectedErr error) (int64, kv.DB, error) {
+	lc.Lock()
+	if err := lc.checkTermIsLeader(term); err != nil {
+		lc.Unlock()
+		return wal.InvalidOffset, nil, err
+	}
+
+	res := make(chan *entity.TWithError[*proto.WriteResponse], 1)
+	var barrierOffset int64
+	lc.writesRejectedErr = writesRejectedErr
+	lc.appendEntry(ctx, func(offset int64) *proto.WriteRequest {
+		barrierOffset = offset
+		return &proto.WriteRequest{Shard: &lc.shardId}
+	}, concurrent.NewOnce(func(t *proto.WriteResponse) {
+		res <- &entity.TWithError[*proto.WriteResponse]{Err: nil, T: t}
+	}, func(err error) {
+		res <- &entity.TWithError[*proto.WriteResponse]{Err: err, T: nil}
+	}))
+	lc.writesRejectedOffset = barrierOffset
+	db := lc.db
+	lc.Unlock()
+
+	select {
+	case r := <-res:
+		if r.Err != nil {
+			return wal.InvalidOffset, nil, r.Err
+		}
+		return barrierOffset, db, nil
+	case <-ctx.Done():
+		return wal.InvalidOffset, nil, ctx.Err()
+	}
+}
+
+// checkTermIsLeader verifies that we are leading the given term. It must be
+// called with the lock held.
+func (lc *leaderController) checkTermIsLeader(term int64) error {
+	if term != lc.term {
+		return constant.ErrInvalidTerm
+	}
+	return checkStatusIsLeader(lc.status)
+}
+
+// resumeWrites resumes accepting writes, if we are still leading the same term.
+func (lc *leaderController) resumeWrites(term int64) {
+	lc.Lock()
+	defer lc.Unlock()
+	if lc.term == term {
+		lc.writesRejectedErr = nil
+	}
+}
+
+// resumeWritesRejectedAt resumes accepting writes, if we are still leading the
+// same term and the writes were rejected with the barrier entry at the given
+// offset, rather than by a later request.
+func (lc *leaderController) resumeWritesRejectedAt(term int64, barrierOffset int64) bool {
+	lc.Lock()
+	defer lc.Unlock()
+	if lc.term != term || lc.writesRejectedErr == nil || lc.writesRejectedOffset != barrierOffset {
+		return false
+	}
+	lc.writesRejectedErr = nil
+	return true
+}
+
+// sendShardSnapshot sends a snapshot of the DB to a node of another shard, and
+// returns the offset the node is caught up to.
+func (lc *leaderController) sendShardSnapshot(ctx context.Context, db kv.DB, node string, shard int64, term int64,
+	hashRange *proto.Int32HashRange, merge bool) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := lc.rpcClient.SendSnapshot(ctx, node, lc.namespace, shard, term)
+	if err != nil {
+		return wal.InvalidOffset, err
+	}
+
+	snapshot, err := db.Snapshot()
+	if err != nil {
+		return wal.InvalidOffset, err
+	}
+	defer snapshot.Close()
+
+	chunksCount, totalSize, err := sendSnapshotChunks(stream, snapshot, lc.shardId, term, hashRange, merge, lc.log)
+	if err != nil {
+		return wal.InvalidOffset, err
+	}
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return wal.InvalidOffset, err
+	}
+
+	lc.log.Info(
+		"Sent snapshot to target shard node",
+		slog.Int64("target-shard", shard),
+		slog.String("node", node),
+		slog.Int64("chunks-count", chunksCount),
+		slog.String("total-size", humanize.IBytes(uint64(totalSize))),
+		slog.Int64("ack-offset", response.AckOffset),
+	)
+	return response.AckOffset, nil
+}
+
+// sendShardLogEntries sends the log entries after the given offset, up to the
+// barrier offset, to a node of another shard that installed a snapshot of the
+// DB at that offset. The header holds the fields set in all the chunks, such
+// as the term of the other shard, or the hash range the node then retains.
+func (lc *leaderController) sendShardLogEntries(ctx context.Context, node string, shard int64,
+	afterOffset int64, barrierOffset int64, header *proto.SnapshotChunk) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := lc.rpcClient.SendSnapshot(ctx, node, lc.namespace, shard, header.Term)
+	if err != nil {
+		return err
+	}
+
+	reader, err := lc.wal.NewReader(afterOffset)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	newChunk := func() *proto.SnapshotChunk {
+		chunk := header.CloneVT()
+		chunk.CatchUp = true
+		chunk.SourceShard = lc.shardId
+		return chunk
+	}
+
+	chunk := newChunk()
+	var chunkSize, entriesCount int64
+	for offset := afterOffset; offset < barrierOffset; {
+		if !reader.HasNext() {
+			return errors.Errorf("log entries up to offset %d are not available", barrierOffset)
+		}
+		entry, err := reader.ReadNext()
+		if err != nil {
+			return err
+		}
+
+		offset = entry.Offset
+		chunk.Entries = append(chunk.Entries, entry)
+		chunkSize += int64(len(entry.Value))
+		entriesCount++
+		if chunkSize >= kv.MaxSnapshotChunkSize && offset < barrierOffset {
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			chunk = newChunk()
+			chunkSize = 0
+		}
+	}
+
+	// The last chunk is always sent, to carry the header
+	if err := stream.Send(chunk); err != nil {
+		return err
+	}
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	if response.AckOffset != barrierOffset {
+		return errors.Errorf("node is caught up to offset %d rather than %d", response.AckOffset, barrierOffset)
+	}
+
+	lc.log.Info(
+		"Sent log entries to target shard node",
+		slog.Int64("target-shard", shard),
+		slog.String("node", node),
+		slog.Int64("entries-count", entriesCount),
+		slog.Int64("ack-offset", response.AckOffset),
+	)
+	return nil
+}
+
 func (lc *leaderController) CreateSession(request *proto.CreateSessionRequest) (*proto.CreateSessionResponse, error) {
 	return lc.sessionManager.CreateSession(request)
 }
 
-func (lc *leaderController) KeepAlive(sessionId int64) error {
-	return lc.sessionManager.KeepAlive(sessionId)
+func (lc *leaderController) KeepAlive(sessionId int64, sourceShard *int64) (int64, error) {
+	return lc.sessionManager.KeepAlive(sessionId, sourceShard)
 }
 
 func (lc *leaderController) CloseSession(request *proto.CloseSessionRequest) (*proto.CloseSessionResponse, error) {
 	return lc.sessionManager.CloseSession(request)
 }
 
+func (lc *leaderController) ListSessions(request *proto.ListShardSessionsRequest) (*proto.ListShardSessionsResponse, error) {
+	return lc.sessionManager.ListSessions(request)
+}
+
+func (lc *leaderController) RevokeSession(request *proto.RevokeShardSessionRequest) (*proto.RevokeShardSessionResponse, error) {
+	return lc.sessionManager.RevokeSession(request)
+}
+
+func (lc *leaderController) PrepareTransaction(ctx context.Context, request *proto.PrepareTransactionRequest) (*proto.TransactionResponse, error) {
+	return lc.transactionManager.PrepareTransaction(ctx, request)
+}
+
+func (lc *leaderController) CommitTransaction(ctx context.Context, request *proto.CommitTransactionRequest) (*proto.TransactionResponse, error) {
+	return lc.transactionManager.CommitTransaction(ctx, request)
+}
+
+func (lc *leaderController) AbortTransaction(ctx context.Context, request *proto.AbortTransactionRequest) (*proto.TransactionResponse, error) {
+	return lc.transactionManager.AbortTransaction(ctx, request)
+}
+
 func checkStatusIsLeader(actual proto.ServingStatus) error {
 	if actual != proto.ServingStatus_LEADER {
 		return status.Errorf(constant.CodeInvalidStatus, "Received message in the wrong state. In %+v, should be %+v.", actual, proto.ServingStatus_LEADER)
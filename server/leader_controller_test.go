@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	pb "google.golang.org/protobuf/proto"
@@ -60,7 +61,7 @@ func TestLeaderController_NotInitialized(t *testing.T) {
 	assert.NoError(t, err)
 	walFactory := newTestWalFactory(t)
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	assert.EqualValues(t, wal.InvalidTerm, lc.Term())
@@ -97,7 +98,7 @@ func TestLeaderController_Closed(t *testing.T) {
 	assert.NoError(t, err)
 	walFactory := newTestWalFactory(t)
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	assert.EqualValues(t, wal.InvalidTerm, lc.Term())
@@ -133,7 +134,7 @@ func TestLeaderController_BecomeLeader_NoFencing(t *testing.T) {
 	assert.NoError(t, err)
 	walFactory := newTestWalFactory(t)
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	assert.EqualValues(t, wal.InvalidTerm, lc.Term())
@@ -159,7 +160,7 @@ func TestLeaderController_BecomeLeader_RF1(t *testing.T) {
 	assert.NoError(t, err)
 	walFactory := newTestWalFactory(t)
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	assert.EqualValues(t, wal.InvalidTerm, lc.Term())
@@ -259,7 +260,7 @@ func TestLeaderController_BecomeLeader_RF2(t *testing.T) {
 
 	rpc := newMockRpcClient()
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, rpc, walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, rpc, nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	assert.EqualValues(t, wal.InvalidTerm, lc.Term())
@@ -359,6 +360,128 @@ func TestLeaderController_BecomeLeader_RF2(t *testing.T) {
 	assert.NoError(t, walFactory.Close())
 }
 
+func TestLeaderController_LinearizableRead(t *testing.T) {
+	var shard int64 = 1
+
+	kvFactory, err := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	walFactory := newTestWalFactory(t)
+
+	rpc := newMockRpcClient()
+
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, rpc, nil, walFactory, kvFactory)
+	assert.NoError(t, err)
+
+	_, err = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
+	assert.NoError(t, err)
+	_, err = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
+		Shard:             shard,
+		Term:              1,
+		ReplicationFactor: 2,
+		FollowerMaps: map[string]*proto.EntryId{
+			"f1": InvalidEntryId,
+		},
+	})
+	assert.NoError(t, err)
+
+	go func() {
+		req := <-rpc.appendReqs
+		rpc.ackResps <- &proto.Ack{Offset: req.Entry.Offset}
+	}()
+
+	_, err = lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shard,
+		Puts:  []*proto.PutRequest{{Key: "a", Value: []byte("value-a")}},
+	})
+	assert.NoError(t, err)
+
+	// The read is served only after the follower has confirmed the leadership
+	responses := make(chan *oentity.TWithError[*proto.GetResponse], 1000)
+	go lc.Read(context.Background(), &proto.ReadRequest{
+		Shard:        &shard,
+		Gets:         []*proto.GetRequest{{Key: "a", IncludeValue: true}},
+		Linearizable: true,
+	}, concurrent.ReadFromStreamCallback(responses))
+
+	req := <-rpc.appendReqs
+	assert.EqualValues(t, 1, req.Entry.Offset)
+	assert.Empty(t, responses)
+
+	// The reads received after the no-op entry was appended wait for the
+	// next check, which is shared by all of them
+	lateResponses := make([]chan *oentity.TWithError[*proto.GetResponse], 5)
+	for i := range lateResponses {
+		lateResponses[i] = make(chan *oentity.TWithError[*proto.GetResponse], 1000)
+		go lc.Read(context.Background(), &proto.ReadRequest{
+			Shard:        &shard,
+			Gets:         []*proto.GetRequest{{Key: "a", IncludeValue: true}},
+			Linearizable: true,
+		}, concurrent.ReadFromStreamCallback(lateResponses[i]))
+	}
+
+	// The next check is not started before the current one is completed
+	select {
+	case r := <-rpc.appendReqs:
+		assert.Failf(t, "unexpected append", "%+v", r)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	rpc.ackResps <- &proto.Ack{Offset: req.Entry.Offset}
+
+	results, err := channel.ReadAll[*proto.GetResponse](context.Background(), responses)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, proto.Status_OK, results[0].Status)
+	assert.Equal(t, []byte("value-a"), results[0].Value)
+
+	lateReq := <-rpc.appendReqs
+	assert.EqualValues(t, 2, lateReq.Entry.Offset)
+	for _, ch := range lateResponses {
+		assert.Empty(t, ch)
+	}
+
+	rpc.ackResps <- &proto.Ack{Offset: lateReq.Entry.Offset}
+
+	for _, ch := range lateResponses {
+		results, err = channel.ReadAll[*proto.GetResponse](context.Background(), ch)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, []byte("value-a"), results[0].Value)
+	}
+
+	// Without the confirmation, the read is not served
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	responses = make(chan *oentity.TWithError[*proto.GetResponse], 1000)
+	lc.Read(ctx, &proto.ReadRequest{
+		Shard:        &shard,
+		Gets:         []*proto.GetRequest{{Key: "a", IncludeValue: true}},
+		Linearizable: true,
+	}, concurrent.ReadFromStreamCallback(responses))
+
+	_, err = channel.ReadAll[*proto.GetResponse](context.Background(), responses)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// A fenced leader rejects the reads
+	_, err = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 2})
+	assert.NoError(t, err)
+
+	responses = make(chan *oentity.TWithError[*proto.GetResponse], 1000)
+	lc.Read(context.Background(), &proto.ReadRequest{
+		Shard:        &shard,
+		Gets:         []*proto.GetRequest{{Key: "a"}},
+		Linearizable: true,
+	}, concurrent.ReadFromStreamCallback(responses))
+
+	_, err = channel.ReadAll[*proto.GetResponse](context.Background(), responses)
+	assert.Equal(t, constant.CodeInvalidStatus, status.Code(err))
+
+	close(rpc.ackResps)
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvFactory.Close())
+	assert.NoError(t, walFactory.Close())
+}
+
 func TestLeaderController_TermPersistent(t *testing.T) {
 	var shard int64 = 1
 
@@ -368,7 +491,7 @@ func TestLeaderController_TermPersistent(t *testing.T) {
 		BaseWalDir: t.TempDir(),
 	})
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	assert.EqualValues(t, wal.InvalidTerm, lc.Term())
@@ -389,7 +512,7 @@ func TestLeaderController_TermPersistent(t *testing.T) {
 	assert.NoError(t, lc.Close())
 
 	// Re-open lead controller
-	lc, err = NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err = NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	assert.EqualValues(t, 5, lc.Term())
@@ -415,7 +538,7 @@ func TestLeaderController_FenceTerm(t *testing.T) {
 	assert.NoError(t, db.UpdateTerm(5, kv.TermOptions{}))
 	assert.NoError(t, db.Close())
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	assert.EqualValues(t, 5, lc.Term())
@@ -459,7 +582,7 @@ func TestLeaderController_BecomeLeaderTerm(t *testing.T) {
 	assert.NoError(t, db.UpdateTerm(5, kv.TermOptions{}))
 	assert.NoError(t, db.Close())
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	assert.EqualValues(t, 5, lc.Term())
@@ -506,7 +629,7 @@ func TestLeaderController_AddFollower(t *testing.T) {
 	assert.NoError(t, err)
 	walFactory := newTestWalFactory(t)
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	_, err = lc.NewTerm(&proto.NewTermRequest{
@@ -569,7 +692,7 @@ func TestLeaderController_AddFollowerRepeated(t *testing.T) {
 	assert.NoError(t, err)
 	walFactory := newTestWalFactory(t)
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	_, err = lc.NewTerm(&proto.NewTermRequest{
@@ -657,7 +780,7 @@ func TestLeaderController_AddFollower_Truncate(t *testing.T) {
 
 	rpcClient := newMockRpcClient()
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, rpcClient, walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, rpcClient, nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	_, err = lc.NewTerm(&proto.NewTermRequest{
@@ -737,7 +860,7 @@ func TestLeaderController_AddFollowerCheckTerm(t *testing.T) {
 	assert.NoError(t, err)
 	walFactory := newTestWalFactory(t)
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	_, err = lc.NewTerm(&proto.NewTermRequest{
@@ -810,7 +933,7 @@ func TestLeaderController_EntryVisibilityAfterBecomingLeader(t *testing.T) {
 
 	rpc := newMockRpcClient()
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, rpc, walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, rpc, nil, walFactory, kvFactory)
 
 	_, _ = lc.NewTerm(&proto.NewTermRequest{
 		Shard: shard,
@@ -861,7 +984,7 @@ func TestLeaderController_Notifications(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -906,13 +1029,64 @@ func TestLeaderController_Notifications(t *testing.T) {
 	assert.NoError(t, walFactory.Close())
 }
 
+func TestLeaderController_NotificationsFiltered(t *testing.T) {
+	var shard int64 = 1
+
+	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	walFactory := newTestWalFactory(t)
+
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
+	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
+	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
+		Shard:             shard,
+		Term:              1,
+		ReplicationFactor: 1,
+		FollowerMaps:      nil,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	prefix := "/jobs/"
+	adaptor := concurrent.NewStreamCallbackAdaptor[*proto.NotificationBatch]()
+	lc.GetNotifications(ctx, &proto.NotificationsRequest{
+		Shard:                shard,
+		StartOffsetExclusive: &wal.InvalidOffset,
+		KeyPrefix:            &prefix,
+	}, adaptor)
+
+	for _, key := range []string{"/other/a", "/jobs/a", "/other/b", "/jobs/b"} {
+		_, _ = lc.WriteBlock(context.Background(), &proto.WriteRequest{
+			Shard: &shard,
+			Puts: []*proto.PutRequest{{
+				Key:   key,
+				Value: []byte("value")}},
+		})
+	}
+
+	// The batches without matching notifications are skipped
+	nb1 := <-adaptor.Ch()
+	assert.EqualValues(t, 1, nb1.Offset)
+	assert.Equal(t, 1, len(nb1.Notifications))
+	assert.Contains(t, nb1.Notifications, "/jobs/a")
+
+	nb2 := <-adaptor.Ch()
+	assert.EqualValues(t, 3, nb2.Offset)
+	assert.Equal(t, 1, len(nb2.Notifications))
+	assert.Contains(t, nb2.Notifications, "/jobs/b")
+
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvFactory.Close())
+	assert.NoError(t, walFactory.Close())
+}
+
 func TestLeaderController_NotificationsCloseLeader(t *testing.T) {
 	var shard int64 = 1
 
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -948,7 +1122,7 @@ func TestLeaderController_NotificationsWhenNotReady(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -969,7 +1143,7 @@ func TestLeaderController_List(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -1012,7 +1186,7 @@ func TestLeaderController_RangeScan(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -1067,7 +1241,7 @@ func TestLeaderController_DeleteShard(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -1091,7 +1265,7 @@ func TestLeaderController_DeleteShard(t *testing.T) {
 
 	assert.NoError(t, lc.Close())
 
-	lc, _ = NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ = NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 2})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -1122,7 +1296,7 @@ func TestLeaderController_DeleteShard_WrongTerm(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -1154,7 +1328,7 @@ func TestLeaderController_GetStatus(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 2})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -1198,7 +1372,7 @@ func TestLeaderController_Write(t *testing.T) {
 	assert.NoError(t, err)
 	walFactory := newTestWalFactory(t)
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	_, err = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
@@ -1289,7 +1463,7 @@ func TestLeaderController_NotificationsDisabled(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1, Options: &proto.NewTermOptions{EnableNotifications: false}})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -1316,7 +1490,7 @@ func TestLeaderController_DuplicateNewTerm_WithSession(t *testing.T) {
 	assert.NoError(t, err)
 	walFactory := newTestWalFactory(t)
 
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 
 	_, err = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
@@ -1393,7 +1567,7 @@ func TestLeaderController_GetSequenceUpdates(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -1452,7 +1626,7 @@ func TestLeaderController_ConcurrentWrite(t *testing.T) {
 	walFactory := newTestWalFactory(t)
 	defer walFactory.Close()
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	defer lc.Close()
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
@@ -1481,3 +1655,173 @@ func TestLeaderController_ConcurrentWrite(t *testing.T) {
 		assert.NoError(t, <-errors)
 	}
 }
+
+func TestLeaderController_RangeStats(t *testing.T) {
+	var shard int64 = 1
+
+	kvFactory, err := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	walFactory := newTestWalFactory(t)
+
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
+	assert.NoError(t, err)
+
+	_, err = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
+	assert.NoError(t, err)
+	_, err = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
+		Shard:             shard,
+		Term:              1,
+		ReplicationFactor: 1,
+		FollowerMaps:      nil,
+	})
+	assert.NoError(t, err)
+
+	_, err = lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shard,
+		Puts: []*proto.PutRequest{
+			{Key: "/a", Value: []byte("1"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "3"}}},
+			{Key: "/b", Value: []byte("22"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "2"}}},
+			{Key: "/c", Value: []byte("333"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "1"}}},
+			{Key: "/c/d", Value: []byte("4444")},
+		},
+	})
+	assert.NoError(t, err)
+
+	res, err := lc.RangeStats(context.Background(), &proto.RangeStatsRequest{
+		Shard:          &shard,
+		StartInclusive: "/",
+		EndExclusive:   "//",
+	})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, res.Count)
+	assert.EqualValues(t, 6, res.TotalValueSize)
+	assert.Equal(t, "/a", res.GetMinKey())
+	assert.Equal(t, "/c", res.GetMaxKey())
+
+	res, err = lc.RangeStats(context.Background(), &proto.RangeStatsRequest{
+		Shard:              &shard,
+		StartInclusive:     "2",
+		EndExclusive:       "9",
+		SecondaryIndexName: pb.String("idx"),
+	})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, res.Count)
+	assert.EqualValues(t, 3, res.TotalValueSize)
+	assert.Equal(t, "/a", res.GetMinKey())
+	assert.Equal(t, "/b", res.GetMaxKey())
+
+	res, err = lc.RangeStats(context.Background(), &proto.RangeStatsRequest{
+		Shard:          &shard,
+		StartInclusive: "/x",
+		EndExclusive:   "/y",
+	})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, res.Count)
+	assert.Nil(t, res.MinKey)
+	assert.Nil(t, res.MaxKey)
+
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvFactory.Close())
+	assert.NoError(t, walFactory.Close())
+}
+
+func TestLeaderController_TransferLeadership(t *testing.T) {
+	var shard int64 = 1
+
+	kvFactory, err := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	walFactory := newTestWalFactory(t)
+
+	rpc := newMockRpcClient()
+
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, rpc, nil, walFactory, kvFactory)
+	assert.NoError(t, err)
+
+	_, err = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
+	assert.NoError(t, err)
+
+	_, err = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
+		Shard:             shard,
+		Term:              1,
+		ReplicationFactor: 2,
+		FollowerMaps: map[string]*proto.EntryId{
+			"f1": InvalidEntryId,
+		},
+	})
+	assert.NoError(t, err)
+
+	write := func() (*proto.WriteResponse, error) {
+		return lc.WriteBlock(context.Background(), &proto.WriteRequest{
+			Shard: &shard,
+			Puts:  []*proto.PutRequest{{Key: "a", Value: []byte("value-a")}},
+		})
+	}
+
+	// The new leader must be a follower, in the current term
+	_, err = lc.TransferLeadership(context.Background(), &proto.TransferLeadershipRequest{
+		Shard: shard, Term: 1, NewLeader: "f2",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = lc.TransferLeadership(context.Background(), &proto.TransferLeadershipRequest{
+		Shard: shard, Term: 2, NewLeader: "f1",
+	})
+	assert.ErrorIs(t, err, constant.ErrInvalidTerm)
+
+	// The follower is not acknowledging the entries, the writes are resumed
+	// once the transfer fails
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	_, err = lc.TransferLeadership(ctx, &proto.TransferLeadershipRequest{
+		Shard: shard, Term: 1, NewLeader: "f1",
+	})
+	cancel()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	<-rpc.appendReqs
+
+	go func() {
+		for req := range rpc.appendReqs {
+			rpc.ackResps <- &proto.Ack{Offset: req.Entry.Offset}
+		}
+	}()
+
+	res, err := write()
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, res.Puts[0].Status)
+
+	// Once the follower has all the entries, the leader is ready to hand off
+	// the leadership and keeps rejecting the writes, until the hand-off
+	// timeout expires
+	tr, err := lc.TransferLeadership(context.Background(), &proto.TransferLeadershipRequest{
+		Shard: shard, Term: 1, NewLeader: "f1", HandOffTimeoutMs: 200,
+	})
+	assert.NoError(t, err)
+	AssertProtoEqual(t, &proto.EntryId{Term: 1, Offset: 2}, tr.HeadEntryId)
+
+	_, err = write()
+	assert.Equal(t, constant.CodeLeaderTransferring, status.Code(err))
+
+	assert.Eventually(t, func() bool {
+		res, err = write()
+		return err == nil && res.Puts[0].Status == proto.Status_OK
+	}, 10*time.Second, 20*time.Millisecond)
+
+	tr, err = lc.TransferLeadership(context.Background(), &proto.TransferLeadershipRequest{
+		Shard: shard, Term: 1, NewLeader: "f1",
+	})
+	assert.NoError(t, err)
+	AssertProtoEqual(t, &proto.EntryId{Term: 1, Offset: 4}, tr.HeadEntryId)
+
+	_, err = write()
+	assert.Equal(t, constant.CodeLeaderTransferring, status.Code(err))
+
+	// The new term elects the new leader
+	fr, err := lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 2})
+	assert.NoError(t, err)
+	AssertProtoEqual(t, tr.HeadEntryId, fr.HeadEntryId)
+
+	assert.NoError(t, lc.Close())
+	close(rpc.appendReqs)
+	close(rpc.ackResps)
+	assert.NoError(t, kvFactory.Close())
+	assert.NoError(t, walFactory.Close())
+}
@@ -0,0 +1,104 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"strings"
+
+	"github.com/oxia-db/oxia/common/compare"
+	"github.com/oxia-db/oxia/proto"
+)
+
+// notificationsFilter selects the notifications to send to a subscriber,
+// following the filters of its request, and strips the captured values
+// unless they were requested.
+type notificationsFilter struct {
+	keyPrefix         *string
+	keyStartInclusive *string
+	keyEndExclusive   *string
+	types             map[proto.NotificationType]bool
+	includeValues     bool
+}
+
+// newNotificationsFilter returns the filter of the request, or nil if it
+// sends all the notifications as they are stored.
+func newNotificationsFilter(req *proto.NotificationsRequest) *notificationsFilter {
+	if req.KeyPrefix == nil && req.KeyStartInclusive == nil && req.KeyEndExclusive == nil && len(req.Types) == 0 &&
+		req.IncludeValues {
+		return nil
+	}
+
+	f := &notificationsFilter{
+		keyPrefix:         req.KeyPrefix,
+		keyStartInclusive: req.KeyStartInclusive,
+		keyEndExclusive:   req.KeyEndExclusive,
+		includeValues:     req.IncludeValues,
+	}
+	if len(req.Types) > 0 {
+		f.types = make(map[proto.NotificationType]bool, len(req.Types))
+		for _, t := range req.Types {
+			f.types[t] = true
+		}
+	}
+	return f
+}
+
+// apply returns the batch with only the matching notifications, or nil if
+// none of them matches.
+func (f *notificationsFilter) apply(batch *proto.NotificationBatch) *proto.NotificationBatch {
+	if f == nil {
+		return batch
+	}
+
+	notifications := make(map[string]*proto.Notification)
+	for key, notification := range batch.Notifications {
+		if !f.matches(key, notification) {
+			continue
+		}
+		if !f.includeValues && (notification.Value != nil || notification.PreviousValue != nil) {
+			notification = &proto.Notification{
+				Type:         notification.Type,
+				VersionId:    notification.VersionId,
+				KeyRangeLast: notification.KeyRangeLast,
+			}
+		}
+		notifications[key] = notification
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+	return &proto.NotificationBatch{
+		Shard:         batch.Shard,
+		Offset:        batch.Offset,
+		Timestamp:     batch.Timestamp,
+		Notifications: notifications,
+	}
+}
+
+func (f *notificationsFilter) matches(key string, notification *proto.Notification) bool {
+	if f.types != nil && !f.types[notification.Type] {
+		return false
+	}
+
+	if notification.Type == proto.NotificationType_KEY_RANGE_DELETED {
+		// The deleted range overlaps the filter range
+		return (f.keyEndExclusive == nil || compare.CompareWithSlash([]byte(key), []byte(*f.keyEndExclusive)) < 0) &&
+			(f.keyStartInclusive == nil || compare.CompareWithSlash([]byte(*f.keyStartInclusive), []byte(notification.GetKeyRangeLast())) < 0)
+	}
+
+	return (f.keyPrefix == nil || strings.HasPrefix(key, *f.keyPrefix)) &&
+		(f.keyStartInclusive == nil || compare.CompareWithSlash([]byte(key), []byte(*f.keyStartInclusive)) >= 0) &&
+		(f.keyEndExclusive == nil || compare.CompareWithSlash([]byte(key), []byte(*f.keyEndExclusive)) < 0)
+}
@@ -0,0 +1,107 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/proto"
+)
+
+func TestNotificationsFilter(t *testing.T) {
+	batch := &proto.NotificationBatch{
+		Shard:  1,
+		Offset: 5,
+		Notifications: map[string]*proto.Notification{
+			"/jobs/a":     {Type: proto.NotificationType_KEY_CREATED},
+			"/jobs/b":     {Type: proto.NotificationType_KEY_MODIFIED},
+			"/jobs/a/sub": {Type: proto.NotificationType_KEY_DELETED},
+			"/other":      {Type: proto.NotificationType_KEY_CREATED},
+			"/x":          {Type: proto.NotificationType_KEY_RANGE_DELETED, KeyRangeLast: pb.String("/y")},
+		},
+	}
+
+	for _, test := range []struct {
+		name     string
+		req      *proto.NotificationsRequest
+		expected []string
+	}{
+		{"no filter", &proto.NotificationsRequest{},
+			[]string{"/jobs/a", "/jobs/b", "/jobs/a/sub", "/other", "/x"}},
+		{"prefix", &proto.NotificationsRequest{KeyPrefix: pb.String("/jobs/")},
+			[]string{"/jobs/a", "/jobs/b", "/jobs/a/sub", "/x"}},
+		{"range", &proto.NotificationsRequest{KeyStartInclusive: pb.String("/jobs/a"), KeyEndExclusive: pb.String("/jobs/b")},
+			[]string{"/jobs/a"}},
+		{"range overlapping the range deletion", &proto.NotificationsRequest{KeyStartInclusive: pb.String("/w"), KeyEndExclusive: pb.String("/xa")},
+			[]string{"/x"}},
+		{"range after the range deletion", &proto.NotificationsRequest{KeyStartInclusive: pb.String("/y"), KeyEndExclusive: pb.String("/z")},
+			[]string{}},
+		{"types", &proto.NotificationsRequest{Types: []proto.NotificationType{
+			proto.NotificationType_KEY_CREATED, proto.NotificationType_KEY_DELETED,
+		}}, []string{"/jobs/a", "/jobs/a/sub", "/other"}},
+		{"prefix and types", &proto.NotificationsRequest{
+			KeyPrefix: pb.String("/jobs/"),
+			Types:     []proto.NotificationType{proto.NotificationType_KEY_CREATED},
+		}, []string{"/jobs/a"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			filtered := newNotificationsFilter(test.req).apply(batch)
+			if len(test.expected) == 0 {
+				assert.Nil(t, filtered)
+				return
+			}
+			assert.EqualValues(t, 1, filtered.Shard)
+			assert.EqualValues(t, 5, filtered.Offset)
+			keys := make([]string, 0, len(filtered.Notifications))
+			for key := range filtered.Notifications {
+				keys = append(keys, key)
+			}
+			assert.ElementsMatch(t, test.expected, keys)
+		})
+	}
+}
+
+func TestNotificationsFilterValues(t *testing.T) {
+	batch := &proto.NotificationBatch{
+		Shard:  1,
+		Offset: 5,
+		Notifications: map[string]*proto.Notification{
+			"/a": {Type: proto.NotificationType_KEY_MODIFIED, VersionId: pb.Int64(3), Value: []byte("1"), PreviousValue: []byte("0")},
+			"/b": {Type: proto.NotificationType_KEY_DELETED, PreviousValue: []byte("0")},
+		},
+	}
+
+	assert.Same(t, batch, newNotificationsFilter(&proto.NotificationsRequest{IncludeValues: true}).apply(batch))
+
+	filtered := newNotificationsFilter(&proto.NotificationsRequest{}).apply(batch)
+	assert.Len(t, filtered.Notifications, 2)
+	assert.Equal(t, proto.NotificationType_KEY_MODIFIED, filtered.Notifications["/a"].Type)
+	assert.EqualValues(t, 3, filtered.Notifications["/a"].GetVersionId())
+	for _, n := range filtered.Notifications {
+		assert.Nil(t, n.Value)
+		assert.Nil(t, n.PreviousValue)
+	}
+
+	// The stored batch is not modified
+	assert.Equal(t, []byte("1"), batch.Notifications["/a"].Value)
+
+	filtered = newNotificationsFilter(&proto.NotificationsRequest{KeyPrefix: pb.String("/a"), IncludeValues: true}).apply(batch)
+	assert.Len(t, filtered.Notifications, 1)
+	assert.Equal(t, []byte("1"), filtered.Notifications["/a"].Value)
+	assert.Equal(t, []byte("0"), filtered.Notifications["/a"].PreviousValue)
+}
@@ -214,7 +214,7 @@ func (s *publicRpcServer) Read(request *proto.ReadRequest, stream proto.OxiaClie
 		slog.Any("req", request),
 	)
 
-	lc, err := s.getLeader(request.Shard)
+	reader, err := s.getReader(request.Shard, request.MaxStalenessMs, request.Linearizable)
 	if err != nil {
 		return err
 	}
@@ -222,7 +222,7 @@ func (s *publicRpcServer) Read(request *proto.ReadRequest, stream proto.OxiaClie
 	ctx := stream.Context()
 
 	finish := make(chan error, 1)
-	lc.Read(stream.Context(), request, concurrent.NewBatchStreamOnce[*proto.GetResponse](maxTotalReadCount, maxTotalReadValueSize,
+	reader.Read(stream.Context(), request, concurrent.NewBatchStreamOnce[*proto.GetResponse](maxTotalReadCount, maxTotalReadValueSize,
 		func(result *proto.GetResponse) int { return protowire.SizeBytes(len(result.Value)) },
 		func(container []*proto.GetResponse) error { return stream.Send(&proto.ReadResponse{Gets: container}) },
 		func(err error) { finish <- err },
@@ -250,15 +250,20 @@ func (s *publicRpcServer) List(request *proto.ListRequest, stream proto.OxiaClie
 		slog.String("peer", rpc.GetPeer(stream.Context())),
 		slog.Any("req", request),
 	)
-	lc, err := s.getLeader(request.Shard)
+	reader, err := s.getReader(request.Shard, request.MaxStalenessMs, request.Linearizable)
 	if err != nil {
 		return err
 	}
 	ctx := stream.Context()
 	finish := make(chan error, 1)
-	lc.List(ctx, request, concurrent.NewBatchStreamOnce[string](maxTotalListKeyCount, maxTotalListKeySize,
-		func(key string) int { return protowire.SizeBytes(len(key)) },
-		func(container []string) error { return stream.Send(&proto.ListResponse{Keys: container}) },
+	reader.List(ctx, request, concurrent.NewBatchStreamOnce[ListEntry](maxTotalListKeyCount, maxTotalListKeySize,
+		func(entry ListEntry) int {
+			if entry.SecondaryKey != nil {
+				return protowire.SizeBytes(len(entry.Key)) + protowire.SizeBytes(len(*entry.SecondaryKey))
+			}
+			return protowire.SizeBytes(len(entry.Key))
+		},
+		func(container []ListEntry) error { return stream.Send(toListResponse(container)) },
 		func(err error) { finish <- err },
 	))
 	for {
@@ -277,6 +282,17 @@ func (s *publicRpcServer) List(request *proto.ListRequest, stream proto.OxiaClie
 	}
 }
 
+func toListResponse(entries []ListEntry) *proto.ListResponse {
+	res := &proto.ListResponse{Keys: make([]string, len(entries))}
+	for i, entry := range entries {
+		res.Keys[i] = entry.Key
+		if entry.SecondaryKey != nil {
+			res.SecondaryIndexKeys = append(res.SecondaryIndexKeys, *entry.SecondaryKey)
+		}
+	}
+	return res
+}
+
 func (s *publicRpcServer) RangeScan(request *proto.RangeScanRequest, stream proto.OxiaClient_RangeScanServer) error {
 	s.log.Debug(
 		"RangeScan request",
@@ -285,14 +301,14 @@ func (s *publicRpcServer) RangeScan(request *proto.RangeScanRequest, stream prot
 	)
 	ctx := stream.Context()
 
-	var lc LeaderController
+	var reader ShardReader
 	var err error
-	if lc, err = s.getLeader(request.Shard); err != nil {
+	if reader, err = s.getReader(request.Shard, request.MaxStalenessMs, request.Linearizable); err != nil {
 		return err
 	}
 
 	finish := make(chan error, 1)
-	lc.RangeScan(ctx, request,
+	reader.RangeScan(ctx, request,
 		concurrent.NewBatchStreamOnce[*proto.GetResponse](maxTotalScanBatchCount, maxTotalReadValueSize,
 			func(response *proto.GetResponse) int { return len(response.Value) },
 			func(container []*proto.GetResponse) error {
@@ -320,6 +336,28 @@ func (s *publicRpcServer) RangeScan(request *proto.RangeScanRequest, stream prot
 	}
 }
 
+func (s *publicRpcServer) RangeStats(ctx context.Context, request *proto.RangeStatsRequest) (*proto.RangeStatsResponse, error) {
+	s.log.Debug(
+		"RangeStats request",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", request),
+	)
+
+	reader, err := s.getReader(request.Shard, request.MaxStalenessMs, request.Linearizable)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := reader.RangeStats(ctx, request)
+	if err != nil {
+		s.log.Warn(
+			"Failed to perform range-stats operation",
+			slog.Any("error", err),
+		)
+	}
+	return res, err
+}
+
 func (s *publicRpcServer) GetNotifications(req *proto.NotificationsRequest, stream proto.OxiaClient_GetNotificationsServer) error {
 	s.log.Debug(
 		"Get notifications",
@@ -394,7 +432,7 @@ func (s *publicRpcServer) KeepAlive(ctx context.Context, req *proto.SessionHeart
 	if err != nil {
 		return nil, err
 	}
-	err = lc.KeepAlive(req.SessionId)
+	sessionId, err := lc.KeepAlive(req.SessionId, req.SourceShard)
 	if err != nil {
 		s.log.Warn(
 			"Failed to listen to heartbeats",
@@ -402,7 +440,7 @@ func (s *publicRpcServer) KeepAlive(ctx context.Context, req *proto.SessionHeart
 		)
 		return nil, err
 	}
-	return &proto.KeepAliveResponse{}, nil
+	return &proto.KeepAliveResponse{SessionId: sessionId}, nil
 }
 
 func (s *publicRpcServer) CloseSession(ctx context.Context, req *proto.CloseSessionRequest) (*proto.CloseSessionResponse, error) {
@@ -425,6 +463,72 @@ func (s *publicRpcServer) CloseSession(ctx context.Context, req *proto.CloseSess
 	return res, nil
 }
 
+func (s *publicRpcServer) PrepareTransaction(ctx context.Context, req *proto.PrepareTransactionRequest) (*proto.TransactionResponse, error) {
+	s.log.Debug(
+		"Prepare transaction request",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+	lc, err := s.getLeader(&req.Shard)
+	if err != nil {
+		return nil, err
+	}
+	res, err := lc.PrepareTransaction(ctx, req)
+	if err != nil {
+		s.log.Warn(
+			"Failed to prepare transaction",
+			slog.String("transaction-id", req.TransactionId),
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *publicRpcServer) CommitTransaction(ctx context.Context, req *proto.CommitTransactionRequest) (*proto.TransactionResponse, error) {
+	s.log.Debug(
+		"Commit transaction request",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+	lc, err := s.getLeader(&req.Shard)
+	if err != nil {
+		return nil, err
+	}
+	res, err := lc.CommitTransaction(ctx, req)
+	if err != nil {
+		s.log.Warn(
+			"Failed to commit transaction",
+			slog.String("transaction-id", req.TransactionId),
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *publicRpcServer) AbortTransaction(ctx context.Context, req *proto.AbortTransactionRequest) (*proto.TransactionResponse, error) {
+	s.log.Debug(
+		"Abort transaction request",
+		slog.String("peer", rpc.GetPeer(ctx)),
+		slog.Any("req", req),
+	)
+	lc, err := s.getLeader(&req.Shard)
+	if err != nil {
+		return nil, err
+	}
+	res, err := lc.AbortTransaction(ctx, req)
+	if err != nil {
+		s.log.Warn(
+			"Failed to abort transaction",
+			slog.String("transaction-id", req.TransactionId),
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
 func (s *publicRpcServer) GetSequenceUpdates(req *proto.GetSequenceUpdatesRequest, stream proto.OxiaClient_GetSequenceUpdatesServer) error {
 	s.log.Debug(
 		"Get sequence update request",
@@ -478,6 +582,23 @@ func (s *publicRpcServer) getLeader(shardId *int64) (LeaderController, error) {
 	return lc, nil
 }
 
+// getReader returns the controller that serves the read requests. If the
+// request tolerates stale data, it can be served by a follower, unless it
+// requires a linearizable read.
+func (s *publicRpcServer) getReader(shardId *int64, maxStalenessMs *uint64, linearizable bool) (ShardReader, error) {
+	if maxStalenessMs == nil || linearizable {
+		return s.getLeader(shardId)
+	}
+	if shardId == nil {
+		return nil, status.Error(codes.InvalidArgument, "shard id is required")
+	}
+
+	if lc, err := s.shardsDirector.GetLeader(*shardId); err == nil {
+		return lc, nil
+	}
+	return s.shardsDirector.GetFollower(*shardId)
+}
+
 func (s *publicRpcServer) Close() error {
 	return s.grpcServer.Close()
 }
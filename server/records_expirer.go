@@ -0,0 +1,162 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/metric"
+	"github.com/oxia-db/oxia/common/process"
+	"github.com/oxia-db/oxia/proto"
+)
+
+const (
+	// Interval at which the leader looks for expired records
+	recordsExpirationCheckInterval = 1 * time.Second
+
+	// Max number of records deleted in a single write
+	recordsExpirationMaxBatchSize = 1000
+)
+
+// RecordsExpirer deletes the records whose time-to-live has expired.
+//
+// The expiration is driven by the shard leader, and the records are removed
+// with regular delete operations. This ensures that all the replicas delete
+// the same records and that the KEY_DELETED notifications are emitted.
+type RecordsExpirer interface {
+	io.Closer
+	Initialize()
+}
+
+var _ RecordsExpirer = (*recordsExpirer)(nil)
+
+type recordsExpirer struct {
+	leaderController *leaderController
+	namespace        string
+	shardId          int64
+	log              *slog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	expiredRecords metric.Counter
+}
+
+func NewRecordsExpirer(ctx context.Context, namespace string, shardId int64, controller *leaderController) RecordsExpirer {
+	re := &recordsExpirer{
+		leaderController: controller,
+		namespace:        namespace,
+		shardId:          shardId,
+		log: slog.With(
+			slog.String("component", "records-expirer"),
+			slog.String("namespace", namespace),
+			slog.Int64("shard", shardId),
+			slog.Int64("term", controller.term),
+		),
+
+		expiredRecords: metric.NewCounter("oxia_server_records_expired",
+			"The total number of records deleted after their time-to-live expired", "count",
+			metric.LabelsForShard(namespace, shardId)),
+	}
+
+	re.ctx, re.cancel = context.WithCancel(ctx)
+	return re
+}
+
+// Initialize starts the background task that deletes the expired records.
+func (re *recordsExpirer) Initialize() {
+	go process.DoWithLabels(re.ctx, map[string]string{
+		"oxia":      "records-expirer",
+		"namespace": re.namespace,
+		"shard":     fmt.Sprintf("%d", re.shardId),
+	}, re.run)
+}
+
+func (re *recordsExpirer) run() {
+	ticker := time.NewTicker(recordsExpirationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-re.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := re.deleteExpiredRecords(); err != nil && re.ctx.Err() == nil {
+				re.log.Warn(
+					"Failed to delete expired records",
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+}
+
+func (re *recordsExpirer) deleteExpiredRecords() error {
+	for re.ctx.Err() == nil {
+		deletes, err := re.leaderController.expiredRecords(uint64(time.Now().UnixMilli()), recordsExpirationMaxBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(deletes) == 0 {
+			return nil
+		}
+
+		res, err := re.leaderController.writeBlock(re.ctx, func(_ int64) *proto.WriteRequest {
+			return &proto.WriteRequest{
+				Shard:   &re.shardId,
+				Deletes: deletes,
+			}
+		})
+		if err != nil {
+			return err
+		}
+
+		deleted, expired := 0, 0
+		for i, dr := range res.Deletes {
+			if dr.Status != proto.Status_OK {
+				continue
+			}
+			deleted++
+			// The orphan index entries are not records
+			if !strings.HasPrefix(deletes[i].Key, constant.InternalKeyPrefix) {
+				expired++
+			}
+		}
+		re.expiredRecords.Add(expired)
+		re.log.Debug(
+			"Deleted expired records",
+			slog.Int("count", expired),
+		)
+
+		// The records that are locked by a transaction, or were updated in the
+		// meantime, are returned again by the next scan. Wait for the next
+		// check if none could be deleted, instead of retrying the same batch
+		if len(deletes) < recordsExpirationMaxBatchSize || deleted == 0 {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (re *recordsExpirer) Close() error {
+	re.cancel()
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/proto"
+)
+
+func TestRecordsExpirer(t *testing.T) {
+	var shard int64 = 1
+	lc := newTransactionsTestLeader(t, shard, nil)
+
+	wr, err := lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shard,
+		Puts: []*proto.PutRequest{
+			{Key: "/a", Value: []byte("a"), TtlMs: pb.Uint64(0)},
+			{Key: "/b", Value: []byte("b"), TtlMs: pb.Uint64(3_600_000)},
+			{Key: "/c", Value: []byte("c")},
+		},
+	})
+	assert.NoError(t, err)
+	for _, pr := range wr.Puts {
+		assert.Equal(t, proto.Status_OK, pr.Status)
+	}
+	assert.NotNil(t, wr.Puts[0].Version.ExpirationTimestamp)
+
+	db := lc.(*leaderController).db
+	assert.Eventually(t, func() bool {
+		gr, err := db.Get(&proto.GetRequest{Key: "/a"})
+		assert.NoError(t, err)
+		return gr.Status == proto.Status_KEY_NOT_FOUND
+	}, 10*time.Second, 100*time.Millisecond)
+
+	for _, key := range []string{"/b", "/c"} {
+		gr, err := db.Get(&proto.GetRequest{Key: key})
+		assert.NoError(t, err)
+		assert.Equal(t, proto.Status_OK, gr.Status)
+	}
+
+	assert.NoError(t, lc.Close())
+}
+
+func TestRecordsExpirer_LockedRecords(t *testing.T) {
+	var shard int64 = 1
+	lc := newTransactionsTestLeader(t, shard, nil)
+
+	puts := make([]*proto.PutRequest, recordsExpirationMaxBatchSize)
+	deletes := make([]*proto.DeleteRequest, recordsExpirationMaxBatchSize)
+	for i := range puts {
+		key := fmt.Sprintf("/key-%04d", i)
+		puts[i] = &proto.PutRequest{Key: key, Value: []byte("v"), TtlMs: pb.Uint64(1_000)}
+		deletes[i] = &proto.DeleteRequest{Key: key}
+	}
+	wr, err := lc.WriteBlock(context.Background(), &proto.WriteRequest{Shard: &shard, Puts: puts})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, wr.Puts[0].Status)
+
+	// A full batch of expired records that cannot be deleted
+	res, err := lc.PrepareTransaction(context.Background(), &proto.PrepareTransactionRequest{
+		Shard:         shard,
+		TransactionId: "txn-1",
+		PrimaryShard:  shard,
+		TimeoutMs:     60_000,
+		Deletes:       deletes,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_PENDING, res.Status)
+
+	// The expirer retries at the next check, instead of writing the same
+	// deletes in a loop
+	headOffset := lc.(*leaderController).quorumAckTracker.HeadOffset()
+	time.Sleep(3 * time.Second)
+	assert.LessOrEqual(t, lc.(*leaderController).quorumAckTracker.HeadOffset()-headOffset, int64(5))
+
+	res, err = lc.AbortTransaction(context.Background(), &proto.AbortTransactionRequest{
+		Shard:         shard,
+		TransactionId: "txn-1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_ABORTED, res.Status)
+
+	db := lc.(*leaderController).db
+	assert.Eventually(t, func() bool {
+		gr, err := db.Get(&proto.GetRequest{Key: "/key-0000"})
+		assert.NoError(t, err)
+		return gr.Status == proto.Status_KEY_NOT_FOUND
+	}, 10*time.Second, 100*time.Millisecond)
+
+	assert.NoError(t, lc.Close())
+}
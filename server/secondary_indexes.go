@@ -200,11 +200,33 @@ func writeSecondaryIndexes(batch kv.WriteBatch, primaryKey string, secondaryInde
 
 // /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// secondaryIndexScanRange returns the range of the keys of the index to scan.
+// If `startAfterKey` is set, the records indexed with the secondary key where
+// the scan starts (`startInclusive`, or `endExclusive` for reverse scans) are
+// skipped up to that primary key.
+func secondaryIndexScanRange(indexName string, startInclusive string, endExclusive string,
+	startAfterKey *string, reverse bool) (start string, end string) {
+	start = fmt.Sprintf(secondaryIdxRangePrefixFormat, indexName, startInclusive)
+	end = fmt.Sprintf(secondaryIdxRangePrefixFormat, indexName, endExclusive)
+	if startAfterKey == nil {
+		return start, end
+	}
+
+	if reverse {
+		end = fmt.Sprintf(secondaryIdxFormat, indexName, endExclusive, url.PathEscape(*startAfterKey))
+	} else {
+		start = kv.KeySuccessor(fmt.Sprintf(secondaryIdxFormat, indexName, startInclusive, url.PathEscape(*startAfterKey)))
+	}
+	return start, end
+}
+
 func newSecondaryIndexListIterator(req *proto.ListRequest, db kv.DB) (kv.KeyIterator, error) {
-	indexName := *req.SecondaryIndexName
+	start, end := secondaryIndexScanRange(*req.SecondaryIndexName, req.StartInclusive, req.EndExclusive,
+		req.StartAfterKey, req.Reverse)
 	it, err := db.List(&proto.ListRequest{
-		StartInclusive: fmt.Sprintf(secondaryIdxRangePrefixFormat, indexName, req.StartInclusive),
-		EndExclusive:   fmt.Sprintf(secondaryIdxRangePrefixFormat, indexName, req.EndExclusive),
+		StartInclusive: start,
+		EndExclusive:   end,
+		Reverse:        req.Reverse,
 	})
 	if err != nil {
 		return nil, err
@@ -232,6 +254,16 @@ func (it *secondaryIndexListIterator) Key() string {
 	return primaryKey
 }
 
+func (it *secondaryIndexListIterator) SecondaryKey() string {
+	_, secondaryKey, err := secondaryIndexPrimaryAndSecondaryKey(it.it.Key())
+	if err != nil {
+		// This should never happen since we control the key format
+		panic(errors.Wrap(err, "Failed to parse secondary index key"))
+	}
+
+	return secondaryKey
+}
+
 func (*secondaryIndexListIterator) Prev() bool {
 	panic("not supported")
 }
@@ -255,10 +287,12 @@ func (it *secondaryIndexListIterator) Close() error {
 // /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 func newSecondaryIndexRangeScanIterator(req *proto.RangeScanRequest, db kv.DB) (kv.RangeScanIterator, error) {
-	indexName := *req.SecondaryIndexName
+	start, end := secondaryIndexScanRange(*req.SecondaryIndexName, req.StartInclusive, req.EndExclusive,
+		req.StartAfterKey, req.Reverse)
 	it, err := db.List(&proto.ListRequest{
-		StartInclusive: fmt.Sprintf(secondaryIdxRangePrefixFormat, indexName, req.StartInclusive),
-		EndExclusive:   fmt.Sprintf(secondaryIdxRangePrefixFormat, indexName, req.EndExclusive),
+		StartInclusive: start,
+		EndExclusive:   end,
+		Reverse:        req.Reverse,
 	})
 	if err != nil {
 		return nil, err
@@ -298,7 +332,9 @@ func (it *secondaryIndexRangeIterator) Value() (*proto.GetResponse, error) {
 	})
 
 	if gr != nil {
+		secondaryKey := it.listIt.SecondaryKey()
 		gr.Key = &primaryKey
+		gr.SecondaryIndexKey = &secondaryKey
 	}
 
 	return gr, err
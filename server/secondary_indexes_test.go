@@ -36,7 +36,7 @@ func TestSecondaryIndices_List(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -132,7 +132,7 @@ func TestSecondaryIndices_RangeScan(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -243,7 +243,7 @@ func TestSecondaryIndices_MultipleKeysForSameIdx(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
 		Shard:             shard,
@@ -349,3 +349,226 @@ func TestSecondaryIndices_MultipleKeysForSameIdx(t *testing.T) {
 	assert.NoError(t, kvFactory.Close())
 	assert.NoError(t, walFactory.Close())
 }
+
+func TestSecondaryIndices_Pagination(t *testing.T) {
+	var shard int64 = 1
+
+	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	walFactory := newTestWalFactory(t)
+
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
+	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
+	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
+		Shard:             shard,
+		Term:              1,
+		ReplicationFactor: 1,
+		FollowerMaps:      nil,
+	})
+
+	_, err := lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shard,
+		Puts: []*proto.PutRequest{
+			{Key: "/a", Value: []byte("0"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "1"}}},
+			{Key: "/b", Value: []byte("1"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "1"}}},
+			{Key: "/c", Value: []byte("2"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "1"}}},
+			{Key: "/d", Value: []byte("3"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "2"}}},
+		},
+	})
+	assert.NoError(t, err)
+
+	keys, err := lc.ListBlock(context.Background(), &proto.ListRequest{
+		Shard:              &shard,
+		StartInclusive:     "0",
+		EndExclusive:       "9",
+		SecondaryIndexName: pb.String("idx"),
+		Limit:              pb.Uint32(2),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/a", "/b"}, keys)
+
+	// Resume after the last returned record
+	keys, err = lc.ListBlock(context.Background(), &proto.ListRequest{
+		Shard:              &shard,
+		StartInclusive:     "1",
+		EndExclusive:       "9",
+		SecondaryIndexName: pb.String("idx"),
+		Limit:              pb.Uint32(2),
+		StartAfterKey:      pb.String("/b"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/c", "/d"}, keys)
+
+	ch := make(chan *entity.TWithError[*proto.GetResponse], 100)
+	lc.RangeScan(context.Background(), &proto.RangeScanRequest{
+		Shard:              &shard,
+		StartInclusive:     "1",
+		EndExclusive:       "9",
+		SecondaryIndexName: pb.String("idx"),
+		Limit:              pb.Uint32(2),
+		StartAfterKey:      pb.String("/c"),
+	}, concurrent.ReadFromStreamCallback(ch))
+
+	gr := <-ch
+	assert.NoError(t, gr.Err)
+	assert.Equal(t, "/d", gr.T.GetKey())
+	assert.Equal(t, "2", gr.T.GetSecondaryIndexKey())
+	assert.Equal(t, []byte("3"), gr.T.Value)
+	gr = <-ch
+	assert.Nil(t, gr)
+
+	// Primary key pagination
+	keys, err = lc.ListBlock(context.Background(), &proto.ListRequest{
+		Shard:          &shard,
+		StartInclusive: "/a",
+		EndExclusive:   "/z",
+		Limit:          pb.Uint32(2),
+		StartAfterKey:  pb.String("/a"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/b", "/c"}, keys)
+
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvFactory.Close())
+	assert.NoError(t, walFactory.Close())
+}
+
+func TestSecondaryIndices_Reverse(t *testing.T) {
+	var shard int64 = 1
+
+	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	walFactory := newTestWalFactory(t)
+
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
+	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
+	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
+		Shard:             shard,
+		Term:              1,
+		ReplicationFactor: 1,
+		FollowerMaps:      nil,
+	})
+
+	_, err := lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shard,
+		Puts: []*proto.PutRequest{
+			{Key: "/a", Value: []byte("0"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "1"}}},
+			{Key: "/b", Value: []byte("1"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "2"}}},
+			{Key: "/c", Value: []byte("2"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "2"}}},
+			{Key: "/d", Value: []byte("3"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "3"}}},
+		},
+	})
+	assert.NoError(t, err)
+
+	keys, err := lc.ListBlock(context.Background(), &proto.ListRequest{
+		Shard:              &shard,
+		StartInclusive:     "0",
+		EndExclusive:       "9",
+		SecondaryIndexName: pb.String("idx"),
+		Limit:              pb.Uint32(2),
+		Reverse:            true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/d", "/c"}, keys)
+
+	// Resume before the last returned record
+	keys, err = lc.ListBlock(context.Background(), &proto.ListRequest{
+		Shard:              &shard,
+		StartInclusive:     "0",
+		EndExclusive:       "2",
+		SecondaryIndexName: pb.String("idx"),
+		StartAfterKey:      pb.String("/c"),
+		Reverse:            true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/b", "/a"}, keys)
+
+	ch := make(chan *entity.TWithError[*proto.GetResponse], 100)
+	lc.RangeScan(context.Background(), &proto.RangeScanRequest{
+		Shard:              &shard,
+		StartInclusive:     "2",
+		EndExclusive:       "3",
+		SecondaryIndexName: pb.String("idx"),
+		Reverse:            true,
+	}, concurrent.ReadFromStreamCallback(ch))
+
+	gr := <-ch
+	assert.NoError(t, gr.Err)
+	assert.Equal(t, "/c", gr.T.GetKey())
+	assert.Equal(t, []byte("2"), gr.T.Value)
+	gr = <-ch
+	assert.NoError(t, gr.Err)
+	assert.Equal(t, "/b", gr.T.GetKey())
+	gr = <-ch
+	assert.Nil(t, gr)
+
+	// Primary key reverse scan
+	keys, err = lc.ListBlock(context.Background(), &proto.ListRequest{
+		Shard:          &shard,
+		StartInclusive: "/a",
+		EndExclusive:   "/z",
+		Limit:          pb.Uint32(2),
+		StartAfterKey:  pb.String("/d"),
+		Reverse:        true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/c", "/b"}, keys)
+
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvFactory.Close())
+	assert.NoError(t, walFactory.Close())
+}
+
+func TestSecondaryIndices_AppendAndMergePatch(t *testing.T) {
+	var shard int64 = 1
+
+	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	walFactory := newTestWalFactory(t)
+
+	lc, _ := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
+	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
+	_, _ = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
+		Shard:             shard,
+		Term:              1,
+		ReplicationFactor: 1,
+		FollowerMaps:      nil,
+	})
+
+	_, err := lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shard,
+		Puts: []*proto.PutRequest{
+			{Key: "/a", Value: []byte("0"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "1"}}},
+			{Key: "/b", Value: []byte("{}"), SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "2"}}},
+		},
+	})
+	assert.NoError(t, err)
+
+	// The records keep their secondary indexes when their values are modified
+	_, err = lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard:        &shard,
+		Appends:      []*proto.AppendRequest{{Key: "/a", Value: []byte("1")}},
+		MergePatches: []*proto.MergePatchRequest{{Key: "/b", Patch: []byte(`{"x":1}`)}},
+	})
+	assert.NoError(t, err)
+
+	ch := make(chan *entity.TWithError[*proto.GetResponse], 100)
+	lc.RangeScan(context.Background(), &proto.RangeScanRequest{
+		Shard:              &shard,
+		StartInclusive:     "1",
+		EndExclusive:       "3",
+		SecondaryIndexName: pb.String("idx"),
+	}, concurrent.ReadFromStreamCallback(ch))
+
+	gr := <-ch
+	assert.NoError(t, gr.Err)
+	assert.Equal(t, "/a", gr.T.GetKey())
+	assert.Equal(t, []byte("01"), gr.T.Value)
+	gr = <-ch
+	assert.NoError(t, gr.Err)
+	assert.Equal(t, "/b", gr.T.GetKey())
+	assert.Equal(t, []byte(`{"x":1}`), gr.T.Value)
+	gr = <-ch
+	assert.Nil(t, gr)
+
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvFactory.Close())
+	assert.NoError(t, walFactory.Close())
+}
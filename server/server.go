@@ -60,6 +60,7 @@ type Server struct {
 	metrics                   *metric.PrometheusMetrics
 	walFactory                wal.Factory
 	kvFactory                 kv.Factory
+	clientPool                rpc.ClientPool
 
 	healthServer rpc.HealthServer
 }
@@ -93,11 +94,13 @@ func NewWithGrpcProvider(config Config, provider rpc.GrpcProvider, replicationRp
 			SyncData:    true,
 		}),
 		kvFactory:    kvFactory,
+		clientPool:   rpc.NewClientPool(config.PeerTLS, nil),
 		healthServer: rpc.NewClosableHealthServer(context.Background()),
 	}
 
-	s.shardsDirector = NewShardsDirector(config, s.walFactory, s.kvFactory, replicationRpcProvider)
 	s.shardAssignmentDispatcher = NewShardAssignmentDispatcher(s.healthServer)
+	s.shardsDirector = NewShardsDirector(config, s.walFactory, s.kvFactory, replicationRpcProvider,
+		newRemoteTransactionResolver(s.shardAssignmentDispatcher, s.clientPool))
 
 	s.internalRpcServer, err = newInternalRpcServer(provider, config.InternalServiceAddr,
 		s.shardsDirector, s.shardAssignmentDispatcher, s.healthServer, config.InternalServerTLS)
@@ -138,6 +141,7 @@ func (s *Server) Close() error {
 		s.kvFactory.Close(),
 		s.walFactory.Close(),
 		s.replicationRpcProvider.Close(),
+		s.clientPool.Close(),
 	)
 
 	if s.metrics != nil {
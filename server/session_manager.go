@@ -15,11 +15,13 @@
 package server
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/url"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -34,7 +36,7 @@ import (
 )
 
 const (
-	sessionKeyPrefix = constant.InternalKeyPrefix + "session"
+	sessionKeyPrefix = kv.SessionsPrefix
 	sessionKeyFormat = sessionKeyPrefix + "/%016x"
 	sessionKeyLength = len(sessionKeyPrefix) + 1 + 16
 )
@@ -78,8 +80,16 @@ func IsSessionKey(key string) bool {
 type SessionManager interface {
 	io.Closer
 	CreateSession(request *proto.CreateSessionRequest) (*proto.CreateSessionResponse, error)
-	KeepAlive(sessionId int64) error
+	// KeepAlive records a heartbeat of the session, returning its id. When the
+	// source shard is set, the id is the one the session had on that shard,
+	// before it was split or merged into this one
+	KeepAlive(sessionId int64, sourceShard *int64) (int64, error)
 	CloseSession(request *proto.CloseSessionRequest) (*proto.CloseSessionResponse, error)
+	// ListSessions returns the active sessions of the shard
+	ListSessions(request *proto.ListShardSessionsRequest) (*proto.ListShardSessionsResponse, error)
+	// RevokeSession expires a session on behalf of an administrator,
+	// deleting all its ephemeral records
+	RevokeSession(request *proto.RevokeShardSessionRequest) (*proto.RevokeShardSessionResponse, error)
 	Initialize() error
 }
 
@@ -93,12 +103,17 @@ type sessionManager struct {
 	sessions         collection.Map[SessionId, *session]
 	log              *slog.Logger
 
+	// The ids of the sessions taken over from other shards in a merge, by
+	// source shard and by the id they had there
+	sourceSessions map[int64]map[int64]SessionId
+
 	ctx    context.Context
 	cancel context.CancelFunc
 
 	createdSessions metric.Counter
 	closedSessions  metric.Counter
 	expiredSessions metric.Counter
+	revokedSessions metric.Counter
 	activeSessions  metric.Gauge
 }
 
@@ -106,6 +121,7 @@ func NewSessionManager(ctx context.Context, namespace string, shardId int64, con
 	labels := metric.LabelsForShard(namespace, shardId)
 	sm := &sessionManager{
 		sessions:         collection.NewVisibleMap[SessionId, *session](),
+		sourceSessions:   map[int64]map[int64]SessionId{},
 		namespace:        namespace,
 		shardId:          shardId,
 		leaderController: controller,
@@ -122,6 +138,8 @@ func NewSessionManager(ctx context.Context, namespace string, shardId int64, con
 			"The total number of sessions closed", "count", labels),
 		expiredSessions: metric.NewCounter("oxia_server_sessions_expired",
 			"The total number of sessions expired", "count", labels),
+		revokedSessions: metric.NewCounter("oxia_server_sessions_revoked",
+			"The total number of sessions revoked by an administrator", "count", labels),
 	}
 
 	sm.ctx, sm.cancel = context.WithCancel(ctx)
@@ -180,6 +198,10 @@ func (sm *sessionManager) createSession(request *proto.CreateSessionRequest, min
 }
 
 func (sm *sessionManager) getSession(sessionId int64) (*session, error) {
+	if sm.ctx.Err() != nil {
+		// The leader is stepping down, the sessions are tracked by the next one
+		return nil, constant.ErrNodeIsNotLeader
+	}
 	s, found := sm.sessions.Get(SessionId(sessionId))
 	if !found {
 		sm.log.Warn(
@@ -191,36 +213,148 @@ func (sm *sessionManager) getSession(sessionId int64) (*session, error) {
 	return s, nil
 }
 
-func (sm *sessionManager) KeepAlive(sessionId int64) error {
+func (sm *sessionManager) KeepAlive(sessionId int64, sourceShard *int64) (int64, error) {
 	sm.RLock()
-	s, err := sm.getSession(sessionId)
+	s, err := sm.getSourceSession(sessionId, sourceShard)
 	sm.RUnlock()
 	if err != nil {
-		return err
+		return 0, err
 	}
 	s.heartbeat()
-	return nil
+	return int64(s.id), nil
+}
+
+// getSourceSession returns a session that was created on the source shard.
+// The sessions of a split shard keep their ids, while the ones taken over in a
+// merge might have been given a new id, to not collide with the others.
+func (sm *sessionManager) getSourceSession(sessionId int64, sourceShard *int64) (*session, error) {
+	if sourceShard != nil && *sourceShard != sm.shardId {
+		if ids, found := sm.sourceSessions[*sourceShard]; found {
+			id, found := ids[sessionId]
+			if !found {
+				sm.log.Warn(
+					"Session not found",
+					slog.Int64("source-shard", *sourceShard),
+					slog.Int64("session-id", sessionId),
+				)
+				return nil, constant.ErrSessionNotFound
+			}
+			sessionId = int64(id)
+		}
+	}
+	return sm.getSession(sessionId)
 }
 
 func (sm *sessionManager) CloseSession(request *proto.CloseSessionRequest) (*proto.CloseSessionResponse, error) {
+	s, err := sm.removeSession(request.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Session closing")
+	if err = sm.closeSession(s); err != nil {
+		return nil, err
+	}
+
+	sm.closedSessions.Inc()
+	return &proto.CloseSessionResponse{}, nil
+}
+
+func (sm *sessionManager) RevokeSession(request *proto.RevokeShardSessionRequest) (*proto.RevokeShardSessionResponse, error) {
+	s, err := sm.removeSession(request.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Session revoked")
+	if err = sm.closeSession(s); err != nil {
+		return nil, err
+	}
+
+	sm.revokedSessions.Inc()
+	return &proto.RevokeShardSessionResponse{}, nil
+}
+
+func (sm *sessionManager) removeSession(sessionId int64) (*session, error) {
 	sm.Lock()
-	s, err := sm.getSession(request.SessionId)
+	defer sm.Unlock()
+	s, err := sm.getSession(sessionId)
 	if err != nil {
-		sm.Unlock()
 		return nil, err
 	}
 	sm.sessions.Remove(s.id)
-	sm.Unlock()
+	return s, nil
+}
 
-	s.log.Info("Session closing")
+// closeSession stops the session and deletes its key, along with all its
+// ephemeral records.
+func (*sessionManager) closeSession(s *session) error {
 	s.Close()
-	err = s.delete()
+	return s.delete()
+}
+
+func (sm *sessionManager) ListSessions(request *proto.ListShardSessionsRequest) (*proto.ListShardSessionsResponse, error) {
+	var sessions []*session
+	sm.RLock()
+	if request.SessionId != nil {
+		s, err := sm.getSession(*request.SessionId)
+		if err != nil {
+			sm.RUnlock()
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	} else {
+		sessions = sm.sessions.Values()
+	}
+	sm.RUnlock()
+
+	slices.SortFunc(sessions, func(a, b *session) int {
+		return cmp.Compare(a.id, b.id)
+	})
+
+	res := &proto.ListShardSessionsResponse{}
+	for _, s := range sessions {
+		shardSession := &proto.ShardSession{
+			SessionId:      int64(s.id),
+			ClientIdentity: s.clientIdentity,
+			TimeoutMs:      uint32(s.timeout.Milliseconds()),
+		}
+		if request.IncludeEphemeralKeys {
+			keys, err := sm.ephemeralKeys(s.id)
+			if err != nil {
+				return nil, err
+			}
+			shardSession.EphemeralKeys = keys
+		}
+		res.Sessions = append(res.Sessions, shardSession)
+	}
+	return res, nil
+}
+
+// ephemeralKeys returns the keys of the ephemeral records owned by the
+// session, as tracked by its shadow keys.
+func (sm *sessionManager) ephemeralKeys(sessionId SessionId) ([]string, error) {
+	sessionKey := SessionKey(sessionId)
+	shadowKeys, err := sm.leaderController.ListBlock(context.Background(), &proto.ListRequest{
+		Shard:          &sm.shardId,
+		StartInclusive: sessionKey + "/",
+		EndExclusive:   sessionKey + "//",
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	sm.closedSessions.Inc()
-	return &proto.CloseSessionResponse{}, nil
+	keys := make([]string, 0, len(shadowKeys))
+	for _, shadowKey := range shadowKeys {
+		key, err := url.PathUnescape(shadowKey[len(sessionKey)+1:])
+		if err != nil {
+			return nil, err
+		}
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
 }
 
 func (sm *sessionManager) Initialize() error {
@@ -232,6 +366,15 @@ func (sm *sessionManager) Initialize() error {
 	}
 	for sessionId, sessionMetadata := range sessions {
 		startSession(sessionId, sessionMetadata, sm)
+
+		if sessionMetadata.SourceShard != nil && sessionMetadata.SourceSessionId != nil {
+			ids, found := sm.sourceSessions[*sessionMetadata.SourceShard]
+			if !found {
+				ids = map[int64]SessionId{}
+				sm.sourceSessions[*sessionMetadata.SourceShard] = ids
+			}
+			ids[*sessionMetadata.SourceSessionId] = sessionId
+		}
 	}
 	return nil
 }
@@ -403,19 +546,44 @@ func (*sessionManagerUpdateOperationCallbackS) OnDeleteWithEntry(batch kv.WriteB
 			return err
 		}
 		if unescapedEphemeralKey != "" {
+			previousValue, err := deleteEphemeralExpiration(batch, unescapedEphemeralKey)
+			if err != nil {
+				return err
+			}
+			if notification == nil || !notification.IncludesPreviousValue() {
+				previousValue = nil
+			}
 			// delete the ephemeral key
 			if err := batch.Delete(unescapedEphemeralKey); err != nil {
 				return err
 			}
 			// add ephemeral key to notification
 			if notification != nil {
-				notification.Deleted(unescapedEphemeralKey)
+				notification.Deleted(unescapedEphemeralKey, previousValue)
 			}
 		}
 	}
 	return nil
 }
 
+// deleteEphemeralExpiration removes the expiration of an ephemeral record
+// that is deleted with its session, and returns its value, or nil if the
+// record was already deleted.
+func deleteEphemeralExpiration(batch kv.WriteBatch, key string) ([]byte, error) {
+	se, err := kv.GetStorageEntry(batch, key)
+	if err != nil {
+		if errors.Is(err, kv.ErrKeyNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer se.ReturnToVTPool()
+	if err = kv.DeleteExpirationIndex(batch, key, se); err != nil {
+		return nil, err
+	}
+	return slices.Clone(se.Value), nil
+}
+
 func (s *sessionManagerUpdateOperationCallbackS) OnDeleteRange(batch kv.WriteBatch, notification *kv.Notifications, keyStartInclusive string, keyEndExclusive string) error {
 	it, err := batch.RangeScan(keyStartInclusive, keyEndExclusive)
 	if err != nil {
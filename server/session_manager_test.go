@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"testing"
 	"time"
 
@@ -515,6 +516,247 @@ func TestSessionManagerReopening(t *testing.T) {
 	assert.NoError(t, walf.Close())
 }
 
+func TestSessionManager_Closed(t *testing.T) {
+	shardId := int64(1)
+	kvf, walf, sManager, lc := createSessionManager(t)
+
+	createResp, err := sManager.CreateSession(&proto.CreateSessionRequest{
+		Shard:            shardId,
+		SessionTimeoutMs: 5 * 1000,
+	})
+	assert.NoError(t, err)
+	_, err = sManager.KeepAlive(createResp.SessionId, nil)
+	assert.NoError(t, err)
+
+	// The sessions are not gone, they are just not tracked by this leader
+	assert.NoError(t, sManager.Close())
+	_, err = sManager.KeepAlive(createResp.SessionId, nil)
+	assert.ErrorIs(t, err, constant.ErrNodeIsNotLeader)
+
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvf.Close())
+	assert.NoError(t, walf.Close())
+}
+
+func TestSessionManager_SourceSessions(t *testing.T) {
+	shardId := int64(1)
+	kvf, walf, _, lc := createSessionManager(t)
+
+	// The session 7 was taken over from the shard 5, where it had the id 3,
+	// while the session 9 was created on the shard
+	merged, err := (&proto.SessionMetadata{TimeoutMs: 5000, SourceShard: pb.Int64(5), SourceSessionId: pb.Int64(3)}).MarshalVT()
+	assert.NoError(t, err)
+	native, err := (&proto.SessionMetadata{TimeoutMs: 5000}).MarshalVT()
+	assert.NoError(t, err)
+	_, err = lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shardId,
+		Puts: []*proto.PutRequest{
+			{Key: SessionKey(7), Value: merged},
+			{Key: SessionKey(9), Value: native},
+		},
+	})
+	assert.NoError(t, err)
+
+	lc = reopenLeaderController(t, kvf, walf, lc)
+	sManager := lc.sessionManager.(*sessionManager)
+
+	id, err := sManager.KeepAlive(3, pb.Int64(5))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 7, id)
+
+	_, err = sManager.KeepAlive(9, pb.Int64(5))
+	assert.ErrorIs(t, err, constant.ErrSessionNotFound)
+
+	// The sessions of a split shard keep their ids
+	id, err = sManager.KeepAlive(9, pb.Int64(2))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 9, id)
+
+	id, err = sManager.KeepAlive(7, nil)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 7, id)
+
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvf.Close())
+	assert.NoError(t, walf.Close())
+}
+
+func TestSessionManager_ListAndRevoke(t *testing.T) {
+	shardId := int64(1)
+	kvf, walf, sManager, lc := createSessionManager(t)
+
+	res, err := sManager.ListSessions(&proto.ListShardSessionsRequest{Shard: shardId})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Sessions)
+
+	createResp, err := sManager.CreateSession(&proto.CreateSessionRequest{
+		Shard:            shardId,
+		SessionTimeoutMs: 5 * 1000,
+		ClientIdentity:   "client-1",
+	})
+	assert.NoError(t, err)
+	sessionId1 := createResp.SessionId
+	createResp, err = sManager.CreateSession(&proto.CreateSessionRequest{
+		Shard:            shardId,
+		SessionTimeoutMs: 10 * 1000,
+		ClientIdentity:   "client-2",
+	})
+	assert.NoError(t, err)
+	sessionId2 := createResp.SessionId
+
+	for _, key := range []string{"a/b", "c"} {
+		_, err = lc.WriteBlock(context.Background(), &proto.WriteRequest{
+			Shard: &shardId,
+			Puts: []*proto.PutRequest{{
+				Key:       key,
+				Value:     []byte(key),
+				SessionId: &sessionId1,
+			}},
+		})
+		assert.NoError(t, err)
+	}
+
+	res, err = sManager.ListSessions(&proto.ListShardSessionsRequest{Shard: shardId})
+	assert.NoError(t, err)
+	assert.Len(t, res.Sessions, 2)
+	assert.Equal(t, sessionId1, res.Sessions[0].SessionId)
+	assert.Equal(t, "client-1", res.Sessions[0].ClientIdentity)
+	assert.Equal(t, uint32(5000), res.Sessions[0].TimeoutMs)
+	assert.Empty(t, res.Sessions[0].EphemeralKeys)
+	assert.Equal(t, sessionId2, res.Sessions[1].SessionId)
+	assert.Equal(t, "client-2", res.Sessions[1].ClientIdentity)
+	assert.Equal(t, uint32(10000), res.Sessions[1].TimeoutMs)
+
+	res, err = sManager.ListSessions(&proto.ListShardSessionsRequest{
+		Shard:                shardId,
+		SessionId:            &sessionId1,
+		IncludeEphemeralKeys: true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Sessions, 1)
+	assert.ElementsMatch(t, []string{"a/b", "c"}, res.Sessions[0].EphemeralKeys)
+
+	// Revoking a session deletes its ephemeral records
+	_, err = sManager.RevokeSession(&proto.RevokeShardSessionRequest{Shard: shardId, SessionId: sessionId1})
+	assert.NoError(t, err)
+	assert.Nil(t, getSessionMetadata(t, lc, sessionId1))
+	assert.Empty(t, getData(t, lc, "a/b"))
+	assert.Empty(t, getData(t, lc, "c"))
+	_, err = sManager.KeepAlive(sessionId1, nil)
+	assert.ErrorIs(t, err, constant.ErrSessionNotFound)
+
+	_, err = sManager.ListSessions(&proto.ListShardSessionsRequest{Shard: shardId, SessionId: &sessionId1})
+	assert.ErrorIs(t, err, constant.ErrSessionNotFound)
+	_, err = sManager.RevokeSession(&proto.RevokeShardSessionRequest{Shard: shardId, SessionId: sessionId1})
+	assert.ErrorIs(t, err, constant.ErrSessionNotFound)
+
+	res, err = sManager.ListSessions(&proto.ListShardSessionsRequest{Shard: shardId})
+	assert.NoError(t, err)
+	assert.Len(t, res.Sessions, 1)
+	assert.Equal(t, sessionId2, res.Sessions[0].SessionId)
+
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvf.Close())
+	assert.NoError(t, walf.Close())
+}
+
+func TestSessionManager_EphemeralCounter(t *testing.T) {
+	shardId := int64(1)
+	kvf, walf, sManager, lc := createSessionManager(t)
+
+	createResp, err := sManager.CreateSession(&proto.CreateSessionRequest{
+		Shard:            shardId,
+		SessionTimeoutMs: 5 * 1000,
+		ClientIdentity:   "client-1",
+	})
+	assert.NoError(t, err)
+	sessionId := createResp.SessionId
+
+	_, err = lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shardId,
+		Puts: []*proto.PutRequest{{
+			Key:              "counter",
+			Value:            []byte("1"),
+			SessionId:        &sessionId,
+			SecondaryIndexes: []*proto.SecondaryIndex{{IndexName: "idx", SecondaryKey: "c"}},
+		}},
+	})
+	assert.NoError(t, err)
+
+	// The counter stays ephemeral and indexed once incremented
+	wr, err := lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard:      &shardId,
+		Increments: []*proto.IncrementRequest{{Key: "counter", Delta: 2}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, wr.Increments[0].Status)
+	assert.EqualValues(t, 3, wr.Increments[0].Value)
+	assert.Equal(t, sessionId, wr.Increments[0].Version.GetSessionId())
+	assert.Equal(t, "3", getData(t, lc, "counter"))
+
+	keys, err := lc.ListBlock(context.Background(), &proto.ListRequest{
+		Shard:              &shardId,
+		StartInclusive:     "a",
+		EndExclusive:       "z",
+		SecondaryIndexName: pb.String("idx"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"counter"}, keys)
+
+	// Closing the session deletes the counter
+	_, err = sManager.CloseSession(&proto.CloseSessionRequest{
+		Shard:     shardId,
+		SessionId: sessionId,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, getData(t, lc, "counter"))
+
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvf.Close())
+	assert.NoError(t, walf.Close())
+}
+
+func TestSessionManager_EphemeralWithTtl(t *testing.T) {
+	shardId := int64(1)
+	kvf, walf, sManager, lc := createSessionManager(t)
+
+	createResp, err := sManager.CreateSession(&proto.CreateSessionRequest{
+		Shard:            shardId,
+		SessionTimeoutMs: 5 * 1000,
+		ClientIdentity:   "client-1",
+	})
+	assert.NoError(t, err)
+	sessionId := createResp.SessionId
+
+	wr, err := lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shardId,
+		Puts: []*proto.PutRequest{{
+			Key:       "a",
+			Value:     []byte("a"),
+			SessionId: &sessionId,
+			TtlMs:     pb.Uint64(3_600_000),
+		}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, wr.Puts[0].Status)
+
+	// The expiration of the record is removed with the session
+	_, err = sManager.CloseSession(&proto.CloseSessionRequest{
+		Shard:     shardId,
+		SessionId: sessionId,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, getData(t, lc, "a"))
+
+	expired, err := lc.db.ExpiredRecords(math.MaxInt64, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, expired)
+
+	assert.NoError(t, lc.Close())
+	assert.NoError(t, kvf.Close())
+	assert.NoError(t, walf.Close())
+}
+
 func getData(t *testing.T, lc *leaderController, key string) string {
 	t.Helper()
 
@@ -536,7 +778,9 @@ func keepAlive(t *testing.T, sManager *sessionManager, sessionId int64, err erro
 		assert.NoError(t, err)
 		for i := 0; i < heartbeatCount; i++ {
 			time.Sleep(sleepTime)
-			assert.NoError(t, sManager.KeepAlive(sessionId))
+			id, err := sManager.KeepAlive(sessionId, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, sessionId, id)
 		}
 	}()
 }
@@ -568,7 +812,7 @@ func createSessionManager(t *testing.T) (kv.Factory, wal.Factory, *sessionManage
 	kvFactory, err := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	assert.NoError(t, err)
 	walFactory := newTestWalFactory(t)
-	lc, err := NewLeaderController(Config{NotificationsRetentionTime: 10 * time.Second}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{NotificationsRetentionTime: 10 * time.Second}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 	_, err = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	assert.NoError(t, err)
@@ -593,7 +837,7 @@ func reopenLeaderController(t *testing.T, kvFactory kv.Factory, walFactory wal.F
 	assert.NoError(t, oldlc.Close())
 
 	var err error
-	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), walFactory, kvFactory)
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), nil, walFactory, kvFactory)
 	assert.NoError(t, err)
 	_, err = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
 	assert.NoError(t, err)
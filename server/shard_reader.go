@@ -0,0 +1,244 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/oxia-db/oxia/common/compare"
+	"github.com/oxia-db/oxia/common/concurrent"
+	"github.com/oxia-db/oxia/common/process"
+	"github.com/oxia-db/oxia/common/rpc"
+	"github.com/oxia-db/oxia/proto"
+	"github.com/oxia-db/oxia/server/kv"
+)
+
+// ShardReader serves the read requests of a shard. Reads are normally served
+// by the leader, though followers are able to serve the requests that
+// tolerate stale data.
+type ShardReader interface {
+	Read(ctx context.Context, request *proto.ReadRequest, cb concurrent.StreamCallback[*proto.GetResponse])
+	List(ctx context.Context, request *proto.ListRequest, cb concurrent.StreamCallback[ListEntry])
+	RangeScan(ctx context.Context, request *proto.RangeScanRequest, cb concurrent.StreamCallback[*proto.GetResponse])
+	RangeStats(ctx context.Context, request *proto.RangeStatsRequest) (*proto.RangeStatsResponse, error)
+}
+
+// ListEntry is a key returned by a list request. When listing through a
+// secondary index, the secondary key of the record is included as well.
+type ListEntry struct {
+	Key          string
+	SecondaryKey *string
+}
+
+func readFromDB(ctx context.Context, db kv.DB, shardId int64, log *slog.Logger,
+	request *proto.ReadRequest, cb concurrent.StreamCallback[*proto.GetResponse]) {
+	go process.DoWithLabels(
+		ctx,
+		map[string]string{
+			"oxia":  "read",
+			"shard": fmt.Sprintf("%d", shardId),
+			"peer":  rpc.GetPeer(ctx),
+		},
+		func() {
+			log.Debug("Received read request")
+			var response *proto.GetResponse
+			var err error
+
+			for _, get := range request.Gets {
+				if get.SecondaryIndexName != nil {
+					response, err = secondaryIndexGet(get, db)
+				} else {
+					response, err = db.Get(get)
+				}
+				if err != nil {
+					break
+				}
+				if err = cb.OnNext(response); err != nil {
+					break
+				}
+				if err = ctx.Err(); err != nil {
+					break
+				}
+			}
+			cb.OnComplete(err)
+		},
+	)
+}
+
+func listFromDB(ctx context.Context, db kv.DB, shardId int64, log *slog.Logger,
+	request *proto.ListRequest, cb concurrent.StreamCallback[ListEntry]) {
+	go process.DoWithLabels(
+		ctx,
+		map[string]string{
+			"oxia":  "list",
+			"shard": fmt.Sprintf("%d", shardId),
+			"peer":  rpc.GetPeer(ctx),
+		},
+		func() {
+			log.Debug("Received list request", slog.Any("request", request))
+
+			var it kv.KeyIterator
+			var err error
+
+			if request.SecondaryIndexName != nil {
+				it, err = newSecondaryIndexListIterator(request, db)
+			} else {
+				it, err = db.List(request)
+			}
+			if err != nil {
+				log.Warn(
+					"Failed to process list request",
+					slog.Any("error", err),
+				)
+				cb.OnComplete(err)
+				return
+			}
+
+			secondaryIndexIt, _ := it.(*secondaryIndexListIterator)
+			for count := uint32(0); it.Valid() && withinLimit(request.Limit, count); it.Next() {
+				entry := ListEntry{Key: it.Key()}
+				if secondaryIndexIt != nil {
+					secondaryKey := secondaryIndexIt.SecondaryKey()
+					entry.SecondaryKey = &secondaryKey
+				}
+				if err = cb.OnNext(entry); err != nil {
+					break
+				}
+				if err = ctx.Err(); err != nil {
+					break
+				}
+				count++
+			}
+
+			err = multierr.Combine(err, it.Close())
+			cb.OnComplete(err)
+		},
+	)
+}
+
+func rangeScanFromDB(ctx context.Context, db kv.DB, shardId int64, log *slog.Logger,
+	request *proto.RangeScanRequest, cb concurrent.StreamCallback[*proto.GetResponse]) {
+	go process.DoWithLabels(ctx,
+		map[string]string{
+			"oxia":  "range-scan",
+			"shard": fmt.Sprintf("%d", shardId),
+			"peer":  rpc.GetPeer(ctx),
+		},
+		func() {
+			log.Debug("Received list request", slog.Any("request", request))
+
+			filter, err := kv.NewRangeScanFilter(request)
+			if err != nil {
+				cb.OnComplete(status.Error(codes.InvalidArgument, err.Error()))
+				return
+			}
+
+			var it kv.RangeScanIterator
+			if request.SecondaryIndexName != nil {
+				it, err = newSecondaryIndexRangeScanIterator(request, db)
+			} else {
+				it, err = db.RangeScan(request)
+			}
+
+			if err != nil {
+				log.Warn("Failed to process range-scan request", slog.Any("error", err))
+				cb.OnComplete(err)
+				return
+			}
+
+			if filter != nil {
+				it = filter.Apply(it)
+			}
+
+			var gr *proto.GetResponse
+			for count := uint32(0); it.Valid() && withinLimit(request.Limit, count); it.Next() {
+				if gr, err = it.Value(); err != nil {
+					break
+				}
+				if err = cb.OnNext(gr); err != nil {
+					break
+				}
+				if err = ctx.Err(); err != nil {
+					break
+				}
+				count++
+			}
+
+			err = multierr.Combine(err, it.Close())
+			cb.OnComplete(err)
+		},
+	)
+}
+
+func rangeStatsFromDB(ctx context.Context, db kv.DB, log *slog.Logger,
+	request *proto.RangeStatsRequest) (*proto.RangeStatsResponse, error) {
+	log.Debug("Received range-stats request", slog.Any("request", request))
+
+	scanRequest := &proto.RangeScanRequest{
+		StartInclusive:     request.StartInclusive,
+		EndExclusive:       request.EndExclusive,
+		SecondaryIndexName: request.SecondaryIndexName,
+	}
+
+	var it kv.RangeScanIterator
+	var err error
+	if request.SecondaryIndexName != nil {
+		it, err = newSecondaryIndexRangeScanIterator(scanRequest, db)
+	} else {
+		it, err = db.RangeScan(scanRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &proto.RangeStatsResponse{}
+	for ; it.Valid(); it.Next() {
+		var gr *proto.GetResponse
+		if gr, err = it.Value(); err != nil {
+			break
+		}
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		if gr.Status != proto.Status_OK {
+			continue
+		}
+
+		key := gr.GetKey()
+		res.Count++
+		res.TotalValueSize += uint64(len(gr.Value))
+		if res.MinKey == nil || compare.CompareWithSlash([]byte(key), []byte(*res.MinKey)) < 0 {
+			res.MinKey = &key
+		}
+		if res.MaxKey == nil || compare.CompareWithSlash([]byte(key), []byte(*res.MaxKey)) > 0 {
+			res.MaxKey = &key
+		}
+	}
+
+	if err = multierr.Combine(err, it.Close()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func withinLimit(limit *uint32, count uint32) bool {
+	return limit == nil || count < *limit
+}
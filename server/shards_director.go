@@ -15,6 +15,7 @@
 package server
 
 import (
+	"context"
 	"io"
 	"log/slog"
 	"sync"
@@ -32,6 +33,7 @@ import (
 
 type ShardsDirector interface {
 	io.Closer
+	TransactionResolver
 
 	GetLeader(shardId int64) (LeaderController, error)
 	GetFollower(shardId int64) (FollowerController, error)
@@ -52,6 +54,7 @@ type shardsDirector struct {
 	kvFactory              kv.Factory
 	walFactory             wal.Factory
 	replicationRpcProvider ReplicationRpcProvider
+	transactionResolver    TransactionResolver
 	closed                 bool
 	log                    *slog.Logger
 
@@ -59,7 +62,8 @@ type shardsDirector struct {
 	followersCounter metric.UpDownCounter
 }
 
-func NewShardsDirector(config Config, walFactory wal.Factory, kvFactory kv.Factory, provider ReplicationRpcProvider) ShardsDirector {
+func NewShardsDirector(config Config, walFactory wal.Factory, kvFactory kv.Factory, provider ReplicationRpcProvider,
+	transactionResolver TransactionResolver) ShardsDirector {
 	sd := &shardsDirector{
 		config:                 config,
 		walFactory:             walFactory,
@@ -67,6 +71,7 @@ func NewShardsDirector(config Config, walFactory wal.Factory, kvFactory kv.Facto
 		leaders:                make(map[int64]LeaderController),
 		followers:              make(map[int64]FollowerController),
 		replicationRpcProvider: provider,
+		transactionResolver:    transactionResolver,
 		log: slog.With(
 			slog.String("component", "shards-director"),
 		),
@@ -146,7 +151,7 @@ func (s *shardsDirector) GetOrCreateLeader(namespace string, shardId int64) (Lea
 	}
 
 	// Create new leader controller
-	lc, err := NewLeaderController(s.config, namespace, shardId, s.replicationRpcProvider, s.walFactory, s.kvFactory)
+	lc, err := NewLeaderController(s.config, namespace, shardId, s.replicationRpcProvider, s, s.walFactory, s.kvFactory)
 	if err != nil {
 		return nil, err
 	}
@@ -229,6 +234,20 @@ func (s *shardsDirector) DeleteShard(req *proto.DeleteShardRequest) (*proto.Dele
 	return fc.DeleteShard(req)
 }
 
+// AbortTransaction resolves a transaction on its primary shard. The request is
+// served directly when the leader of the primary shard is on this node.
+func (s *shardsDirector) AbortTransaction(ctx context.Context, namespace string,
+	request *proto.AbortTransactionRequest) (*proto.TransactionResponse, error) {
+	if leader, err := s.GetLeader(request.Shard); err == nil && leader.Status() == proto.ServingStatus_LEADER {
+		return leader.AbortTransaction(ctx, request)
+	}
+
+	if s.transactionResolver == nil {
+		return nil, status.Errorf(constant.CodeNodeIsNotLeader, "node is not leader for shard %d", request.Shard)
+	}
+	return s.transactionResolver.AbortTransaction(ctx, namespace, request)
+}
+
 func (s *shardsDirector) Close() error {
 	s.Lock()
 	defer s.Unlock()
@@ -32,7 +32,7 @@ func TestShardsDirector_DeleteShardLeader(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	sd := NewShardsDirector(Config{}, walFactory, kvFactory, newMockRpcClient())
+	sd := NewShardsDirector(Config{}, walFactory, kvFactory, newMockRpcClient(), nil)
 
 	lc, _ := sd.GetOrCreateLeader(constant.DefaultNamespace, shard)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
@@ -70,7 +70,7 @@ func TestShardsDirector_GetOrCreateFollower(t *testing.T) {
 	kvFactory, _ := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
 	walFactory := newTestWalFactory(t)
 
-	sd := NewShardsDirector(Config{}, walFactory, kvFactory, newMockRpcClient())
+	sd := NewShardsDirector(Config{}, walFactory, kvFactory, newMockRpcClient(), nil)
 
 	lc, _ := sd.GetOrCreateLeader(constant.DefaultNamespace, shard)
 	_, _ = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 2})
@@ -37,6 +37,10 @@ type StandaloneConfig struct {
 
 	NumShards            uint32
 	NotificationsEnabled bool
+
+	// Whether the notifications carry the written and the replaced values
+	NotificationsIncludeValue         bool
+	NotificationsIncludePreviousValue bool
 }
 
 type Standalone struct {
@@ -89,7 +93,7 @@ func NewStandalone(config StandaloneConfig) (*Standalone, error) {
 		return nil, err
 	}
 
-	s.shardsDirector = NewShardsDirector(config.Config, s.walFactory, s.kvFactory, newNoOpReplicationRpcProvider())
+	s.shardsDirector = NewShardsDirector(config.Config, s.walFactory, s.kvFactory, newNoOpReplicationRpcProvider(), nil)
 
 	if err := s.initializeShards(config.NumShards); err != nil {
 		return nil, err
@@ -129,7 +133,9 @@ func (s *Standalone) initializeShards(numShards uint32) error {
 			Shard: i,
 			Term:  newTerm,
 			Options: &proto.NewTermOptions{
-				EnableNotifications: s.config.NotificationsEnabled,
+				EnableNotifications:               s.config.NotificationsEnabled,
+				NotificationsIncludeValue:         s.config.NotificationsIncludeValue,
+				NotificationsIncludePreviousValue: s.config.NotificationsIncludePreviousValue,
 			},
 		}); err != nil {
 			return err
@@ -0,0 +1,317 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oxia-db/oxia/common/channel"
+	"github.com/oxia-db/oxia/common/concurrent"
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/entity"
+	"github.com/oxia-db/oxia/common/metric"
+	"github.com/oxia-db/oxia/common/process"
+	"github.com/oxia-db/oxia/proto"
+	"github.com/oxia-db/oxia/server/kv"
+)
+
+const (
+	// Interval at which the leader looks for abandoned transactions
+	transactionsCheckInterval = 1 * time.Second
+
+	// The outcome of the transactions is retained for a long time, so that
+	// the other participants and the clients are able to resolve them
+	transactionOutcomeRetention = 24 * time.Hour
+)
+
+// --- TransactionManager
+
+// TransactionManager handles the multi-shard transactions on the shard leader.
+//
+// The transactions follow a two-phase commit, driven by the client. The
+// operations are first prepared on all the participant shards, and then
+// committed, starting from the primary shard. The outcome on the primary
+// shard is the outcome of the transaction.
+//
+// If the client goes away, the transaction stays pending until it expires.
+// At that point, the leader of each participant shard resolves it by aborting
+// it on the primary shard, which will return the committed status instead if
+// the transaction was already committed there.
+type TransactionManager interface {
+	io.Closer
+	PrepareTransaction(ctx context.Context, request *proto.PrepareTransactionRequest) (*proto.TransactionResponse, error)
+	CommitTransaction(ctx context.Context, request *proto.CommitTransactionRequest) (*proto.TransactionResponse, error)
+	AbortTransaction(ctx context.Context, request *proto.AbortTransactionRequest) (*proto.TransactionResponse, error)
+	Initialize()
+}
+
+var _ TransactionManager = (*transactionManager)(nil)
+
+type transactionManager struct {
+	leaderController *leaderController
+	resolver         TransactionResolver
+	namespace        string
+	shardId          int64
+	log              *slog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	committedTransactions metric.Counter
+	abortedTransactions   metric.Counter
+	resolvedTransactions  metric.Counter
+}
+
+func NewTransactionManager(ctx context.Context, namespace string, shardId int64, controller *leaderController,
+	resolver TransactionResolver) TransactionManager {
+	labels := metric.LabelsForShard(namespace, shardId)
+	tm := &transactionManager{
+		leaderController: controller,
+		resolver:         resolver,
+		namespace:        namespace,
+		shardId:          shardId,
+		log: slog.With(
+			slog.String("component", "transaction-manager"),
+			slog.String("namespace", namespace),
+			slog.Int64("shard", shardId),
+			slog.Int64("term", controller.term),
+		),
+
+		committedTransactions: metric.NewCounter("oxia_server_transactions_committed",
+			"The total number of transactions committed", "count", labels),
+		abortedTransactions: metric.NewCounter("oxia_server_transactions_aborted",
+			"The total number of transactions aborted", "count", labels),
+		resolvedTransactions: metric.NewCounter("oxia_server_transactions_resolved",
+			"The total number of abandoned transactions resolved by the server", "count", labels),
+	}
+
+	tm.ctx, tm.cancel = context.WithCancel(ctx)
+	return tm
+}
+
+func (tm *transactionManager) PrepareTransaction(ctx context.Context, request *proto.PrepareTransactionRequest) (*proto.TransactionResponse, error) {
+	for _, put := range request.Puts {
+		if put.SessionId != nil || len(put.SequenceKeyDelta) > 0 {
+			return nil, errors.Wrap(constant.ErrInvalidTransaction, "ephemeral records and sequential keys are not supported")
+		}
+	}
+
+	return tm.write(ctx, &proto.WriteRequest{
+		Shard:   &request.Shard,
+		Puts:    request.Puts,
+		Deletes: request.Deletes,
+		Transaction: &proto.TransactionRequest{
+			TransactionId: request.TransactionId,
+			Action:        proto.TransactionAction_PREPARE,
+			PrimaryShard:  request.PrimaryShard,
+			PrimaryHash:   request.PrimaryHash,
+			TimeoutMs:     request.TimeoutMs,
+		},
+	})
+}
+
+func (tm *transactionManager) CommitTransaction(ctx context.Context, request *proto.CommitTransactionRequest) (*proto.TransactionResponse, error) {
+	res, err := tm.write(ctx, &proto.WriteRequest{
+		Shard: &request.Shard,
+		Transaction: &proto.TransactionRequest{
+			TransactionId: request.TransactionId,
+			Action:        proto.TransactionAction_COMMIT,
+		},
+	})
+	if err == nil && res.Status == proto.TransactionStatus_COMMITTED {
+		tm.committedTransactions.Inc()
+	}
+	return res, err
+}
+
+func (tm *transactionManager) AbortTransaction(ctx context.Context, request *proto.AbortTransactionRequest) (*proto.TransactionResponse, error) {
+	res, err := tm.write(ctx, &proto.WriteRequest{
+		Shard: &request.Shard,
+		Transaction: &proto.TransactionRequest{
+			TransactionId: request.TransactionId,
+			Action:        proto.TransactionAction_ABORT,
+		},
+	})
+	if err == nil && res.Status == proto.TransactionStatus_ABORTED {
+		tm.abortedTransactions.Inc()
+	}
+	return res, err
+}
+
+func (tm *transactionManager) write(ctx context.Context, request *proto.WriteRequest) (*proto.TransactionResponse, error) {
+	res, err := tm.leaderController.writeBlock(ctx, func(_ int64) *proto.WriteRequest {
+		return request
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.TransactionResponse{
+		Status:  res.GetTransactionStatus(),
+		Puts:    res.Puts,
+		Deletes: res.Deletes,
+	}, nil
+}
+
+// Initialize starts the background task that resolves the abandoned
+// transactions and discards the old outcomes.
+func (tm *transactionManager) Initialize() {
+	go process.DoWithLabels(tm.ctx, map[string]string{
+		"oxia":      "transaction-manager",
+		"namespace": tm.namespace,
+		"shard":     fmt.Sprintf("%d", tm.shardId),
+	}, tm.run)
+}
+
+func (tm *transactionManager) run() {
+	ticker := time.NewTicker(transactionsCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-tm.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := tm.resolveExpiredTransactions(); err != nil && tm.ctx.Err() == nil {
+				tm.log.Warn(
+					"Failed to resolve expired transactions",
+					slog.Any("error", err),
+				)
+			}
+			if err := tm.discardExpiredOutcomes(); err != nil && tm.ctx.Err() == nil {
+				tm.log.Warn(
+					"Failed to discard expired transaction outcomes",
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+}
+
+func (tm *transactionManager) resolveExpiredTransactions() error {
+	intents, err := tm.rangeScan(kv.TransactionIntentsPrefix)
+	if err != nil {
+		return err
+	}
+
+	now := uint64(time.Now().UnixMilli())
+	for _, entry := range intents {
+		intent := &proto.TransactionIntent{}
+		if err = intent.UnmarshalVT(entry.Value); err != nil {
+			return errors.Wrap(err, "failed to deserialize transaction intent")
+		}
+		if intent.ExpirationTimestamp > now {
+			continue
+		}
+
+		transactionId, err := kv.TransactionIdFromKey(entry.GetKey())
+		if err != nil {
+			return err
+		}
+		if err = tm.resolveTransaction(transactionId, intent); err != nil {
+			tm.log.Warn(
+				"Failed to resolve expired transaction",
+				slog.String("transaction-id", transactionId),
+				slog.Int64("primary-shard", intent.PrimaryShard),
+				slog.Any("error", err),
+			)
+		}
+	}
+	return nil
+}
+
+func (tm *transactionManager) resolveTransaction(transactionId string, intent *proto.TransactionIntent) error {
+	status := proto.TransactionStatus_ABORTED
+	if intent.PrimaryShard != tm.shardId {
+		if tm.resolver == nil {
+			return errors.New("no transaction resolver available")
+		}
+
+		res, err := tm.resolver.AbortTransaction(tm.ctx, tm.namespace, &proto.AbortTransactionRequest{
+			Shard:         intent.PrimaryShard,
+			TransactionId: transactionId,
+			PrimaryHash:   intent.PrimaryHash,
+		})
+		if err != nil {
+			return err
+		}
+		status = res.Status
+	}
+
+	var err error
+	if status == proto.TransactionStatus_COMMITTED {
+		_, err = tm.CommitTransaction(tm.ctx, &proto.CommitTransactionRequest{Shard: tm.shardId, TransactionId: transactionId})
+	} else {
+		_, err = tm.AbortTransaction(tm.ctx, &proto.AbortTransactionRequest{Shard: tm.shardId, TransactionId: transactionId})
+	}
+	if err != nil {
+		return err
+	}
+
+	tm.log.Info(
+		"Resolved expired transaction",
+		slog.String("transaction-id", transactionId),
+		slog.Any("status", status),
+	)
+	tm.resolvedTransactions.Inc()
+	return nil
+}
+
+func (tm *transactionManager) discardExpiredOutcomes() error {
+	outcomes, err := tm.rangeScan(kv.TransactionOutcomesPrefix)
+	if err != nil {
+		return err
+	}
+
+	expiration := uint64(time.Now().Add(-transactionOutcomeRetention).UnixMilli())
+	var deletes []*proto.DeleteRequest
+	for _, entry := range outcomes {
+		if entry.Version.CreatedTimestamp < expiration {
+			deletes = append(deletes, &proto.DeleteRequest{Key: entry.GetKey()})
+		}
+	}
+	if len(deletes) == 0 {
+		return nil
+	}
+
+	_, err = tm.leaderController.writeBlock(tm.ctx, func(_ int64) *proto.WriteRequest {
+		return &proto.WriteRequest{
+			Shard:   &tm.shardId,
+			Deletes: deletes,
+		}
+	})
+	return err
+}
+
+func (tm *transactionManager) rangeScan(prefix string) ([]*proto.GetResponse, error) {
+	ch := make(chan *entity.TWithError[*proto.GetResponse])
+	go tm.leaderController.RangeScan(tm.ctx, &proto.RangeScanRequest{
+		Shard:          &tm.shardId,
+		StartInclusive: prefix + "/",
+		EndExclusive:   prefix + "//",
+	}, concurrent.ReadFromStreamCallback(ch))
+	return channel.ReadAll(tm.ctx, ch)
+}
+
+func (tm *transactionManager) Close() error {
+	tm.cancel()
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/proto"
+	"github.com/oxia-db/oxia/server/kv"
+)
+
+type mockTransactionResolver struct {
+	sync.Mutex
+	status   proto.TransactionStatus
+	requests []*proto.AbortTransactionRequest
+}
+
+func (m *mockTransactionResolver) AbortTransaction(_ context.Context, _ string,
+	request *proto.AbortTransactionRequest) (*proto.TransactionResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.requests = append(m.requests, request)
+	return &proto.TransactionResponse{Status: m.status}, nil
+}
+
+func newTransactionsTestLeader(t *testing.T, shard int64, resolver TransactionResolver) LeaderController {
+	t.Helper()
+
+	kvFactory, err := kv.NewPebbleKVFactory(kv.NewFactoryOptionsForTest(t))
+	assert.NoError(t, err)
+	walFactory := newTestWalFactory(t)
+	t.Cleanup(func() {
+		assert.NoError(t, kvFactory.Close())
+		assert.NoError(t, walFactory.Close())
+	})
+
+	lc, err := NewLeaderController(Config{}, constant.DefaultNamespace, shard, newMockRpcClient(), resolver, walFactory, kvFactory)
+	assert.NoError(t, err)
+	_, err = lc.NewTerm(&proto.NewTermRequest{Shard: shard, Term: 1})
+	assert.NoError(t, err)
+	_, err = lc.BecomeLeader(context.Background(), &proto.BecomeLeaderRequest{
+		Shard:             shard,
+		Term:              1,
+		ReplicationFactor: 1,
+		FollowerMaps:      nil,
+	})
+	assert.NoError(t, err)
+	return lc
+}
+
+func TestTransactionManager_PrepareAndCommit(t *testing.T) {
+	var shard int64 = 1
+	lc := newTransactionsTestLeader(t, shard, nil)
+
+	res, err := lc.PrepareTransaction(context.Background(), &proto.PrepareTransactionRequest{
+		Shard:         shard,
+		TransactionId: "txn-1",
+		PrimaryShard:  shard,
+		TimeoutMs:     60_000,
+		Puts:          []*proto.PutRequest{{Key: "/a", Value: []byte("a"), ExpectedVersionId: pb.Int64(-1)}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_PENDING, res.Status)
+
+	wr, err := lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shard,
+		Puts:  []*proto.PutRequest{{Key: "/a", Value: []byte("b")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_KEY_LOCKED, wr.Puts[0].Status)
+
+	res, err = lc.CommitTransaction(context.Background(), &proto.CommitTransactionRequest{
+		Shard:         shard,
+		TransactionId: "txn-1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_COMMITTED, res.Status)
+	assert.Equal(t, proto.Status_OK, res.Puts[0].Status)
+
+	wr, err = lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard: &shard,
+		Puts:  []*proto.PutRequest{{Key: "/a", Value: []byte("b"), ExpectedVersionId: pb.Int64(res.Puts[0].Version.VersionId)}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.Status_OK, wr.Puts[0].Status)
+
+	// Transaction steps cannot be sent as regular writes
+	_, err = lc.WriteBlock(context.Background(), &proto.WriteRequest{
+		Shard:       &shard,
+		Transaction: &proto.TransactionRequest{TransactionId: "txn-1", Action: proto.TransactionAction_ABORT},
+	})
+	assert.ErrorIs(t, err, constant.ErrInvalidTransaction)
+
+	// Ephemeral records are not supported
+	_, err = lc.PrepareTransaction(context.Background(), &proto.PrepareTransactionRequest{
+		Shard:         shard,
+		TransactionId: "txn-2",
+		PrimaryShard:  shard,
+		Puts:          []*proto.PutRequest{{Key: "/b", Value: []byte("b"), SessionId: pb.Int64(1)}},
+	})
+	assert.ErrorIs(t, err, constant.ErrInvalidTransaction)
+
+	assert.NoError(t, lc.Close())
+}
+
+func TestTransactionManager_AbortExpiredOnPrimary(t *testing.T) {
+	var shard int64 = 1
+	lc := newTransactionsTestLeader(t, shard, nil)
+
+	res, err := lc.PrepareTransaction(context.Background(), &proto.PrepareTransactionRequest{
+		Shard:         shard,
+		TransactionId: "txn-1",
+		PrimaryShard:  shard,
+		TimeoutMs:     0,
+		Puts:          []*proto.PutRequest{{Key: "/a", Value: []byte("a")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_PENDING, res.Status)
+
+	// The abandoned transaction is aborted by the leader
+	assert.Eventually(t, func() bool {
+		wr, err := lc.WriteBlock(context.Background(), &proto.WriteRequest{
+			Shard: &shard,
+			Puts:  []*proto.PutRequest{{Key: "/a", Value: []byte("b"), ExpectedVersionId: pb.Int64(-1)}},
+		})
+		assert.NoError(t, err)
+		return wr.Puts[0].Status == proto.Status_OK
+	}, 10*time.Second, 100*time.Millisecond)
+
+	res, err = lc.CommitTransaction(context.Background(), &proto.CommitTransactionRequest{
+		Shard:         shard,
+		TransactionId: "txn-1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_ABORTED, res.Status)
+
+	assert.NoError(t, lc.Close())
+}
+
+func TestTransactionManager_ResolveExpiredOnParticipant(t *testing.T) {
+	var shard int64 = 2
+	resolver := &mockTransactionResolver{status: proto.TransactionStatus_COMMITTED}
+	lc := newTransactionsTestLeader(t, shard, resolver)
+
+	res, err := lc.PrepareTransaction(context.Background(), &proto.PrepareTransactionRequest{
+		Shard:         shard,
+		TransactionId: "txn-1",
+		PrimaryShard:  1,
+		PrimaryHash:   pb.Uint32(100),
+		TimeoutMs:     0,
+		Puts:          []*proto.PutRequest{{Key: "/a", Value: []byte("a")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_PENDING, res.Status)
+
+	// The transaction was committed on the primary shard, so it must be
+	// committed on the participant as well
+	assert.Eventually(t, func() bool {
+		wr, err := lc.WriteBlock(context.Background(), &proto.WriteRequest{
+			Shard: &shard,
+			Puts:  []*proto.PutRequest{{Key: "/a", Value: []byte("b"), ExpectedVersionId: pb.Int64(0)}},
+		})
+		assert.NoError(t, err)
+		return wr.Puts[0].Status == proto.Status_OK
+	}, 10*time.Second, 100*time.Millisecond)
+
+	res, err = lc.AbortTransaction(context.Background(), &proto.AbortTransactionRequest{
+		Shard:         shard,
+		TransactionId: "txn-1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proto.TransactionStatus_COMMITTED, res.Status)
+
+	resolver.Lock()
+	assert.EqualValues(t, 1, resolver.requests[0].Shard)
+	assert.Equal(t, "txn-1", resolver.requests[0].TransactionId)
+	assert.EqualValues(t, 100, resolver.requests[0].GetPrimaryHash())
+	resolver.Unlock()
+
+	assert.NoError(t, lc.Close())
+}
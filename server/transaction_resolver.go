@@ -0,0 +1,78 @@
+// Copyright 2023-2025 The Oxia Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/oxia-db/oxia/common/constant"
+	"github.com/oxia-db/oxia/common/rpc"
+	"github.com/oxia-db/oxia/proto"
+)
+
+// TransactionResolver decides the outcome of an abandoned transaction, by
+// aborting it on its primary shard. If the transaction was already committed
+// on the primary shard, the committed status is returned instead.
+//
+// When the primary shard was split or merged, the transaction is resolved on
+// the shard that has the primary hash in its range, since the outcomes of the
+// transactions are carried over to the new shards.
+type TransactionResolver interface {
+	AbortTransaction(ctx context.Context, namespace string, request *proto.AbortTransactionRequest) (*proto.TransactionResponse, error)
+}
+
+type remoteTransactionResolver struct {
+	assignmentDispatcher ShardAssignmentsDispatcher
+	pool                 rpc.ClientPool
+}
+
+// newRemoteTransactionResolver creates a resolver that sends the requests to
+// the leader of the primary shard, as found in the shard assignments.
+func newRemoteTransactionResolver(assignmentDispatcher ShardAssignmentsDispatcher, pool rpc.ClientPool) TransactionResolver {
+	return &remoteTransactionResolver{
+		assignmentDispatcher: assignmentDispatcher,
+		pool:                 pool,
+	}
+}
+
+func (r *remoteTransactionResolver) AbortTransaction(ctx context.Context, namespace string,
+	request *proto.AbortTransactionRequest) (*proto.TransactionResponse, error) {
+	var leader string
+	var err error
+	if request.PrimaryHash != nil {
+		var shardId int64
+		if shardId, leader, err = r.assignmentDispatcher.ShardForHash(namespace, *request.PrimaryHash); err != nil {
+			return nil, err
+		}
+		request = &proto.AbortTransactionRequest{
+			Shard:         shardId,
+			TransactionId: request.TransactionId,
+		}
+	} else if leader, err = r.assignmentDispatcher.Leader(namespace, request.Shard); err != nil {
+		return nil, err
+	}
+
+	client, err := r.pool.GetClientRpc(leader)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, constant.MetadataNamespace, namespace)
+	ctx = metadata.AppendToOutgoingContext(ctx, constant.MetadataShardId, fmt.Sprintf("%d", request.Shard))
+	return client.AbortTransaction(ctx, request)
+}
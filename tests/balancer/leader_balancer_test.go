@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/oxia-db/oxia/coordinator/model"
+	"github.com/oxia-db/oxia/coordinator/policies"
 	"github.com/oxia-db/oxia/coordinator/utils"
 	"github.com/oxia-db/oxia/tests/mock"
 )
@@ -292,3 +293,89 @@ func TestLeaderBalancedNodeAdded(t *testing.T) {
 		return true
 	}, 1*time.Minute, 1*time.Second)
 }
+
+func TestLeaderAffinityBalanced(t *testing.T) {
+	s1, s1ad := mock.NewServer(t, "sv-1")
+	defer s1.Close()
+	s2, s2ad := mock.NewServer(t, "sv-2")
+	defer s2.Close()
+	s3, s3ad := mock.NewServer(t, "sv-3")
+	defer s3.Close()
+
+	cc := &model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{
+			{
+				Name:              "ns-1",
+				InitialShardCount: 3,
+				ReplicationFactor: 3,
+			},
+			{
+				Name:              "ns-2",
+				InitialShardCount: 3,
+				ReplicationFactor: 3,
+			},
+		},
+		ServerMetadata: map[string]model.ServerMetadata{
+			s1ad.GetIdentifier(): {Labels: map[string]string{"zone": "us-east-1"}},
+			s2ad.GetIdentifier(): {Labels: map[string]string{"zone": "us-west-1"}},
+			s3ad.GetIdentifier(): {Labels: map[string]string{"zone": "us-west-1"}},
+		},
+		Servers: []model.Server{s1ad, s2ad, s3ad},
+	}
+
+	ch := make(chan any, 1)
+	coordinator := mock.NewCoordinator(t, cc, ch)
+	defer coordinator.Close()
+
+	statusResource := coordinator.StatusResource()
+	configResource := coordinator.ConfigResource()
+
+	assert.Eventually(t, func() bool {
+		status := statusResource.Load()
+		if len(status.Namespaces) != 2 {
+			return false
+		}
+		for _, ns := range status.Namespaces {
+			for _, shard := range ns.Shards {
+				if shard.Status != model.ShardStatusSteadyState {
+					return false
+				}
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+
+	// Prefer the leaders of ns-1 in us-east-1
+	cc.Namespaces[0].Policies = &policies.Policies{
+		LeaderAffinities: []policies.LeaderAffinity{
+			{Labels: map[string]string{"zone": "us-east-1"}},
+		},
+	}
+	ch <- struct{}{}
+
+	assert.Eventually(t, func() bool {
+		nc, exist := configResource.NamespaceConfig("ns-1")
+		return exist && nc.Policies != nil
+	}, 10*time.Second, 50*time.Millisecond)
+
+	balancer := coordinator.LoadBalancer()
+	assert.Eventually(t, func() bool {
+		balancer.Trigger()
+		for _, shard := range statusResource.Load().Namespaces["ns-1"].Shards {
+			if shard.Status != model.ShardStatusSteadyState || shard.Leader == nil ||
+				shard.Leader.GetIdentifier() != s1ad.GetIdentifier() {
+				return false
+			}
+		}
+		return true
+	}, 30*time.Second, 50*time.Millisecond)
+
+	// The leader count balancing must not move the leaders of ns-1 away
+	for range 10 {
+		balancer.Trigger()
+		time.Sleep(50 * time.Millisecond)
+	}
+	for _, shard := range statusResource.Load().Namespaces["ns-1"].Shards {
+		assert.Equal(t, s1ad.GetIdentifier(), shard.Leader.GetIdentifier())
+	}
+}
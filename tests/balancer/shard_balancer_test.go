@@ -212,6 +212,105 @@ func TestPolicyBasedShardBalancer(t *testing.T) {
 	}
 }
 
+func TestNodeAffinityShardBalancer(t *testing.T) {
+	s1, s1ad := mock.NewServer(t, "sv-1")
+	defer s1.Close()
+	s2, s2ad := mock.NewServer(t, "sv-2")
+	defer s2.Close()
+	s3, s3ad := mock.NewServer(t, "sv-3")
+	defer s3.Close()
+	s4, s4ad := mock.NewServer(t, "sv-4")
+	defer s4.Close()
+	s5, s5ad := mock.NewServer(t, "sv-5")
+	defer s5.Close()
+	s6, s6ad := mock.NewServer(t, "sv-6")
+	defer s6.Close()
+	ssdMetadata := model.ServerMetadata{
+		Labels: map[string]string{"disk": "ssd"},
+		Taints: []policies.Taint{{Key: "dedicated", Value: "critical", Effect: policies.NoSchedule}},
+	}
+	hddMetadata := model.ServerMetadata{
+		Labels: map[string]string{"disk": "hdd"},
+	}
+	serverMetadata := map[string]model.ServerMetadata{
+		s1ad.GetIdentifier(): ssdMetadata,
+		s2ad.GetIdentifier(): ssdMetadata,
+		s3ad.GetIdentifier(): hddMetadata,
+		s4ad.GetIdentifier(): hddMetadata,
+		s5ad.GetIdentifier(): hddMetadata,
+		s6ad.GetIdentifier(): ssdMetadata,
+	}
+
+	cc := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{
+			{
+				Name:              "critical",
+				InitialShardCount: 3,
+				ReplicationFactor: 2,
+				Policies: &policies.Policies{
+					NodeAffinities: []policies.NodeAffinity{
+						{Labels: map[string]string{"disk": "ssd"}, Mode: policies.Required},
+					},
+					Tolerations: []policies.Toleration{{Key: "dedicated"}},
+				},
+			},
+			{
+				Name:              "default",
+				InitialShardCount: 3,
+				ReplicationFactor: 2,
+			},
+		},
+		ServerMetadata: serverMetadata,
+		Servers:        []model.Server{s1ad, s2ad, s3ad, s4ad},
+	}
+
+	ch := make(chan any, 1)
+	coordinator := mock.NewCoordinator(t, &cc, ch)
+	defer coordinator.Close()
+
+	statusResource := coordinator.StatusResource()
+	configResource := coordinator.ConfigResource()
+
+	assert.Eventually(t, func() bool {
+		for _, ns := range statusResource.Load().Namespaces {
+			for _, shard := range ns.Shards {
+				if shard.Status != model.ShardStatusSteadyState {
+					return false
+				}
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+
+	cc.Servers = append(cc.Servers, s5ad, s6ad)
+	ch <- struct{}{}
+
+	assert.Eventually(t, func() bool {
+		_, exist := configResource.Node(s6ad.GetIdentifier())
+		return exist
+	}, 10*time.Second, 50*time.Millisecond)
+
+	balancer := coordinator.LoadBalancer()
+	assert.Eventually(t, func() bool {
+		balancer.Trigger()
+		return balancer.IsBalanced()
+	}, 30*time.Second, 50*time.Millisecond)
+
+	// check if follow the policies
+	for name, ns := range statusResource.Load().Namespaces {
+		for _, shard := range ns.Shards {
+			for _, server := range shard.Ensemble {
+				disk := serverMetadata[server.GetIdentifier()].Labels["disk"]
+				if name == "critical" {
+					assert.Equal(t, "ssd", disk)
+				} else {
+					assert.Equal(t, "hdd", disk)
+				}
+			}
+		}
+	}
+}
+
 func TestBalanceWithoutDeadlock(t *testing.T) {
 	// init 3 nodes
 	s1, s1ad := mock.NewServer(t, "sv-1")
@@ -19,21 +19,28 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
+	"path/filepath"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/oxia-db/oxia/coordinator"
 	"github.com/oxia-db/oxia/coordinator/metadata"
 	rpc2 "github.com/oxia-db/oxia/coordinator/rpc"
 
+	"github.com/oxia-db/oxia/common/concurrent"
 	"github.com/oxia-db/oxia/common/constant"
 	"github.com/oxia-db/oxia/common/rpc"
 
 	"github.com/oxia-db/oxia/coordinator/model"
 	"github.com/oxia-db/oxia/oxia"
+	"github.com/oxia-db/oxia/proto"
 	"github.com/oxia-db/oxia/server"
 )
 
@@ -340,6 +347,34 @@ func TestCoordinator_MultipleNamespaces(t *testing.T) {
 	assert.EqualValues(t, []byte("my-value"), res)
 	assert.EqualValues(t, 0, version3.ModificationsCount)
 
+	// Access the other namespaces from the default client
+	clientNs2, err := clientDefault.Namespace("my-ns-2")
+	assert.NoError(t, err)
+
+	_, res, _, err = clientNs2.Get(ctx, "my-key")
+	assert.ErrorIs(t, err, oxia.ErrKeyNotFound)
+	assert.Nil(t, res)
+
+	_, _, err = clientNs2.Put(ctx, "my-key", []byte("my-value-3"))
+	assert.NoError(t, err)
+
+	sharedClientNs1, err := clientDefault.Namespace("my-ns-1")
+	assert.NoError(t, err)
+	_, res, _, err = sharedClientNs1.Get(ctx, "my-key")
+	assert.NoError(t, err)
+	assert.EqualValues(t, []byte("my-value-2"), res)
+
+	_, err = clientDefault.Namespace("my-ns-does-not-exist")
+	assert.ErrorIs(t, err, constant.ErrNamespaceNotFound)
+
+	// Closing a namespace client does not affect the others
+	assert.NoError(t, clientNs2.Close())
+	assert.NoError(t, sharedClientNs1.Close())
+
+	_, res, _, err = clientDefault.Get(ctx, "my-key")
+	assert.NoError(t, err)
+	assert.EqualValues(t, []byte("my-value"), res)
+
 	assert.NoError(t, coordinatorInstance.Close())
 	assert.NoError(t, clientPool.Close())
 
@@ -524,63 +559,141 @@ func TestCoordinator_DynamicallAddNamespace(t *testing.T) {
 	}
 }
 
-func TestCoordinator_AddRemoveNodes(t *testing.T) {
+func TestCoordinator_AdminNamespaces(t *testing.T) {
 	s1, sa1 := newServer(t)
 	s2, sa2 := newServer(t)
 	s3, sa3 := newServer(t)
-	s4, sa4 := newServer(t)
-	s5, sa5 := newServer(t)
 	servers := map[model.Server]*server.Server{
 		sa1: s1,
 		sa2: s2,
 		sa3: s3,
-		sa4: s4,
-		sa5: s5,
 	}
 
-	metadataProvider := metadata.NewMetadataProviderMemory()
 	clusterConfig := model.ClusterConfig{
 		Namespaces: []model.NamespaceConfig{{
 			Name:              "my-ns-1",
 			ReplicationFactor: 1,
-			InitialShardCount: 2,
+			InitialShardCount: 1,
 		}},
 		Servers: []model.Server{sa1, sa2, sa3},
 	}
-	clientPool := rpc.NewClientPool(nil, nil)
-
-	configProvider := func() (model.ClusterConfig, error) {
-		return clusterConfig, nil
+	coordinatorConfig := coordinator.Config{
+		InternalServiceAddr:  "localhost:0",
+		MetricsServiceAddr:   "localhost:0",
+		MetadataProviderName: metadata.ProviderNameFile,
+		FileMetadataPath:     filepath.Join(t.TempDir(), "metadata"),
+		ClusterConfigProvider: func() (model.ClusterConfig, error) {
+			return clusterConfig, nil
+		},
 	}
+	clientPool := rpc.NewClientPool(nil, nil)
 
-	configChangesCh := make(chan any)
-	c, err := coordinator.NewCoordinator(metadataProvider, configProvider, configChangesCh, rpc2.NewRpcProvider(clientPool))
+	coordinatorServer, err := coordinator.NewGrpcServer(coordinatorConfig)
+	assert.NoError(t, err)
+	admin, err := clientPool.GetAdminRpc(fmt.Sprintf("localhost:%d", coordinatorServer.InternalPort()))
 	assert.NoError(t, err)
 
-	assert.Equal(t, 3, len(c.NodeControllers()))
+	ctx := context.Background()
 
-	// Add s4, s5
-	clusterConfig.Servers = append(clusterConfig.Servers, sa4, sa5)
-	// Remove s1
-	clusterConfig.Servers = clusterConfig.Servers[1:]
+	_, err = admin.CreateNamespace(ctx, &proto.CreateNamespaceRequest{Config: &proto.NamespaceConfig{
+		Name:              "my-ns-2",
+		InitialShardCount: 1,
+		ReplicationFactor: 4,
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = admin.CreateNamespace(ctx, &proto.CreateNamespaceRequest{Config: &proto.NamespaceConfig{
+		Name:              "my-ns-1",
+		InitialShardCount: 1,
+		ReplicationFactor: 1,
+	}})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
 
-	configChangesCh <- nil
+	_, err = admin.CreateNamespace(ctx, &proto.CreateNamespaceRequest{Config: &proto.NamespaceConfig{
+		Name:              "my-ns-2",
+		InitialShardCount: 2,
+		ReplicationFactor: 2,
+	}})
+	assert.NoError(t, err)
 
-	// Wait for all shards to be ready
+	// Wait for the shards of the new namespace to be ready
 	assert.Eventually(t, func() bool {
-		return len(c.NodeControllers()) == 4
+		res, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-2"})
+		if err != nil || len(res.Shards) != 2 {
+			return false
+		}
+		for _, shard := range res.Shards {
+			if shard.Status != model.ShardStatusSteadyState.String() {
+				return false
+			}
+		}
+		return true
 	}, 10*time.Second, 10*time.Millisecond)
 
-	_, ok := c.NodeControllers()[sa1.Internal]
-	assert.False(t, ok)
+	client, err := oxia.NewSyncClient(sa1.Public, oxia.WithNamespace("my-ns-2"))
+	assert.NoError(t, err)
+	_, _, err = client.Put(ctx, "my-key", []byte("my-value"))
+	assert.NoError(t, err)
+	_, res, _, err := client.Get(ctx, "my-key")
+	assert.NoError(t, err)
+	assert.EqualValues(t, []byte("my-value"), res)
+	assert.NoError(t, client.Close())
 
-	_, ok = c.NodeControllers()[sa4.Internal]
-	assert.True(t, ok)
+	listRes, err := admin.ListNamespaces(ctx, &proto.ListNamespacesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(listRes.Namespaces))
+	assert.Equal(t, "my-ns-1", listRes.Namespaces[0].Config.Name)
+	assert.False(t, listRes.Namespaces[0].Dynamic)
+	assert.Equal(t, "my-ns-2", listRes.Namespaces[1].Config.Name)
+	assert.True(t, listRes.Namespaces[1].Dynamic)
+	assert.EqualValues(t, 2, listRes.Namespaces[1].Config.ReplicationFactor)
+	assert.True(t, listRes.Namespaces[1].Config.GetNotificationsEnabled())
 
-	_, ok = c.NodeControllers()[sa5.Internal]
-	assert.True(t, ok)
+	_, err = admin.DeleteNamespace(ctx, &proto.DeleteNamespaceRequest{Namespace: "my-ns-1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
 
-	assert.NoError(t, c.Close())
+	_, err = admin.DeleteNamespace(ctx, &proto.DeleteNamespaceRequest{Namespace: "my-ns-3"})
+	assert.Equal(t, constant.CodeNamespaceNotFound, status.Code(err))
+
+	_, err = admin.PauseBalancer(ctx, &proto.PauseBalancerRequest{})
+	assert.NoError(t, err)
+
+	// The namespace is still there after restarting the coordinator, and
+	// the balancer is still paused
+	assert.NoError(t, coordinatorServer.Close())
+	clientPool.Clear(fmt.Sprintf("localhost:%d", coordinatorServer.InternalPort()))
+
+	coordinatorServer, err = coordinator.NewGrpcServer(coordinatorConfig)
+	assert.NoError(t, err)
+	admin, err = clientPool.GetAdminRpc(fmt.Sprintf("localhost:%d", coordinatorServer.InternalPort()))
+	assert.NoError(t, err)
+
+	describeRes, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-2"})
+	assert.NoError(t, err)
+	assert.True(t, describeRes.Namespace.Dynamic)
+	assert.Equal(t, 2, len(describeRes.Shards))
+
+	balancerRes, err := admin.GetBalancerStatus(ctx, &proto.GetBalancerStatusRequest{})
+	assert.NoError(t, err)
+	assert.True(t, balancerRes.Paused)
+
+	_, err = admin.DeleteNamespace(ctx, &proto.DeleteNamespaceRequest{Namespace: "my-ns-2"})
+	assert.NoError(t, err)
+
+	_, err = admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-2"})
+	assert.Equal(t, constant.CodeNamespaceNotFound, status.Code(err))
+
+	// The namespace can be created again once its shards are deleted
+	assert.Eventually(t, func() bool {
+		_, err := admin.CreateNamespace(ctx, &proto.CreateNamespaceRequest{Config: &proto.NamespaceConfig{
+			Name:              "my-ns-2",
+			InitialShardCount: 1,
+			ReplicationFactor: 1,
+		}})
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, coordinatorServer.Close())
 	assert.NoError(t, clientPool.Close())
 
 	for _, serverObj := range servers {
@@ -588,86 +701,243 @@ func TestCoordinator_AddRemoveNodes(t *testing.T) {
 	}
 }
 
-func TestCoordinator_ShrinkCluster(t *testing.T) {
+func TestCoordinator_AdminShardOperations(t *testing.T) {
 	s1, sa1 := newServer(t)
 	s2, sa2 := newServer(t)
 	s3, sa3 := newServer(t)
-	s4, sa4 := newServer(t)
 	servers := map[model.Server]*server.Server{
 		sa1: s1,
 		sa2: s2,
 		sa3: s3,
-		sa4: s4,
 	}
 
-	metadataProvider := metadata.NewMetadataProviderMemory()
 	clusterConfig := model.ClusterConfig{
 		Namespaces: []model.NamespaceConfig{{
 			Name:              "my-ns-1",
-			ReplicationFactor: 1,
+			ReplicationFactor: 2,
 			InitialShardCount: 1,
 		}},
-		Servers: []model.Server{sa1, sa2, sa3, sa4},
+		Servers: []model.Server{sa1, sa2, sa3},
 	}
-	clientPool := rpc.NewClientPool(nil, nil)
-
-	configProvider := func() (model.ClusterConfig, error) {
-		return clusterConfig, nil
+	coordinatorConfig := coordinator.Config{
+		InternalServiceAddr:  "localhost:0",
+		MetricsServiceAddr:   "localhost:0",
+		MetadataProviderName: metadata.ProviderNameMemory,
+		ClusterConfigProvider: func() (model.ClusterConfig, error) {
+			return clusterConfig, nil
+		},
 	}
+	clientPool := rpc.NewClientPool(nil, nil)
 
-	configChangesCh := make(chan any)
-	c, err := coordinator.NewCoordinator(metadataProvider, configProvider, configChangesCh, rpc2.NewRpcProvider(clientPool))
+	coordinatorServer, err := coordinator.NewGrpcServer(coordinatorConfig)
+	assert.NoError(t, err)
+	admin, err := clientPool.GetAdminRpc(fmt.Sprintf("localhost:%d", coordinatorServer.InternalPort()))
 	assert.NoError(t, err)
 
-	statusResource := c.StatusResource()
+	ctx := context.Background()
 
-	// Wait for all shards to be ready
+	// Keep the balancer from moving the shard around while the test runs
+	_, err = admin.PauseBalancer(ctx, &proto.PauseBalancerRequest{})
+	assert.NoError(t, err)
+	balancerRes, err := admin.GetBalancerStatus(ctx, &proto.GetBalancerStatusRequest{})
+	assert.NoError(t, err)
+	assert.True(t, balancerRes.Paused)
+
+	var shard *proto.ShardInfo
 	assert.Eventually(t, func() bool {
-		for _, ns := range statusResource.Load().Namespaces {
-			for _, shard := range ns.Shards {
-				if shard.Status != model.ShardStatusSteadyState {
-					return false
-				}
-			}
+		res, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+		if err != nil || len(res.Shards) != 1 || res.Shards[0].Status != model.ShardStatusSteadyState.String() {
+			return false
 		}
+		shard = res.Shards[0]
 		return true
 	}, 10*time.Second, 10*time.Millisecond)
 
-	assert.Equal(t, 4, len(c.NodeControllers()))
+	nodesRes, err := admin.ListNodes(ctx, &proto.ListNodesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(nodesRes.Nodes))
+	var leaderShards, replicaShards uint32
+	for _, node := range nodesRes.Nodes {
+		assert.Equal(t, "Running", node.Status)
+		leaderShards += node.LeaderShards
+		replicaShards += node.ReplicaShards
+	}
+	assert.EqualValues(t, 1, leaderShards)
+	assert.EqualValues(t, 2, replicaShards)
 
-	// Remove leader server
-	leaderID := statusResource.Load().Namespaces["my-ns-1"].Shards[0].Leader.GetIdentifier()
-	d := make([]model.Server, 0)
-	for _, sv := range clusterConfig.Servers {
-		if sv.GetIdentifier() != leaderID {
-			d = append(d, sv)
+	// Trigger a new election
+	electRes, err := admin.ElectLeader(ctx, &proto.ElectLeaderRequest{Namespace: "my-ns-1", Shard: shard.Shard})
+	assert.NoError(t, err)
+	assert.Contains(t, shard.Ensemble, electRes.Leader)
+
+	describeRes, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+	assert.NoError(t, err)
+	assert.Less(t, shard.Term, describeRes.Shards[0].Term)
+
+	_, err = admin.ElectLeader(ctx, &proto.ElectLeaderRequest{Namespace: "my-ns-1", Shard: 10})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Move a replica to the node outside the ensemble
+	var outside string
+	for _, server := range clusterConfig.Servers {
+		if !slices.Contains(shard.Ensemble, server.GetIdentifier()) {
+			outside = server.GetIdentifier()
 		}
 	}
-	clusterConfig.Servers = d
+	_, err = admin.SwapNode(ctx, &proto.SwapNodeRequest{
+		Namespace: "my-ns-1", Shard: shard.Shard, From: shard.Ensemble[0], To: shard.Ensemble[1],
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
 
-	configChangesCh <- nil
-	assert.Eventually(t, func() bool {
-		return len(c.NodeControllers()) == 3
-	}, 10*time.Second, 10*time.Millisecond)
+	_, err = admin.SwapNode(ctx, &proto.SwapNodeRequest{
+		Namespace: "my-ns-1", Shard: shard.Shard, From: shard.Ensemble[0], To: outside,
+	})
+	assert.NoError(t, err)
+
+	describeRes, err = admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{shard.Ensemble[1], outside}, describeRes.Shards[0].Ensemble)
+
+	_, err = admin.DrainNode(ctx, &proto.DrainNodeRequest{Node: "does-not-exist"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = admin.ResumeBalancer(ctx, &proto.ResumeBalancerRequest{})
+	assert.NoError(t, err)
+	balancerRes, err = admin.GetBalancerStatus(ctx, &proto.GetBalancerStatusRequest{})
+	assert.NoError(t, err)
+	assert.False(t, balancerRes.Paused)
+
+	assert.NoError(t, coordinatorServer.Close())
+	assert.NoError(t, clientPool.Close())
+
+	for _, serverObj := range servers {
+		assert.NoError(t, serverObj.Close())
+	}
+}
+
+func TestCoordinator_AdminSessions(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	servers := map[model.Server]*server.Server{
+		sa1: s1,
+		sa2: s2,
+	}
+
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              "my-ns-1",
+			ReplicationFactor: 2,
+			InitialShardCount: 2,
+		}},
+		Servers: []model.Server{sa1, sa2},
+	}
+	coordinatorConfig := coordinator.Config{
+		InternalServiceAddr:  "localhost:0",
+		MetricsServiceAddr:   "localhost:0",
+		MetadataProviderName: metadata.ProviderNameMemory,
+		ClusterConfigProvider: func() (model.ClusterConfig, error) {
+			return clusterConfig, nil
+		},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorServer, err := coordinator.NewGrpcServer(coordinatorConfig)
+	assert.NoError(t, err)
+	admin, err := clientPool.GetAdminRpc(fmt.Sprintf("localhost:%d", coordinatorServer.InternalPort()))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = admin.PauseBalancer(ctx, &proto.PauseBalancerRequest{})
+	assert.NoError(t, err)
 
-	// Wait for all shards to be ready
 	assert.Eventually(t, func() bool {
-		for _, ns := range statusResource.Load().Namespaces {
-			for _, shard := range ns.Shards {
-				return shard.Term > 0 && shard.Status == model.ShardStatusSteadyState
+		res, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+		if err != nil || len(res.Shards) != 2 {
+			return false
+		}
+		for _, shard := range res.Shards {
+			if shard.Status != model.ShardStatusSteadyState.String() {
+				return false
 			}
 		}
 		return true
 	}, 10*time.Second, 10*time.Millisecond)
 
-	client, err := oxia.NewSyncClient(sa1.Public, oxia.WithNamespace("my-ns-1"))
+	listRes, err := admin.ListSessions(ctx, &proto.ListSessionsRequest{Namespace: "my-ns-1"})
 	assert.NoError(t, err)
+	assert.Empty(t, listRes.Sessions)
 
-	_, _, err = client.Put(context.Background(), "test", []byte("value"))
+	client, err := oxia.NewSyncClient(sa1.Public, oxia.WithNamespace("my-ns-1"),
+		oxia.WithIdentity("my-client"), oxia.WithSessionTimeout(10*time.Second))
 	assert.NoError(t, err)
 
-	assert.NoError(t, client.Close())
-	assert.NoError(t, c.Close())
+	_, _, err = client.Put(ctx, "/a", []byte("value"), oxia.Ephemeral(), oxia.PartitionKey("x"))
+	assert.NoError(t, err)
+	_, _, err = client.Put(ctx, "/b", []byte("value"), oxia.Ephemeral(), oxia.PartitionKey("x"))
+	assert.NoError(t, err)
+
+	listRes, err = admin.ListSessions(ctx, &proto.ListSessionsRequest{Namespace: "my-ns-1"})
+	assert.NoError(t, err)
+	assert.Len(t, listRes.Sessions, 1)
+	session := listRes.Sessions[0]
+	assert.Equal(t, "my-client", session.ClientIdentity)
+	assert.EqualValues(t, 10_000, session.TimeoutMs)
+	assert.NotEmpty(t, session.Leader)
+
+	listRes, err = admin.ListSessions(ctx, &proto.ListSessionsRequest{Namespace: "my-ns-1", Shard: &session.Shard})
+	assert.NoError(t, err)
+	assert.Len(t, listRes.Sessions, 1)
+
+	describeRes, err := admin.DescribeSession(ctx, &proto.DescribeSessionRequest{
+		Namespace: "my-ns-1", Shard: session.Shard, SessionId: session.SessionId,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, session.SessionId, describeRes.Session.SessionId)
+	assert.ElementsMatch(t, []string{"/a", "/b"}, describeRes.EphemeralKeys)
+
+	// Revoking the session deletes its ephemeral records
+	_, err = admin.RevokeSession(ctx, &proto.RevokeSessionRequest{
+		Namespace: "my-ns-1", Shard: session.Shard, SessionId: session.SessionId,
+	})
+	assert.NoError(t, err)
+
+	_, _, _, err = client.Get(ctx, "/a", oxia.PartitionKey("x"))
+	assert.ErrorIs(t, err, oxia.ErrKeyNotFound)
+	_, _, _, err = client.Get(ctx, "/b", oxia.PartitionKey("x"))
+	assert.ErrorIs(t, err, oxia.ErrKeyNotFound)
+
+	_, err = admin.DescribeSession(ctx, &proto.DescribeSessionRequest{
+		Namespace: "my-ns-1", Shard: session.Shard, SessionId: session.SessionId,
+	})
+	assert.Equal(t, constant.CodeSessionNotFound, status.Code(err))
+	_, err = admin.RevokeSession(ctx, &proto.RevokeSessionRequest{
+		Namespace: "my-ns-1", Shard: session.Shard, SessionId: session.SessionId,
+	})
+	assert.Equal(t, constant.CodeSessionNotFound, status.Code(err))
+
+	missingShard := int64(10)
+	_, err = admin.ListSessions(ctx, &proto.ListSessionsRequest{Namespace: "my-ns-1", Shard: &missingShard})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The client might fail to close the session that was revoked
+	_ = client.Close()
+
+	// The shards without an available leader are reported, instead of
+	// failing the whole listing
+	nsRes, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+	assert.NoError(t, err)
+	for sa, serverObj := range servers {
+		if sa.GetIdentifier() == nsRes.Shards[0].GetLeader() {
+			assert.NoError(t, serverObj.Close())
+			delete(servers, sa)
+		}
+	}
+	assert.Eventually(t, func() bool {
+		res, err := admin.ListSessions(ctx, &proto.ListSessionsRequest{Namespace: "my-ns-1"})
+		return err == nil && len(res.FailedShards) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, coordinatorServer.Close())
 	assert.NoError(t, clientPool.Close())
 
 	for _, serverObj := range servers {
@@ -675,12 +945,16 @@ func TestCoordinator_ShrinkCluster(t *testing.T) {
 	}
 }
 
-func TestCoordinator_RefreshServerInfo(t *testing.T) {
+func TestCoordinator_TransferLeadership(t *testing.T) {
 	s1, sa1 := newServer(t)
 	s2, sa2 := newServer(t)
 	s3, sa3 := newServer(t)
+	servers := map[model.Server]*server.Server{
+		sa1: s1,
+		sa2: s2,
+		sa3: s3,
+	}
 
-	metadataProvider := metadata.NewMetadataProviderMemory()
 	clusterConfig := model.ClusterConfig{
 		Namespaces: []model.NamespaceConfig{{
 			Name:              "my-ns-1",
@@ -689,44 +963,565 @@ func TestCoordinator_RefreshServerInfo(t *testing.T) {
 		}},
 		Servers: []model.Server{sa1, sa2, sa3},
 	}
-	configChangesCh := make(chan any)
-	c, err := coordinator.NewCoordinator(metadataProvider, func() (model.ClusterConfig, error) {
-		return clusterConfig, nil
-	}, configChangesCh,
-		rpc2.NewRpcProvider(rpc.NewClientPool(nil, nil)))
+	coordinatorConfig := coordinator.Config{
+		InternalServiceAddr:  "localhost:0",
+		MetricsServiceAddr:   "localhost:0",
+		MetadataProviderName: metadata.ProviderNameMemory,
+		ClusterConfigProvider: func() (model.ClusterConfig, error) {
+			return clusterConfig, nil
+		},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorServer, err := coordinator.NewGrpcServer(coordinatorConfig)
+	assert.NoError(t, err)
+	admin, err := clientPool.GetAdminRpc(fmt.Sprintf("localhost:%d", coordinatorServer.InternalPort()))
 	assert.NoError(t, err)
 
-	statusResource := c.StatusResource()
-	// wait for all shards to be ready
+	ctx := context.Background()
+	_, err = admin.PauseBalancer(ctx, &proto.PauseBalancerRequest{})
+	assert.NoError(t, err)
+
+	var shard *proto.ShardInfo
 	assert.Eventually(t, func() bool {
-		for _, ns := range statusResource.Load().Namespaces {
-			for _, shard := range ns.Shards {
-				if shard.Status != model.ShardStatusSteadyState {
-					return false
-				}
-			}
+		res, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+		if err != nil || len(res.Shards) != 1 || res.Shards[0].Status != model.ShardStatusSteadyState.String() {
+			return false
 		}
+		shard = res.Shards[0]
 		return true
 	}, 10*time.Second, 10*time.Millisecond)
 
-	// change the localhost to 127.0.0.1
-	clusterServer := make([]model.Server, 0)
-	for _, sv := range clusterConfig.Servers {
-		clusterServer = append(clusterServer, model.Server{
-			Public:   strings.ReplaceAll(sv.Public, "localhost", "127.0.0.1"),
-			Internal: sv.Internal,
-		})
+	client, err := oxia.NewSyncClient(sa1.Public, oxia.WithNamespace("my-ns-1"))
+	assert.NoError(t, err)
+
+	// Keep writing while the leadership moves around
+	writerCtx, cancelWriter := context.WithCancel(ctx)
+	writes := concurrent.NewWaitGroup(1)
+	writtenKeys := atomic.Int64{}
+	go func() {
+		for i := 0; writerCtx.Err() == nil; i++ {
+			if _, _, err := client.Put(ctx, fmt.Sprintf("key-%d", i), []byte("value")); err != nil {
+				writes.Fail(err)
+				return
+			}
+			writtenKeys.Add(1)
+		}
+		writes.Done()
+	}()
+
+	leader := shard.GetLeader()
+	term := shard.Term
+	for _, to := range []string{shard.Ensemble[0], shard.Ensemble[1], shard.Ensemble[2]} {
+		if to == leader {
+			// The leader is already in place
+			res, err := admin.TransferLeader(ctx, &proto.TransferLeaderRequest{Namespace: "my-ns-1", Shard: shard.Shard, To: to})
+			assert.NoError(t, err)
+			assert.Equal(t, to, res.Leader)
+			continue
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		start := time.Now()
+		res, err := admin.TransferLeader(ctx, &proto.TransferLeaderRequest{Namespace: "my-ns-1", Shard: shard.Shard, To: to})
+		assert.NoError(t, err)
+		assert.Equal(t, to, res.Leader)
+		leader = to
+		term++
+
+		// The writes resume as soon as the new leader takes over, without
+		// waiting for the hand-off timeout
+		_, _, err = client.Put(ctx, "transferred-to", []byte(to))
+		assert.NoError(t, err)
+		assert.Less(t, time.Since(start), 2*time.Second)
 	}
 
-	clusterConfig.Servers = clusterServer
-	configChangesCh <- nil
+	describeRes, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, leader, describeRes.Shards[0].GetLeader())
+	assert.Equal(t, term, describeRes.Shards[0].Term)
 
-	assert.Eventually(t, func() bool {
-		for _, ns := range statusResource.Load().Namespaces {
-			for _, shard := range ns.Shards {
-				if shard.Status != model.ShardStatusSteadyState {
-					return false
-				}
+	_, err = admin.TransferLeader(ctx, &proto.TransferLeaderRequest{Namespace: "my-ns-1", Shard: shard.Shard, To: "does-not-exist"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// None of the writes has failed
+	time.Sleep(100 * time.Millisecond)
+	cancelWriter()
+	assert.NoError(t, writes.Wait(ctx))
+	assert.Positive(t, writtenKeys.Load())
+
+	_, value, _, err := client.Get(ctx, "key-0")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+	assert.NoError(t, client.Close())
+
+	assert.NoError(t, coordinatorServer.Close())
+	assert.NoError(t, clientPool.Close())
+
+	for _, serverObj := range servers {
+		assert.NoError(t, serverObj.Close())
+	}
+}
+
+func TestCoordinator_SplitShardAdmin(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+	servers := map[model.Server]*server.Server{
+		sa1: s1,
+		sa2: s2,
+		sa3: s3,
+	}
+
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              "my-ns-1",
+			ReplicationFactor: 3,
+			InitialShardCount: 1,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	coordinatorConfig := coordinator.Config{
+		InternalServiceAddr:  "localhost:0",
+		MetricsServiceAddr:   "localhost:0",
+		MetadataProviderName: metadata.ProviderNameMemory,
+		ClusterConfigProvider: func() (model.ClusterConfig, error) {
+			return clusterConfig, nil
+		},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorServer, err := coordinator.NewGrpcServer(coordinatorConfig)
+	assert.NoError(t, err)
+	admin, err := clientPool.GetAdminRpc(fmt.Sprintf("localhost:%d", coordinatorServer.InternalPort()))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = admin.PauseBalancer(ctx, &proto.PauseBalancerRequest{})
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		res, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+		return err == nil && len(res.Shards) == 1 && res.Shards[0].Status == model.ShardStatusSteadyState.String()
+	}, 10*time.Second, 10*time.Millisecond)
+
+	res, err := admin.Split(ctx, &proto.SplitRequest{Namespace: "my-ns-1", Shard: 0})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, res.Children)
+
+	// The split shard is removed once its replicas are deleted
+	assert.Eventually(t, func() bool {
+		res, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+		return err == nil && len(res.Shards) == 2 && res.Shards[0].Shard == 1 && res.Shards[1].Shard == 2
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, err = admin.Split(ctx, &proto.SplitRequest{Namespace: "my-ns-1", Shard: 0})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.NoError(t, coordinatorServer.Close())
+	assert.NoError(t, clientPool.Close())
+
+	for _, serverObj := range servers {
+		assert.NoError(t, serverObj.Close())
+	}
+}
+
+func TestCoordinator_MergeShardsAdmin(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+	servers := map[model.Server]*server.Server{
+		sa1: s1,
+		sa2: s2,
+		sa3: s3,
+	}
+
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              "my-ns-1",
+			ReplicationFactor: 3,
+			InitialShardCount: 3,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	coordinatorConfig := coordinator.Config{
+		InternalServiceAddr:  "localhost:0",
+		MetricsServiceAddr:   "localhost:0",
+		MetadataProviderName: metadata.ProviderNameMemory,
+		ClusterConfigProvider: func() (model.ClusterConfig, error) {
+			return clusterConfig, nil
+		},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorServer, err := coordinator.NewGrpcServer(coordinatorConfig)
+	assert.NoError(t, err)
+	admin, err := clientPool.GetAdminRpc(fmt.Sprintf("localhost:%d", coordinatorServer.InternalPort()))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = admin.PauseBalancer(ctx, &proto.PauseBalancerRequest{})
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		res, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+		if err != nil || len(res.Shards) != 3 {
+			return false
+		}
+		for _, shard := range res.Shards {
+			if shard.Status != model.ShardStatusSteadyState.String() {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The shards 0 and 2 do not have adjacent hash ranges
+	_, err = admin.Merge(ctx, &proto.MergeRequest{Namespace: "my-ns-1", Shard: 0, OtherShard: 2})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := admin.Merge(ctx, &proto.MergeRequest{Namespace: "my-ns-1", Shard: 1, OtherShard: 0})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, res.Shard)
+
+	// The merged shards are removed once their replicas are deleted
+	assert.Eventually(t, func() bool {
+		res, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+		return err == nil && len(res.Shards) == 2 && res.Shards[0].Shard == 2 && res.Shards[1].Shard == 3
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, err = admin.Merge(ctx, &proto.MergeRequest{Namespace: "my-ns-1", Shard: 0, OtherShard: 2})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.NoError(t, coordinatorServer.Close())
+	assert.NoError(t, clientPool.Close())
+
+	for _, serverObj := range servers {
+		assert.NoError(t, serverObj.Close())
+	}
+}
+
+func TestCoordinator_DrainNode(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+	servers := map[model.Server]*server.Server{
+		sa1: s1,
+		sa2: s2,
+		sa3: s3,
+	}
+
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              "my-ns-1",
+			ReplicationFactor: 2,
+			InitialShardCount: 3,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	coordinatorConfig := coordinator.Config{
+		InternalServiceAddr:  "localhost:0",
+		MetricsServiceAddr:   "localhost:0",
+		MetadataProviderName: metadata.ProviderNameMemory,
+		ClusterConfigProvider: func() (model.ClusterConfig, error) {
+			return clusterConfig, nil
+		},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorServer, err := coordinator.NewGrpcServer(coordinatorConfig)
+	assert.NoError(t, err)
+	admin, err := clientPool.GetAdminRpc(fmt.Sprintf("localhost:%d", coordinatorServer.InternalPort()))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	assert.Eventually(t, func() bool {
+		res, err := admin.DescribeNamespace(ctx, &proto.DescribeNamespaceRequest{Namespace: "my-ns-1"})
+		if err != nil {
+			return false
+		}
+		for _, shard := range res.Shards {
+			if shard.Status != model.ShardStatusSteadyState.String() {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
+	client, err := oxia.NewSyncClient(sa1.Public, oxia.WithNamespace("my-ns-1"))
+	assert.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		_, _, err = client.Put(ctx, fmt.Sprintf("key-%d", i), []byte("value"))
+		assert.NoError(t, err)
+	}
+
+	node := sa1.GetIdentifier()
+	_, err = admin.GetDrainStatus(ctx, &proto.GetDrainStatusRequest{Node: node})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// A drain can be canceled while in progress
+	_, err = admin.DrainNode(ctx, &proto.DrainNodeRequest{Node: sa2.GetIdentifier()})
+	assert.NoError(t, err)
+	_, err = admin.CancelDrain(ctx, &proto.CancelDrainRequest{Node: sa2.GetIdentifier()})
+	assert.NoError(t, err)
+	_, err = admin.GetDrainStatus(ctx, &proto.GetDrainStatusRequest{Node: sa2.GetIdentifier()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = admin.CancelDrain(ctx, &proto.CancelDrainRequest{Node: sa2.GetIdentifier()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	drainRes, err := admin.DrainNode(ctx, &proto.DrainNodeRequest{Node: node})
+	assert.NoError(t, err)
+	assert.Equal(t, node, drainRes.Status.Node)
+	assert.False(t, drainRes.Status.SafeToShutdown)
+
+	var drainStatus *proto.DrainStatus
+	assert.Eventually(t, func() bool {
+		res, err := admin.GetDrainStatus(ctx, &proto.GetDrainStatusRequest{Node: node})
+		assert.NoError(t, err)
+		drainStatus = res.Status
+		return drainStatus.Phase == coordinator.Drained.String()
+	}, 30*time.Second, 10*time.Millisecond)
+	assert.True(t, drainStatus.SafeToShutdown)
+	assert.EqualValues(t, 0, drainStatus.LeaderShards)
+	assert.EqualValues(t, 0, drainStatus.ReplicaShards)
+	assert.Nil(t, drainStatus.Error)
+
+	nodesRes, err := admin.ListNodes(ctx, &proto.ListNodesRequest{})
+	assert.NoError(t, err)
+	for _, n := range nodesRes.Nodes {
+		assert.Equal(t, n.Id == node, n.Draining)
+	}
+
+	// The drained node is not selected again by the load balancer. Resuming
+	// the balancer triggers a new rebalance.
+	_, err = admin.ResumeBalancer(ctx, &proto.ResumeBalancerRequest{})
+	assert.NoError(t, err)
+	time.Sleep(500 * time.Millisecond)
+	res, err := admin.GetDrainStatus(ctx, &proto.GetDrainStatusRequest{Node: node})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, res.Status.ReplicaShards)
+
+	// The data is still available, with the node shut down
+	assert.NoError(t, s1.Close())
+	delete(servers, sa1)
+	for i := 0; i < 10; i++ {
+		_, value, _, err := client.Get(ctx, fmt.Sprintf("key-%d", i))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+	}
+	assert.NoError(t, client.Close())
+
+	assert.NoError(t, coordinatorServer.Close())
+	assert.NoError(t, clientPool.Close())
+
+	for _, serverObj := range servers {
+		assert.NoError(t, serverObj.Close())
+	}
+}
+
+func TestCoordinator_AddRemoveNodes(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+	s4, sa4 := newServer(t)
+	s5, sa5 := newServer(t)
+	servers := map[model.Server]*server.Server{
+		sa1: s1,
+		sa2: s2,
+		sa3: s3,
+		sa4: s4,
+		sa5: s5,
+	}
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              "my-ns-1",
+			ReplicationFactor: 1,
+			InitialShardCount: 2,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	configProvider := func() (model.ClusterConfig, error) {
+		return clusterConfig, nil
+	}
+
+	configChangesCh := make(chan any)
+	c, err := coordinator.NewCoordinator(metadataProvider, configProvider, configChangesCh, rpc2.NewRpcProvider(clientPool))
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, len(c.NodeControllers()))
+
+	// Add s4, s5
+	clusterConfig.Servers = append(clusterConfig.Servers, sa4, sa5)
+	// Remove s1
+	clusterConfig.Servers = clusterConfig.Servers[1:]
+
+	configChangesCh <- nil
+
+	// Wait for all shards to be ready
+	assert.Eventually(t, func() bool {
+		return len(c.NodeControllers()) == 4
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, ok := c.NodeControllers()[sa1.Internal]
+	assert.False(t, ok)
+
+	_, ok = c.NodeControllers()[sa4.Internal]
+	assert.True(t, ok)
+
+	_, ok = c.NodeControllers()[sa5.Internal]
+	assert.True(t, ok)
+
+	assert.NoError(t, c.Close())
+	assert.NoError(t, clientPool.Close())
+
+	for _, serverObj := range servers {
+		assert.NoError(t, serverObj.Close())
+	}
+}
+
+func TestCoordinator_ShrinkCluster(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+	s4, sa4 := newServer(t)
+	servers := map[model.Server]*server.Server{
+		sa1: s1,
+		sa2: s2,
+		sa3: s3,
+		sa4: s4,
+	}
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              "my-ns-1",
+			ReplicationFactor: 1,
+			InitialShardCount: 1,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3, sa4},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	configProvider := func() (model.ClusterConfig, error) {
+		return clusterConfig, nil
+	}
+
+	configChangesCh := make(chan any)
+	c, err := coordinator.NewCoordinator(metadataProvider, configProvider, configChangesCh, rpc2.NewRpcProvider(clientPool))
+	assert.NoError(t, err)
+
+	statusResource := c.StatusResource()
+
+	// Wait for all shards to be ready
+	assert.Eventually(t, func() bool {
+		for _, ns := range statusResource.Load().Namespaces {
+			for _, shard := range ns.Shards {
+				if shard.Status != model.ShardStatusSteadyState {
+					return false
+				}
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, 4, len(c.NodeControllers()))
+
+	// Remove leader server
+	leaderID := statusResource.Load().Namespaces["my-ns-1"].Shards[0].Leader.GetIdentifier()
+	d := make([]model.Server, 0)
+	for _, sv := range clusterConfig.Servers {
+		if sv.GetIdentifier() != leaderID {
+			d = append(d, sv)
+		}
+	}
+	clusterConfig.Servers = d
+
+	configChangesCh <- nil
+	assert.Eventually(t, func() bool {
+		return len(c.NodeControllers()) == 3
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Wait for all shards to be ready
+	assert.Eventually(t, func() bool {
+		for _, ns := range statusResource.Load().Namespaces {
+			for _, shard := range ns.Shards {
+				return shard.Term > 0 && shard.Status == model.ShardStatusSteadyState
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
+	client, err := oxia.NewSyncClient(sa1.Public, oxia.WithNamespace("my-ns-1"))
+	assert.NoError(t, err)
+
+	_, _, err = client.Put(context.Background(), "test", []byte("value"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, c.Close())
+	assert.NoError(t, clientPool.Close())
+
+	for _, serverObj := range servers {
+		assert.NoError(t, serverObj.Close())
+	}
+}
+
+func TestCoordinator_RefreshServerInfo(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              "my-ns-1",
+			ReplicationFactor: 3,
+			InitialShardCount: 1,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	configChangesCh := make(chan any)
+	c, err := coordinator.NewCoordinator(metadataProvider, func() (model.ClusterConfig, error) {
+		return clusterConfig, nil
+	}, configChangesCh,
+		rpc2.NewRpcProvider(rpc.NewClientPool(nil, nil)))
+	assert.NoError(t, err)
+
+	statusResource := c.StatusResource()
+	// wait for all shards to be ready
+	assert.Eventually(t, func() bool {
+		for _, ns := range statusResource.Load().Namespaces {
+			for _, shard := range ns.Shards {
+				if shard.Status != model.ShardStatusSteadyState {
+					return false
+				}
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// change the localhost to 127.0.0.1
+	clusterServer := make([]model.Server, 0)
+	for _, sv := range clusterConfig.Servers {
+		clusterServer = append(clusterServer, model.Server{
+			Public:   strings.ReplaceAll(sv.Public, "localhost", "127.0.0.1"),
+			Internal: sv.Internal,
+		})
+	}
+
+	clusterConfig.Servers = clusterServer
+	configChangesCh <- nil
+
+	assert.Eventually(t, func() bool {
+		for _, ns := range statusResource.Load().Namespaces {
+			for _, shard := range ns.Shards {
+				if shard.Status != model.ShardStatusSteadyState {
+					return false
+				}
 				for _, sv := range shard.Ensemble {
 					if !strings.HasPrefix(sv.Public, "127.0.0.1") {
 						return false
@@ -737,12 +1532,643 @@ func TestCoordinator_RefreshServerInfo(t *testing.T) {
 		return true
 	}, 10*time.Second, 10*time.Millisecond)
 
-	err = s1.Close()
+	err = s1.Close()
+	assert.NoError(t, err)
+	err = s2.Close()
+	assert.NoError(t, err)
+	err = s3.Close()
+	assert.NoError(t, err)
+	err = c.Close()
+	assert.NoError(t, err)
+}
+
+func TestCoordinator_SplitShard(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              constant.DefaultNamespace,
+			ReplicationFactor: 3,
+			InitialShardCount: 1,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorInstance, err := coordinator.NewCoordinator(metadataProvider, func() (model.ClusterConfig, error) { return clusterConfig, nil }, nil, rpc2.NewRpcProvider(clientPool))
+	assert.NoError(t, err)
+
+	statusResource := coordinatorInstance.StatusResource()
+	assert.Eventually(t, func() bool {
+		shard := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards[0]
+		return shard.Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	client, err := oxia.NewSyncClient(sa1.Public)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 100; i++ {
+		_, _, err := client.Put(ctx, fmt.Sprintf("key-%d", i), []byte(fmt.Sprintf("value-%d", i)))
+		assert.NoError(t, err)
+	}
+
+	children, err := coordinatorInstance.SplitShard(constant.DefaultNamespace, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, children)
+
+	// The load balancer might be moving the leaders of the new shards around
+	assert.Eventually(t, func() bool {
+		shards := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards
+		_, parentExists := shards[0]
+		return !parentExists && len(shards) == 2 &&
+			shards[1].Status == model.ShardStatusSteadyState && shards[2].Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	shards := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards
+	left, right := shards[1], shards[2]
+	assert.EqualValues(t, 0, left.Int32HashRange.Min)
+	assert.EqualValues(t, left.Int32HashRange.Max+1, right.Int32HashRange.Min)
+	assert.EqualValues(t, math.MaxUint32, right.Int32HashRange.Max)
+
+	// All the records must still be readable, through the new shards
+	for i := 0; i < 100; i++ {
+		_, value, _, err := client.Get(ctx, fmt.Sprintf("key-%d", i))
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), string(value))
+	}
+
+	_, _, err = client.Put(ctx, "key-new", []byte("value-new"))
+	assert.NoError(t, err)
+	assert.NoError(t, client.Delete(ctx, "key-0"))
+	_, _, _, err = client.Get(ctx, "key-0")
+	assert.ErrorIs(t, err, oxia.ErrKeyNotFound)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, coordinatorInstance.Close())
+	assert.NoError(t, clientPool.Close())
+
+	assert.NoError(t, s1.Close())
+	assert.NoError(t, s2.Close())
+	assert.NoError(t, s3.Close())
+}
+
+func TestCoordinator_SplitShardWithWrites(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              constant.DefaultNamespace,
+			ReplicationFactor: 3,
+			InitialShardCount: 1,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorInstance, err := coordinator.NewCoordinator(metadataProvider, func() (model.ClusterConfig, error) { return clusterConfig, nil }, nil, rpc2.NewRpcProvider(clientPool))
+	assert.NoError(t, err)
+
+	statusResource := coordinatorInstance.StatusResource()
+	assert.Eventually(t, func() bool {
+		shard := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards[0]
+		return shard.Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	client, err := oxia.NewSyncClient(sa1.Public)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 1000; i++ {
+		_, _, err := client.Put(ctx, fmt.Sprintf("key-%d", i), []byte(fmt.Sprintf("value-%d", i)))
+		assert.NoError(t, err)
+	}
+
+	// The writes keep going while the data is copied into the new shards
+	stop := make(chan struct{})
+	written := make(chan []string, 1)
+	go func() {
+		var keys []string
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				written <- keys
+				return
+			default:
+			}
+			key := fmt.Sprintf("concurrent-%d", i)
+			if _, _, err := client.Put(ctx, key, []byte(key)); err == nil {
+				keys = append(keys, key)
+			}
+		}
+	}()
+
+	_, err = coordinatorInstance.SplitShard(constant.DefaultNamespace, 0)
+	assert.NoError(t, err)
+	close(stop)
+	keys := <-written
+	assert.NotEmpty(t, keys)
+
+	assert.Eventually(t, func() bool {
+		shards := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards
+		_, parentExists := shards[0]
+		return !parentExists && len(shards) == 2 &&
+			shards[1].Status == model.ShardStatusSteadyState && shards[2].Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	for _, key := range keys {
+		_, value, _, err := client.Get(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, key, string(value))
+	}
+	for i := 0; i < 1000; i++ {
+		_, value, _, err := client.Get(ctx, fmt.Sprintf("key-%d", i))
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), string(value))
+	}
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, coordinatorInstance.Close())
+	assert.NoError(t, clientPool.Close())
+
+	assert.NoError(t, s1.Close())
+	assert.NoError(t, s2.Close())
+	assert.NoError(t, s3.Close())
+}
+
+func TestCoordinator_SplitShardSessions(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              constant.DefaultNamespace,
+			ReplicationFactor: 3,
+			InitialShardCount: 1,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorInstance, err := coordinator.NewCoordinator(metadataProvider, func() (model.ClusterConfig, error) { return clusterConfig, nil }, nil, rpc2.NewRpcProvider(clientPool))
+	assert.NoError(t, err)
+
+	statusResource := coordinatorInstance.StatusResource()
+	assert.Eventually(t, func() bool {
+		shard := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards[0]
+		return shard.Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	sessionTimeout := 5 * time.Second
+	client, err := oxia.NewSyncClient(sa1.Public, oxia.WithSessionTimeout(sessionTimeout))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		_, _, err := client.Put(ctx, fmt.Sprintf("ephemeral-%d", i), []byte("value"), oxia.Ephemeral())
+		assert.NoError(t, err)
+	}
+	_, _, version, err := client.Get(ctx, "ephemeral-0")
+	assert.NoError(t, err)
+	sessionId := version.SessionId
+
+	_, err = coordinatorInstance.SplitShard(constant.DefaultNamespace, 0)
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		shards := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards
+		_, parentExists := shards[0]
+		return !parentExists && len(shards) == 2 &&
+			shards[1].Status == model.ShardStatusSteadyState && shards[2].Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The session is kept alive on the new shards, well past its timeout
+	time.Sleep(2 * sessionTimeout)
+	for i := 0; i < 10; i++ {
+		_, _, version, err := client.Get(ctx, fmt.Sprintf("ephemeral-%d", i))
+		assert.NoError(t, err)
+		assert.True(t, version.Ephemeral)
+		assert.Equal(t, sessionId, version.SessionId)
+	}
+
+	// The new ephemeral records are written with the same session
+	for i := 10; i < 20; i++ {
+		_, version, err := client.Put(ctx, fmt.Sprintf("ephemeral-%d", i), []byte("value"), oxia.Ephemeral())
+		assert.NoError(t, err)
+		assert.Equal(t, sessionId, version.SessionId)
+	}
+
+	// Closing the client deletes the ephemeral records on all the new shards
+	assert.NoError(t, client.Close())
+	client, err = oxia.NewSyncClient(sa1.Public)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		keys, err := client.List(ctx, "ephemeral-", "ephemeral.")
+		return assert.NoError(t, err) && len(keys) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, coordinatorInstance.Close())
+	assert.NoError(t, clientPool.Close())
+
+	assert.NoError(t, s1.Close())
+	assert.NoError(t, s2.Close())
+	assert.NoError(t, s3.Close())
+}
+
+func TestCoordinator_SplitShardConsumerGroup(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              constant.DefaultNamespace,
+			ReplicationFactor: 3,
+			InitialShardCount: 1,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorInstance, err := coordinator.NewCoordinator(metadataProvider, func() (model.ClusterConfig, error) { return clusterConfig, nil }, nil, rpc2.NewRpcProvider(clientPool))
+	assert.NoError(t, err)
+
+	statusResource := coordinatorInstance.StatusResource()
+	assert.Eventually(t, func() bool {
+		shard := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards[0]
+		return shard.Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	client, err := oxia.NewSyncClient(sa1.Public, oxia.WithBatchLinger(0))
+	assert.NoError(t, err)
+	member, err := client.GetNotifications(oxia.ConsumerGroup("g"))
+	assert.NoError(t, err)
+
+	_, err = coordinatorInstance.SplitShard(constant.DefaultNamespace, 0)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		shards := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards
+		_, parentExists := shards[0]
+		return !parentExists && len(shards) == 2 &&
+			shards[1].Status == model.ShardStatusSteadyState && shards[2].Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The member takes over the notifications of the new shards
+	ctx := context.Background()
+	assert.Eventually(t, func() bool {
+		_, _, err := client.Put(ctx, "key", []byte("value"))
+		assert.NoError(t, err)
+		select {
+		case n := <-member.Ch():
+			return n.Key == "key" && n.Shard != 0
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, member.Close())
+	assert.NoError(t, client.Close())
+	assert.NoError(t, coordinatorInstance.Close())
+	assert.NoError(t, clientPool.Close())
+
+	assert.NoError(t, s1.Close())
+	assert.NoError(t, s2.Close())
+	assert.NoError(t, s3.Close())
+}
+
+func TestCoordinator_MergeShards(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              constant.DefaultNamespace,
+			ReplicationFactor: 3,
+			InitialShardCount: 2,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorInstance, err := coordinator.NewCoordinator(metadataProvider, func() (model.ClusterConfig, error) { return clusterConfig, nil }, nil, rpc2.NewRpcProvider(clientPool))
+	assert.NoError(t, err)
+
+	statusResource := coordinatorInstance.StatusResource()
+	assert.Eventually(t, func() bool {
+		for _, shard := range statusResource.Load().Namespaces[constant.DefaultNamespace].Shards {
+			if shard.Status != model.ShardStatusSteadyState {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
+	client, err := oxia.NewSyncClient(sa1.Public)
 	assert.NoError(t, err)
-	err = s2.Close()
+
+	ctx := context.Background()
+	for i := 0; i < 100; i++ {
+		_, _, err := client.Put(ctx, fmt.Sprintf("key-%d", i), []byte(fmt.Sprintf("value-%d", i)))
+		assert.NoError(t, err)
+	}
+	key, _, err := client.Put(ctx, "seq", []byte("0"), oxia.PartitionKey("x"), oxia.SequenceKeysDeltas(1))
 	assert.NoError(t, err)
-	err = s3.Close()
+	assert.Equal(t, fmt.Sprintf("seq-%020d", 1), key)
+
+	mergedShard, err := coordinatorInstance.MergeShards(constant.DefaultNamespace, 1, 0)
 	assert.NoError(t, err)
-	err = c.Close()
+	assert.EqualValues(t, 2, mergedShard)
+
+	assert.Eventually(t, func() bool {
+		shards := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards
+		return len(shards) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	merged := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards[mergedShard]
+	assert.Equal(t, model.ShardStatusSteadyState, merged.Status)
+	assert.EqualValues(t, 0, merged.Int32HashRange.Min)
+	assert.EqualValues(t, math.MaxUint32, merged.Int32HashRange.Max)
+
+	// All the records from both the shards must be readable through the merged shard
+	for i := 0; i < 100; i++ {
+		_, value, _, err := client.Get(ctx, fmt.Sprintf("key-%d", i))
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), string(value))
+	}
+
+	// Sequences continue from where they were
+	key, _, err = client.Put(ctx, "seq", []byte("1"), oxia.PartitionKey("x"), oxia.SequenceKeysDeltas(1))
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("seq-%020d", 2), key)
+
+	assert.NoError(t, client.Delete(ctx, "key-0"))
+	_, _, _, err = client.Get(ctx, "key-0")
+	assert.ErrorIs(t, err, oxia.ErrKeyNotFound)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, coordinatorInstance.Close())
+	assert.NoError(t, clientPool.Close())
+
+	assert.NoError(t, s1.Close())
+	assert.NoError(t, s2.Close())
+	assert.NoError(t, s3.Close())
+}
+
+func TestCoordinator_MergeShardsWithWrites(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              constant.DefaultNamespace,
+			ReplicationFactor: 3,
+			InitialShardCount: 2,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorInstance, err := coordinator.NewCoordinator(metadataProvider, func() (model.ClusterConfig, error) { return clusterConfig, nil }, nil, rpc2.NewRpcProvider(clientPool))
+	assert.NoError(t, err)
+
+	statusResource := coordinatorInstance.StatusResource()
+	assert.Eventually(t, func() bool {
+		for _, shard := range statusResource.Load().Namespaces[constant.DefaultNamespace].Shards {
+			if shard.Status != model.ShardStatusSteadyState {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
+	client, err := oxia.NewSyncClient(sa1.Public)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 1000; i++ {
+		_, _, err := client.Put(ctx, fmt.Sprintf("key-%d", i), []byte(fmt.Sprintf("value-%d", i)))
+		assert.NoError(t, err)
+	}
+
+	// The writes keep going while the data is copied into the merged shard
+	stop := make(chan struct{})
+	written := make(chan []string, 1)
+	go func() {
+		var keys []string
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				written <- keys
+				return
+			default:
+			}
+			key := fmt.Sprintf("concurrent-%d", i)
+			if _, _, err := client.Put(ctx, key, []byte(key)); err == nil {
+				keys = append(keys, key)
+			}
+		}
+	}()
+
+	mergedShard, err := coordinatorInstance.MergeShards(constant.DefaultNamespace, 1, 0)
+	assert.NoError(t, err)
+	close(stop)
+	keys := <-written
+	assert.NotEmpty(t, keys)
+
+	assert.Eventually(t, func() bool {
+		shards := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards
+		merged, found := shards[mergedShard]
+		return len(shards) == 1 && found && merged.Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	for _, key := range keys {
+		_, value, _, err := client.Get(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, key, string(value))
+	}
+	for i := 0; i < 1000; i++ {
+		_, value, _, err := client.Get(ctx, fmt.Sprintf("key-%d", i))
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), string(value))
+	}
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, coordinatorInstance.Close())
+	assert.NoError(t, clientPool.Close())
+
+	assert.NoError(t, s1.Close())
+	assert.NoError(t, s2.Close())
+	assert.NoError(t, s3.Close())
+}
+
+func TestCoordinator_MergeShardsSessions(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              constant.DefaultNamespace,
+			ReplicationFactor: 3,
+			InitialShardCount: 2,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorInstance, err := coordinator.NewCoordinator(metadataProvider, func() (model.ClusterConfig, error) { return clusterConfig, nil }, nil, rpc2.NewRpcProvider(clientPool))
+	assert.NoError(t, err)
+
+	statusResource := coordinatorInstance.StatusResource()
+	assert.Eventually(t, func() bool {
+		for _, shard := range statusResource.Load().Namespaces[constant.DefaultNamespace].Shards {
+			if shard.Status != model.ShardStatusSteadyState {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
+	sessionTimeout := 5 * time.Second
+	client, err := oxia.NewSyncClient(sa1.Public, oxia.WithSessionTimeout(sessionTimeout))
+	assert.NoError(t, err)
+
+	// The client gets a session on each shard, which might have the same id
+	ctx := context.Background()
+	for i := 0; i < 20; i++ {
+		_, _, err := client.Put(ctx, fmt.Sprintf("ephemeral-%d", i), []byte("value"), oxia.Ephemeral())
+		assert.NoError(t, err)
+	}
+
+	mergedShard, err := coordinatorInstance.MergeShards(constant.DefaultNamespace, 1, 0)
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		shards := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards
+		return len(shards) == 1 && shards[mergedShard].Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Both the sessions are kept alive on the merged shard, well past their
+	// timeout
+	time.Sleep(2 * sessionTimeout)
+	sessionIds := map[int64]bool{}
+	for i := 0; i < 20; i++ {
+		_, _, version, err := client.Get(ctx, fmt.Sprintf("ephemeral-%d", i))
+		assert.NoError(t, err)
+		assert.True(t, version.Ephemeral)
+		sessionIds[version.SessionId] = true
+	}
+	assert.Len(t, sessionIds, 2)
+
+	// The new ephemeral records are written with one of the sessions
+	for i := 20; i < 30; i++ {
+		_, version, err := client.Put(ctx, fmt.Sprintf("ephemeral-%d", i), []byte("value"), oxia.Ephemeral())
+		assert.NoError(t, err)
+		assert.Contains(t, sessionIds, version.SessionId)
+	}
+
+	// Closing the client deletes the ephemeral records of both the sessions
+	assert.NoError(t, client.Close())
+	client, err = oxia.NewSyncClient(sa1.Public)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		keys, err := client.List(ctx, "ephemeral-", "ephemeral.")
+		return assert.NoError(t, err) && len(keys) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, coordinatorInstance.Close())
+	assert.NoError(t, clientPool.Close())
+
+	assert.NoError(t, s1.Close())
+	assert.NoError(t, s2.Close())
+	assert.NoError(t, s3.Close())
+}
+
+func TestCoordinator_FollowerReads(t *testing.T) {
+	s1, sa1 := newServer(t)
+	s2, sa2 := newServer(t)
+	s3, sa3 := newServer(t)
+
+	metadataProvider := metadata.NewMetadataProviderMemory()
+	clusterConfig := model.ClusterConfig{
+		Namespaces: []model.NamespaceConfig{{
+			Name:              constant.DefaultNamespace,
+			ReplicationFactor: 3,
+			InitialShardCount: 1,
+		}},
+		Servers: []model.Server{sa1, sa2, sa3},
+	}
+	clientPool := rpc.NewClientPool(nil, nil)
+
+	coordinatorInstance, err := coordinator.NewCoordinator(metadataProvider, func() (model.ClusterConfig, error) { return clusterConfig, nil }, nil, rpc2.NewRpcProvider(clientPool))
+	assert.NoError(t, err)
+
+	statusResource := coordinatorInstance.StatusResource()
+	assert.Eventually(t, func() bool {
+		shard := statusResource.Load().Namespaces[constant.DefaultNamespace].Shards[0]
+		return shard.Status == model.ShardStatusSteadyState
+	}, 10*time.Second, 10*time.Millisecond)
+
+	client, err := oxia.NewSyncClient(sa1.Public)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		_, _, err := client.Put(ctx, fmt.Sprintf("/key-%d", i), []byte(fmt.Sprintf("value-%d", i)))
+		assert.NoError(t, err)
+	}
+
+	// The reads are either served by the followers or by the leader, though
+	// they must always succeed
+	for i := 0; i < 20; i++ {
+		_, value, _, err := client.Get(ctx, "/key-0", oxia.FollowerReads(1*time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, "value-0", string(value))
+	}
+
+	// With a very strict bound, the followers of an idle shard fall back to
+	// the leader, which always has the latest data
+	_, _, err = client.Put(ctx, "/key-0", []byte("value-x"))
+	assert.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+	for i := 0; i < 20; i++ {
+		_, value, _, err := client.Get(ctx, "/key-0", oxia.FollowerReads(1*time.Millisecond))
+		assert.NoError(t, err)
+		assert.Equal(t, "value-x", string(value))
+	}
+
+	keys, err := client.List(ctx, "/", "//", oxia.FollowerReads(1*time.Minute))
 	assert.NoError(t, err)
+	assert.Len(t, keys, 10)
+
+	count := 0
+	for res := range client.RangeScan(ctx, "/", "//", oxia.FollowerReads(1*time.Millisecond)) {
+		assert.NoError(t, res.Err)
+		count++
+	}
+	assert.Equal(t, 10, count)
+
+	assert.NoError(t, client.Close())
+	assert.NoError(t, coordinatorInstance.Close())
+	assert.NoError(t, clientPool.Close())
+
+	assert.NoError(t, s1.Close())
+	assert.NoError(t, s2.Close())
+	assert.NoError(t, s3.Close())
 }
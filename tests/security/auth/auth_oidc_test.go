@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/util/json"
 
@@ -39,6 +40,7 @@ import (
 	"github.com/oxia-db/oxia/coordinator/model"
 	"github.com/oxia-db/oxia/oxia"
 	clientauth "github.com/oxia-db/oxia/oxia/auth"
+	"github.com/oxia-db/oxia/proto"
 	"github.com/oxia-db/oxia/server"
 	"github.com/oxia-db/oxia/server/auth"
 )
@@ -202,6 +204,84 @@ func TestOIDCWithStaticToken(t *testing.T) {
 	client.Close()
 }
 
+func TestOIDCAdminService(t *testing.T) {
+	mockOIDC, err := mockoidc.Run()
+	assert.NoError(t, err)
+	defer func(mockOIDC *mockoidc.MockOIDC) {
+		_ = mockOIDC.Shutdown()
+	}(mockOIDC)
+
+	audience := generateRandomStr(t)
+	signedToken, err := mockOIDC.Keypair.SignJWT(&jwt.RegisteredClaims{
+		Audience:  jwt.ClaimStrings{audience},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(1) * time.Hour)),
+		ID:        generateRandomStr(t),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		Issuer:    mockOIDC.Issuer(),
+		NotBefore: jwt.NewNumericDate(time.Time{}),
+		Subject:   generateRandomStr(t),
+	})
+	assert.NoError(t, err)
+
+	jsonParams, err := json.Marshal(auth.OIDCOptions{
+		AllowedIssueURLs: mockOIDC.Issuer(),
+		AllowedAudiences: audience,
+	})
+	assert.NoError(t, err)
+	coordinatorConfig := coordinator.Config{
+		InternalServiceAddr:  "localhost:0",
+		MetadataProviderName: metadata.ProviderNameMemory,
+		ClusterConfigProvider: func() (model.ClusterConfig, error) {
+			return model.ClusterConfig{}, nil
+		},
+		AuthOptions: auth.Options{
+			ProviderName:   auth.ProviderOIDC,
+			ProviderParams: string(jsonParams),
+		},
+	}
+
+	// The admin service cannot be exposed without authentication
+	_, err = coordinator.NewGrpcServer(coordinatorConfig)
+	assert.Error(t, err)
+
+	coordinatorConfig.AdminServiceAddr = "localhost:0"
+	coordinatorServer, err := coordinator.NewGrpcServer(coordinatorConfig)
+	assert.NoError(t, err)
+	defer coordinatorServer.Close()
+	assert.NotEqual(t, coordinatorServer.InternalPort(), coordinatorServer.AdminPort())
+
+	ctx := context.Background()
+	adminAddr := fmt.Sprintf("localhost:%d", coordinatorServer.AdminPort())
+	internalAddr := fmt.Sprintf("localhost:%d", coordinatorServer.InternalPort())
+
+	// The health checks don't need any token
+	clientPool := rpc.NewClientPool(nil, nil)
+	defer clientPool.Close()
+	health, closer, err := clientPool.GetHealthRpc(internalAddr)
+	assert.NoError(t, err)
+	defer closer.Close()
+	_, err = health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+
+	// The admin service is not served on the internal address
+	admin, err := clientPool.GetAdminRpc(internalAddr)
+	assert.NoError(t, err)
+	_, err = admin.ListNamespaces(ctx, &proto.ListNamespacesRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	admin, err = clientPool.GetAdminRpc(adminAddr)
+	assert.NoError(t, err)
+	_, err = admin.ListNamespaces(ctx, &proto.ListNamespacesRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	authClientPool := rpc.NewClientPool(nil, clientauth.NewTokenAuthenticationWithToken(signedToken, false))
+	defer authClientPool.Close()
+	admin, err = authClientPool.GetAdminRpc(adminAddr)
+	assert.NoError(t, err)
+	_, err = admin.ListNamespaces(ctx, &proto.ListNamespacesRequest{})
+	assert.NoError(t, err)
+}
+
 func generateRandomStr(t *testing.T) string {
 	t.Helper()
 	random, err := uuid.NewRandom()